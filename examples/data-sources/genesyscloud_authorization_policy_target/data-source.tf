@@ -0,0 +1,3 @@
+data "genesyscloud_authorization_policy_target" "user_add" {
+  name = "directory:user:add"
+}
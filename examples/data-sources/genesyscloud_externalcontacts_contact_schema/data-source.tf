@@ -0,0 +1,3 @@
+data "genesyscloud_externalcontacts_contact_schema" "example_schema" {
+  name = "Example Contact Schema"
+}
@@ -0,0 +1,3 @@
+data "genesyscloud_externalcontacts_organization_schema" "example_schema" {
+  name = "Example Organization Schema"
+}
@@ -0,0 +1,4 @@
+data "genesyscloud_gamification_metric" "example_metric" {
+  profile_id = genesyscloud_gamification_profile.example_profile.id
+  name       = "Revenue"
+}
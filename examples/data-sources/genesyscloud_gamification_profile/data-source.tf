@@ -0,0 +1,3 @@
+data "genesyscloud_gamification_profile" "example_profile" {
+  name = "Example Performance Profile"
+}
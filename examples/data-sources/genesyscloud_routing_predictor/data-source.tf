@@ -0,0 +1,3 @@
+data "genesyscloud_routing_predictor" "example_predictor" {
+  queue_id = genesyscloud_routing_queue.example_queue.id
+}
@@ -12,4 +12,13 @@ resource "genesyscloud_architect_user_prompt" "welcome_greeting" {
     filename          = "${local.working_dir.architect_user_prompt}/jp-welcome-greeting.wav"
     file_content_hash = filesha256("${local.working_dir.architect_user_prompt}/jp-welcome-greeting.wav")
   }
-}
\ No newline at end of file
+}
+resource "genesyscloud_architect_user_prompt" "hold_message" {
+  name            = "Hold_Message"
+  description     = "Hold message with one WAV file per language"
+  audio_directory = "${local.working_dir.architect_user_prompt}/prompts/hold_message"
+  resources {
+    language = "en-us"
+    text     = "Please hold while we connect you."
+  }
+}
@@ -0,0 +1,19 @@
+resource "genesyscloud_authorization_policy" "example_policy" {
+  name          = "Home workers cannot add users"
+  description   = "Deny adding users to agents working from home"
+  policy_target = "directory:user:add"
+  action_set    = ["add"]
+  effect        = "DENY"
+  enabled       = true
+  condition {
+    conjunction = "AND"
+    term {
+      variable_name = "user.location"
+      operator      = "EQUALS"
+      operand {
+        type  = "SCALAR"
+        value = "Home"
+      }
+    }
+  }
+}
@@ -0,0 +1,30 @@
+resource "genesyscloud_externalcontacts_contact_schema" "example_schema" {
+  name        = "Example Contact Schema"
+  description = "Custom fields for external contacts"
+  enabled     = true
+  properties = jsonencode({
+    "loyalty_tier_text" : {
+      "allOf" : [
+        {
+          "$ref" : "#/definitions/text"
+        }
+      ],
+      "title" : "Loyalty Tier",
+      "description" : "Loyalty program tier",
+      "minLength" : 0,
+      "maxLength" : 50
+    },
+    "account_number_identifier" : {
+      "allOf" : [
+        {
+          "$ref" : "#/definitions/identifier"
+        }
+      ],
+      "title" : "Account Number",
+      "description" : "Account number in the billing system",
+      "minLength" : 1,
+      "maxLength" : 100
+    }
+  })
+  required_fields = ["account_number_identifier"]
+}
@@ -0,0 +1,8 @@
+locals {
+  dependencies = {
+    resource = [
+      "../genesyscloud_user/resource.tf",
+      "../genesyscloud_externalcontacts_organization/resource.tf",
+    ]
+  }
+}
@@ -0,0 +1,5 @@
+resource "genesyscloud_externalcontacts_relationship" "account_manager" {
+  user_id                  = genesyscloud_user.example_user.id
+  external_organization_id = genesyscloud_externalcontacts_organization.example_org.id
+  relationship             = "Account Manager"
+}
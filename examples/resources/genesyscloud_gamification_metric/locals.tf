@@ -0,0 +1,8 @@
+locals {
+  dependencies = {
+    resource = [
+      "../genesyscloud_gamification_profile/resource.tf",
+      "../genesyscloud_employeeperformance_externalmetrics_definitions/resource.tf",
+    ]
+  }
+}
@@ -0,0 +1,26 @@
+resource "genesyscloud_gamification_metric" "example_metric" {
+  profile_id                    = genesyscloud_gamification_profile.example_profile.id
+  name                          = "Revenue"
+  external_metric_definition_id = genesyscloud_employeeperformance_externalmetrics_definitions.example_externalmetrics_definition.id
+  precision                     = 2
+  objective {
+    template_id = "e2b7d7cd-6a5f-4d0f-9d3e-6a3b6f1c5b21"
+    enabled     = true
+    zones {
+      label          = "Below target"
+      direction_type = "Up"
+      zone_type      = "Range"
+      score          = 0
+      min_value      = 0
+      max_value      = 1000
+    }
+    zones {
+      label          = "On target"
+      direction_type = "Up"
+      zone_type      = "Range"
+      score          = 10
+      min_value      = 1000
+      max_value      = 5000
+    }
+  }
+}
@@ -0,0 +1,9 @@
+locals {
+  dependencies = {
+    resource = [
+      "../../data-sources/genesyscloud_auth_division_home/data-source.tf",
+      "../genesyscloud_team/resource.tf",
+      "../genesyscloud_user/resource.tf",
+    ]
+  }
+}
@@ -0,0 +1,13 @@
+resource "genesyscloud_gamification_profile" "example_profile" {
+  name                      = "Example Performance Profile"
+  description               = "Performance profile for the sales team"
+  division_id               = data.genesyscloud_auth_division_home.home.id
+  active                    = true
+  max_leaderboard_rank_size = 10
+  member_ids = [
+    genesyscloud_user.example_user.id
+  ]
+  team_ids = [
+    genesyscloud_team.example_team.id
+  ]
+}
@@ -0,0 +1,3 @@
+exports.handler = async (event) => {
+  return { result: `Processed ${event.inputData}` };
+};
@@ -106,3 +106,47 @@ resource "genesyscloud_integration_action" "example_function_action" {
     file_path       = "${local.working_dir.integration_action}/function.zip"
   }
 }
+
+# Example of a function data action built from a source directory
+# The provider packages the directory into a reproducible zip and only uploads it again when its files change
+resource "genesyscloud_integration_action" "example_function_action_from_source" {
+  name           = "Example Function Action From Source"
+  category       = "Genesys Cloud Data Action"
+  integration_id = genesyscloud_integration.example_gc_data_integration.id
+
+  contract_input = jsonencode({
+    "type" = "object",
+    "properties" = {
+      "inputData" = {
+        "type" = "string"
+      }
+    }
+  })
+
+  contract_output = jsonencode({
+    "type" = "object",
+    "properties" = {
+      "result" = {
+        "type" = "string"
+      }
+    }
+  })
+
+  function_config {
+    description     = "Function packaged from its sources"
+    handler         = "index.handler"
+    runtime         = "nodejs18.x"
+    timeout_seconds = 30
+    source_dir      = "${local.working_dir.integration_action}/function_src"
+  }
+
+  # Run the action with sample input after every apply and fail the apply if the output does not match
+  test {
+    input = jsonencode({
+      "inputData" = "order 42"
+    })
+    expected_output = jsonencode({
+      "result" = "Processed order 42"
+    })
+  }
+}
@@ -0,0 +1,10 @@
+locals {
+  working_dir = {
+    knowledge_import = "."
+  }
+  dependencies = {
+    resource = [
+      "../genesyscloud_knowledge_knowledgebase/resource.tf",
+    ]
+  }
+}
@@ -0,0 +1,5 @@
+resource "genesyscloud_knowledge_import" "help_center" {
+  knowledge_base_id = genesyscloud_knowledge_knowledgebase.example_knowledgebase.id
+  directory         = "${local.working_dir.knowledge_import}/articles"
+  visible           = true
+}
@@ -0,0 +1,7 @@
+resource "genesyscloud_recording_settings" "example" {
+  max_simultaneous_streams                  = 5
+  max_configurable_screen_recording_streams = 10
+  regional_recording_storage_enabled        = true
+  recording_playback_url_ttl                = 10
+  key_rotation_period                       = "Monthly"
+}
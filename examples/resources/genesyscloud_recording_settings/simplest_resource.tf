@@ -0,0 +1,3 @@
+resource "genesyscloud_recording_settings" "example" {
+  key_rotation_period = "Monthly"
+}
@@ -0,0 +1,7 @@
+locals {
+  dependencies = {
+    resource = [
+      "../genesyscloud_routing_queue/resource.tf",
+    ]
+  }
+}
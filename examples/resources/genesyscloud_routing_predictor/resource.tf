@@ -0,0 +1,13 @@
+resource "genesyscloud_routing_predictor" "example_predictor" {
+  kpi                     = "tHandle"
+  queue_ids               = [genesyscloud_routing_queue.example_queue.id]
+  routing_timeout_seconds = 30
+  schedule {
+    schedule_type = "Weekly"
+  }
+  workload_balancing_config {
+    enabled           = true
+    minimum_occupancy = 60
+    maximum_occupancy = 90
+  }
+}
@@ -0,0 +1,5 @@
+locals {
+  working_dir = {
+    users_bulk = "."
+  }
+}
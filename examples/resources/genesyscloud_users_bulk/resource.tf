@@ -0,0 +1,4 @@
+resource "genesyscloud_users_bulk" "contact_center_agents" {
+  filepath   = "${local.working_dir.users_bulk}/agents.csv"
+  batch_size = 50
+}
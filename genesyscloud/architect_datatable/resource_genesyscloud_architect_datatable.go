@@ -97,6 +97,10 @@ func createArchitectDatatable(ctx context.Context, d *schema.ResourceData, meta
 
 	d.SetId(*table.Id)
 
+	if diagErr := importDatatableRows(ctx, d, archProxy); diagErr != nil {
+		return diagErr
+	}
+
 	log.Printf("Created architect_datatable %s %s", name, *table.Id)
 	return readArchitectDatatable(ctx, d, meta)
 }
@@ -173,6 +177,10 @@ func updateArchitectDatatable(ctx context.Context, d *schema.ResourceData, meta
 		return util.BuildAPIDiagnosticError(ResourceType, fmt.Sprintf("Failed to update architect_datatable %s, error: %s", name, err), resp)
 	}
 
+	if diagErr := importDatatableRows(ctx, d, archProxy); diagErr != nil {
+		return diagErr
+	}
+
 	log.Printf("Updated architect_datatable %s", name)
 	return readArchitectDatatable(ctx, d, meta)
 }
@@ -2,9 +2,11 @@ package architect_datatable
 
 import (
 	"context"
+	"io"
 
 	customapi "github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/custom_api_client"
 	"github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/provider"
+	"github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/util/files"
 
 	"github.com/mypurecloud/platform-client-sdk-go/v192/platformclientv2"
 )
@@ -17,6 +19,11 @@ type createOrUpdateArchitectDatatableFunc func(ctx context.Context, p *architect
 type deleteArchitectDatatableFunc func(ctx context.Context, p *architectDatatableProxy, datatableId string) (*platformclientv2.APIResponse, error)
 type getArchitectDatatableFunc func(ctx context.Context, p *architectDatatableProxy, datatableId string, expanded string) (*Datatable, *platformclientv2.APIResponse, error)
 type getAllArchitectDatatableFunc func(ctx context.Context, p *architectDatatableProxy) (*[]platformclientv2.Datatable, *platformclientv2.APIResponse, error)
+type createDatatableImportJobFunc func(ctx context.Context, p *architectDatatableProxy, datatableId string) (*DatatableImportJob, *platformclientv2.APIResponse, error)
+type getDatatableImportJobFunc func(ctx context.Context, p *architectDatatableProxy, datatableId, jobId string) (*DatatableImportJob, *platformclientv2.APIResponse, error)
+type uploadDatatableRowsFileFunc func(ctx context.Context, p *architectDatatableProxy, uploadUri, filePath string) ([]byte, error)
+type createDatatableExportJobFunc func(ctx context.Context, p *architectDatatableProxy, datatableId string) (*DatatableExportJob, *platformclientv2.APIResponse, error)
+type getDatatableExportJobFunc func(ctx context.Context, p *architectDatatableProxy, datatableId, jobId string) (*DatatableExportJob, *platformclientv2.APIResponse, error)
 
 type architectDatatableProxy struct {
 	clientConfig                         *platformclientv2.Configuration
@@ -26,6 +33,11 @@ type architectDatatableProxy struct {
 	getArchitectDatatableAttr            getArchitectDatatableFunc
 	getAllArchitectDatatableAttr         getAllArchitectDatatableFunc
 	deleteArchitectDatatableAttr         deleteArchitectDatatableFunc
+	createDatatableImportJobAttr         createDatatableImportJobFunc
+	getDatatableImportJobAttr            getDatatableImportJobFunc
+	uploadDatatableRowsFileAttr          uploadDatatableRowsFileFunc
+	createDatatableExportJobAttr         createDatatableExportJobFunc
+	getDatatableExportJobAttr            getDatatableExportJobFunc
 }
 
 func newArchitectDatatableProxy(clientConfig *platformclientv2.Configuration) *architectDatatableProxy {
@@ -38,6 +50,11 @@ func newArchitectDatatableProxy(clientConfig *platformclientv2.Configuration) *a
 		getArchitectDatatableAttr:            getArchitectDatatableFn,
 		getAllArchitectDatatableAttr:         getAllArchitectDatatableFn,
 		deleteArchitectDatatableAttr:         deleteArchitectDatatableFn,
+		createDatatableImportJobAttr:         createDatatableImportJobFn,
+		getDatatableImportJobAttr:            getDatatableImportJobFn,
+		uploadDatatableRowsFileAttr:          uploadDatatableRowsFileFn,
+		createDatatableExportJobAttr:         createDatatableExportJobFn,
+		getDatatableExportJobAttr:            getDatatableExportJobFn,
 	}
 }
 
@@ -69,6 +86,29 @@ func (p *architectDatatableProxy) deleteArchitectDatatable(ctx context.Context,
 	return p.deleteArchitectDatatableAttr(ctx, p, id)
 }
 
+// createDatatableImportJob starts an import job that replaces all rows of the datatable with the contents of an uploaded CSV file
+func (p *architectDatatableProxy) createDatatableImportJob(ctx context.Context, datatableId string) (*DatatableImportJob, *platformclientv2.APIResponse, error) {
+	return p.createDatatableImportJobAttr(ctx, p, datatableId)
+}
+
+func (p *architectDatatableProxy) getDatatableImportJob(ctx context.Context, datatableId, jobId string) (*DatatableImportJob, *platformclientv2.APIResponse, error) {
+	return p.getDatatableImportJobAttr(ctx, p, datatableId, jobId)
+}
+
+// uploadDatatableRowsFile uploads a CSV file of rows to the upload URI returned by an import job
+func (p *architectDatatableProxy) uploadDatatableRowsFile(ctx context.Context, uploadUri, filePath string) ([]byte, error) {
+	return p.uploadDatatableRowsFileAttr(ctx, p, uploadUri, filePath)
+}
+
+// createDatatableExportJob starts an export job that writes all rows of the datatable to a CSV file
+func (p *architectDatatableProxy) createDatatableExportJob(ctx context.Context, datatableId string) (*DatatableExportJob, *platformclientv2.APIResponse, error) {
+	return p.createDatatableExportJobAttr(ctx, p, datatableId)
+}
+
+func (p *architectDatatableProxy) getDatatableExportJob(ctx context.Context, datatableId, jobId string) (*DatatableExportJob, *platformclientv2.APIResponse, error) {
+	return p.getDatatableExportJobAttr(ctx, p, datatableId, jobId)
+}
+
 func createOrUpdateArchitectDatatableFn(ctx context.Context, p *architectDatatableProxy, createAction bool, datatable *Datatable) (*Datatable, *platformclientv2.APIResponse, error) {
 	// Set resource context for SDK debug logging
 	ctx = provider.EnsureResourceContext(ctx, ResourceType)
@@ -133,3 +173,54 @@ func getAllArchitectDatatableFn(ctx context.Context, p *architectDatatableProxy)
 
 	return &totalRecords, resp, nil
 }
+
+func createDatatableImportJobFn(ctx context.Context, p *architectDatatableProxy, datatableId string) (*DatatableImportJob, *platformclientv2.APIResponse, error) {
+	// Set resource context for SDK debug logging
+	ctx = provider.EnsureResourceContext(ctx, ResourceType)
+
+	body := DatatableImportJob{ImportMode: platformclientv2.String("ReplaceAll")}
+	return customapi.Do[DatatableImportJob](ctx, p.customApiClient, customapi.MethodPost, "/api/v2/flows/datatables/"+datatableId+"/import/jobs", body, nil)
+}
+
+func getDatatableImportJobFn(ctx context.Context, p *architectDatatableProxy, datatableId, jobId string) (*DatatableImportJob, *platformclientv2.APIResponse, error) {
+	// Set resource context for SDK debug logging
+	ctx = provider.EnsureResourceContext(ctx, ResourceType)
+
+	return customapi.Do[DatatableImportJob](ctx, p.customApiClient, customapi.MethodGet, "/api/v2/flows/datatables/"+datatableId+"/import/jobs/"+jobId, nil, nil)
+}
+
+func uploadDatatableRowsFileFn(ctx context.Context, p *architectDatatableProxy, uploadUri, filePath string) ([]byte, error) {
+	// Set resource context for SDK debug logging
+	ctx = provider.EnsureResourceContext(ctx, ResourceType)
+
+	fileReader, _, err := files.DownloadOrOpenFile(ctx, filePath, S3Enabled)
+	if err != nil {
+		return nil, err
+	}
+	if closer, ok := fileReader.(io.Closer); ok {
+		defer closer.Close()
+	}
+
+	formData := make(map[string]io.Reader)
+	formData["file"] = fileReader
+
+	headers := make(map[string]string)
+	headers["Authorization"] = "Bearer " + p.clientConfig.AccessToken
+
+	s3Uploader := files.NewS3Uploader(nil, formData, nil, headers, "POST", uploadUri)
+	return s3Uploader.Upload()
+}
+
+func createDatatableExportJobFn(ctx context.Context, p *architectDatatableProxy, datatableId string) (*DatatableExportJob, *platformclientv2.APIResponse, error) {
+	// Set resource context for SDK debug logging
+	ctx = provider.EnsureResourceContext(ctx, ResourceType)
+
+	return customapi.Do[DatatableExportJob](ctx, p.customApiClient, customapi.MethodPost, "/api/v2/flows/datatables/"+datatableId+"/export/jobs", nil, nil)
+}
+
+func getDatatableExportJobFn(ctx context.Context, p *architectDatatableProxy, datatableId, jobId string) (*DatatableExportJob, *platformclientv2.APIResponse, error) {
+	// Set resource context for SDK debug logging
+	ctx = provider.EnsureResourceContext(ctx, ResourceType)
+
+	return customapi.Do[DatatableExportJob](ctx, p.customApiClient, customapi.MethodGet, "/api/v2/flows/datatables/"+datatableId+"/export/jobs/"+jobId, nil, nil)
+}
@@ -0,0 +1,183 @@
+package architect_datatable
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/provider"
+	resourceExporter "github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/resource_exporter"
+	"github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/util"
+	"github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/util/files"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+/*
+The resource_genesyscloud_architect_datatable_rows.go file manages the rows of a datatable in bulk from a CSV file
+using the datatable import and export job APIs, instead of one genesyscloud_architect_datatable_row resource per row.
+*/
+
+const (
+	datatableJobStatusSucceeded = "Succeeded"
+	datatableJobStatusFailed    = "Failed"
+)
+
+type DatatableJobError struct {
+	Message *string `json:"message,omitempty"`
+}
+
+type DatatableImportJob struct {
+	Id                  *string            `json:"id,omitempty"`
+	Status              *string            `json:"status,omitempty"`
+	UploadURI           *string            `json:"uploadURI,omitempty"`
+	ImportMode          *string            `json:"importMode,omitempty"`
+	ErrorInformation    *DatatableJobError `json:"errorInformation,omitempty"`
+	CountRecordsUpdated *int               `json:"countRecordsUpdated,omitempty"`
+	CountRecordsDeleted *int               `json:"countRecordsDeleted,omitempty"`
+	CountRecordsFailed  *int               `json:"countRecordsFailed,omitempty"`
+}
+
+type DatatableExportJob struct {
+	Id               *string            `json:"id,omitempty"`
+	Status           *string            `json:"status,omitempty"`
+	DownloadURI      *string            `json:"downloadURI,omitempty"`
+	ErrorInformation *DatatableJobError `json:"errorInformation,omitempty"`
+}
+
+// importDatatableRows replaces all rows of the datatable with the rows in rows_filepath when the file content has changed
+func importDatatableRows(ctx context.Context, d *schema.ResourceData, archProxy *architectDatatableProxy) diag.Diagnostics {
+	filePath := d.Get("rows_filepath").(string)
+	if filePath == "" {
+		return nil
+	}
+
+	fileHash, err := files.HashFileContent(ctx, filePath, S3Enabled)
+	if err != nil {
+		return util.BuildDiagnosticError(ResourceType, fmt.Sprintf("Failed to read rows file %s", filePath), err)
+	}
+	if d.Get("rows_file_content_hash").(string) == fileHash {
+		return nil
+	}
+
+	datatableId := d.Id()
+	log.Printf("Importing rows from %s into architect_datatable %s", filePath, datatableId)
+
+	job, resp, err := archProxy.createDatatableImportJob(ctx, datatableId)
+	if err != nil {
+		return util.BuildAPIDiagnosticError(ResourceType, fmt.Sprintf("Failed to create import job for architect_datatable %s error: %s", datatableId, err), resp)
+	}
+	if job.Id == nil || job.UploadURI == nil {
+		return util.BuildAPIDiagnosticError(ResourceType, fmt.Sprintf("Import job for architect_datatable %s did not return an upload URI", datatableId), resp)
+	}
+
+	jobId := *job.Id
+	if _, err := archProxy.uploadDatatableRowsFile(ctx, *job.UploadURI, filePath); err != nil {
+		return util.BuildDiagnosticError(ResourceType, fmt.Sprintf("Failed to upload rows file %s for architect_datatable %s", filePath, datatableId), err)
+	}
+
+	diagErr := util.WithRetries(ctx, 15*time.Minute, func() *retry.RetryError {
+		job, resp, err := archProxy.getDatatableImportJob(ctx, datatableId, jobId)
+		if err != nil {
+			return retry.NonRetryableError(util.BuildWithRetriesApiDiagnosticError(ResourceType, fmt.Sprintf("Failed to get import job %s for architect_datatable %s | error: %s", jobId, datatableId, err), resp))
+		}
+
+		status := ""
+		if job.Status != nil {
+			status = *job.Status
+		}
+		switch status {
+		case datatableJobStatusSucceeded:
+			if job.CountRecordsFailed != nil && *job.CountRecordsFailed > 0 {
+				return retry.NonRetryableError(util.BuildWithRetriesApiDiagnosticError(ResourceType, fmt.Sprintf("%d rows failed to import into architect_datatable %s", *job.CountRecordsFailed, datatableId), resp))
+			}
+			return nil
+		case datatableJobStatusFailed:
+			return retry.NonRetryableError(util.BuildWithRetriesApiDiagnosticError(ResourceType, fmt.Sprintf("Import job %s for architect_datatable %s failed: %s", jobId, datatableId, getDatatableJobErrorMessage(job.ErrorInformation)), resp))
+		}
+
+		time.Sleep(5 * time.Second)
+		return retry.RetryableError(fmt.Errorf("import job %s for architect_datatable %s has status %q", jobId, datatableId, status))
+	})
+	if diagErr != nil {
+		return diagErr
+	}
+
+	_ = d.Set("rows_file_content_hash", fileHash)
+	log.Printf("Imported rows from %s into architect_datatable %s", filePath, datatableId)
+	return nil
+}
+
+func getDatatableJobErrorMessage(errorInformation *DatatableJobError) string {
+	if errorInformation == nil || errorInformation.Message == nil {
+		return "no error information given"
+	}
+	return *errorInformation.Message
+}
+
+// DatatableRowsExporterResolver exports the rows of a datatable to a CSV file and references it from rows_filepath
+func DatatableRowsExporterResolver(resourceId, exportDirectory, subDirectory string, configMap map[string]interface{}, meta interface{}, resource resourceExporter.ResourceInfo) error {
+	sdkConfig := meta.(*provider.ProviderMeta).ClientConfig
+	archProxy := getArchitectDatatableProxy(sdkConfig)
+	ctx := context.Background()
+
+	exportFileName := fmt.Sprintf("%s.csv", resource.BlockLabel)
+	fullDirectoryPath := filepath.Join(exportDirectory, subDirectory)
+	if err := os.MkdirAll(fullDirectoryPath, os.ModePerm); err != nil {
+		return fmt.Errorf("failed to create directory %s: %w", fullDirectoryPath, err)
+	}
+
+	job, _, err := archProxy.createDatatableExportJob(ctx, resourceId)
+	if err != nil {
+		return fmt.Errorf("failed to create export job for architect_datatable %s: %w", resourceId, err)
+	}
+	if job.Id == nil {
+		return fmt.Errorf("export job for architect_datatable %s did not return an ID", resourceId)
+	}
+
+	jobId := *job.Id
+	var downloadUri string
+	diagErr := util.WithRetries(ctx, 5*time.Minute, func() *retry.RetryError {
+		job, _, err := archProxy.getDatatableExportJob(ctx, resourceId, jobId)
+		if err != nil {
+			return retry.NonRetryableError(fmt.Errorf("failed to get export job %s for architect_datatable %s: %w", jobId, resourceId, err))
+		}
+		if job.Status != nil && *job.Status == datatableJobStatusFailed {
+			return retry.NonRetryableError(fmt.Errorf("export job %s for architect_datatable %s failed: %s", jobId, resourceId, getDatatableJobErrorMessage(job.ErrorInformation)))
+		}
+		if job.Status != nil && *job.Status == datatableJobStatusSucceeded && job.DownloadURI != nil {
+			downloadUri = *job.DownloadURI
+			return nil
+		}
+		time.Sleep(2 * time.Second)
+		return retry.RetryableError(fmt.Errorf("export job %s for architect_datatable %s has not completed", jobId, resourceId))
+	})
+	if diagErr != nil {
+		return fmt.Errorf("error exporting rows of architect_datatable %s: %v", resourceId, diagErr)
+	}
+
+	log.Printf("Downloading rows of architect_datatable %s", resourceId)
+	if _, err := files.DownloadExportFileWithAccessToken(fullDirectoryPath, exportFileName, downloadUri, sdkConfig.AccessToken); err != nil {
+		return fmt.Errorf("error downloading rows of architect_datatable %s: %w", resourceId, err)
+	}
+
+	fullCurrentPath := filepath.Join(fullDirectoryPath, exportFileName)
+	fullRelativePath := filepath.Join(subDirectory, exportFileName)
+
+	hash, err := files.HashFileContent(ctx, fullCurrentPath, S3Enabled)
+	if err != nil {
+		return fmt.Errorf("error calculating file content hash of %s: %w", fullCurrentPath, err)
+	}
+
+	configMap["rows_filepath"] = fullRelativePath
+	delete(configMap, "rows_file_content_hash")
+	resource.State.Attributes["rows_filepath"] = fullRelativePath
+	resource.State.Attributes["rows_file_content_hash"] = hash
+
+	return nil
+}
@@ -10,12 +10,17 @@ import (
 	"github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/provider"
 	resourceExporter "github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/resource_exporter"
 	registrar "github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/resource_register"
+	"github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/validators"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
-const ResourceType = "genesyscloud_architect_datatable"
+const (
+	ResourceType = "genesyscloud_architect_datatable"
+	S3Enabled    = true
+)
 
 // SetRegistrar registers all of the resources, datasources and exporters in the package
 func SetRegistrar(regInstance registrar.Registrar) {
@@ -63,6 +68,9 @@ func ResourceArchitectDatatable() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		CustomizeDiff: customdiff.All(
+			customdiff.ComputedIf("rows_file_content_hash", validators.ValidateFileContentHashChanged("rows_filepath", "rows_file_content_hash", S3Enabled)),
+		),
 		SchemaVersion: 1,
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -88,6 +96,17 @@ func ResourceArchitectDatatable() *schema.Resource {
 				MinItems:    1,
 				Elem:        datatableProperty,
 			},
+			"rows_filepath": {
+				Description:  "Path to a CSV file containing the rows of the architect_datatable. The header row contains the property names. When the file content changes, all existing rows are replaced with the rows from the file using a datatable import job. Do not combine with `genesyscloud_architect_datatable_row` resources for the same datatable. Removing this attribute leaves the existing rows in place.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validators.ValidatePath,
+			},
+			"rows_file_content_hash": {
+				Description: "The hash of the rows file. This is retained as a computed value in the state in order to detect when the file's contents have changed.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
 		},
 	}
 }
@@ -114,3 +133,19 @@ func ArchitectDatatableExporter() *resourceExporter.ResourceExporter {
 		},
 	}
 }
+
+// ArchitectDatatableRowsFileExporter exports the rows of each datatable to a CSV file referenced by rows_filepath. It
+// replaces the datatable exporter when the export is asked for rows files, in which case the
+// genesyscloud_architect_datatable_row resources are not exported, as they would manage the same rows.
+func ArchitectDatatableRowsFileExporter() *resourceExporter.ResourceExporter {
+	exporter := ArchitectDatatableExporter()
+	exporter.CustomFileWriter = resourceExporter.CustomFileWriterSettings{
+		RetrieveAndWriteFilesFunc: DatatableRowsExporterResolver,
+		SubDirectory:              "datatables",
+	}
+	exporter.ThirdPartyRefAttrs = []string{
+		"rows_filepath",
+		"rows_file_content_hash",
+	}
+	return exporter
+}
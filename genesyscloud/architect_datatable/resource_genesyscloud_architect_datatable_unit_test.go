@@ -0,0 +1,63 @@
+package architect_datatable
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/util/files"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mypurecloud/platform-client-sdk-go/v192/platformclientv2"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnitImportDatatableRows(t *testing.T) {
+	tDatatableId := uuid.NewString()
+	tJobId := uuid.NewString()
+	tUploadUri := "https://apps.mypurecloud.com/uploads/v2/datatables?id=" + tJobId
+
+	rowsFile := filepath.Join(t.TempDir(), "rows.csv")
+	err := os.WriteFile(rowsFile, []byte("key,name\n1,First\n2,Second\n"), 0644)
+	assert.NoError(t, err)
+
+	var uploadedFile string
+	archProxy := &architectDatatableProxy{
+		createDatatableImportJobAttr: func(ctx context.Context, p *architectDatatableProxy, datatableId string) (*DatatableImportJob, *platformclientv2.APIResponse, error) {
+			assert.Equal(t, tDatatableId, datatableId)
+			return &DatatableImportJob{Id: &tJobId, UploadURI: &tUploadUri}, nil, nil
+		},
+		uploadDatatableRowsFileAttr: func(ctx context.Context, p *architectDatatableProxy, uploadUri, filePath string) ([]byte, error) {
+			assert.Equal(t, tUploadUri, uploadUri)
+			uploadedFile = filePath
+			return nil, nil
+		},
+		getDatatableImportJobAttr: func(ctx context.Context, p *architectDatatableProxy, datatableId, jobId string) (*DatatableImportJob, *platformclientv2.APIResponse, error) {
+			assert.Equal(t, tJobId, jobId)
+			return &DatatableImportJob{Id: &tJobId, Status: platformclientv2.String(datatableJobStatusSucceeded)}, nil, nil
+		},
+	}
+
+	resourceDataMap := map[string]interface{}{
+		"name":          "Unit Test Datatable",
+		"rows_filepath": rowsFile,
+	}
+	d := schema.TestResourceDataRaw(t, ResourceArchitectDatatable().Schema, resourceDataMap)
+	d.SetId(tDatatableId)
+
+	diags := importDatatableRows(context.Background(), d, archProxy)
+	assert.False(t, diags.HasError(), diags)
+	assert.Equal(t, rowsFile, uploadedFile)
+
+	expectedHash, err := files.HashFileContent(context.Background(), rowsFile, S3Enabled)
+	assert.NoError(t, err)
+	assert.Equal(t, expectedHash, d.Get("rows_file_content_hash").(string))
+
+	// An unchanged file must not start another import job
+	uploadedFile = ""
+	diags = importDatatableRows(context.Background(), d, archProxy)
+	assert.False(t, diags.HasError(), diags)
+	assert.Equal(t, "", uploadedFile)
+}
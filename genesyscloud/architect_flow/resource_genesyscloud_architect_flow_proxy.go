@@ -24,6 +24,7 @@ type createArchitectFlowJobsFunc func(context.Context, *architectFlowProxy) (*pl
 type getArchitectFlowJobsFunc func(context.Context, *architectFlowProxy, string) (*platformclientv2.Architectjobstateresponse, *platformclientv2.APIResponse, error)
 type getAllArchitectFlowsFunc func(context.Context, *architectFlowProxy, string, []string) (*[]platformclientv2.Flow, *platformclientv2.APIResponse, error)
 type getFlowIdByNameAndTypeFunc func(ctx context.Context, a *architectFlowProxy, name string, varType string) (id string, resp *platformclientv2.APIResponse, retryable bool, err error)
+type publishFlowVersionFunc func(ctx context.Context, a *architectFlowProxy, flowId, version string) (*platformclientv2.APIResponse, error)
 
 type generateDownloadUrlFunc func(a *architectFlowProxy, flowId, flowVersion string) (string, error)
 type createExportJobFunc func(a *architectFlowProxy, flowId, flowVersion string) (_ *platformclientv2.Registerarchitectexportjobresponse, _ *platformclientv2.APIResponse, _ error)
@@ -42,6 +43,7 @@ type architectFlowProxy struct {
 	createArchitectFlowJobsAttr     createArchitectFlowJobsFunc
 	getArchitectFlowJobsAttr        getArchitectFlowJobsFunc
 	getFlowIdByNameAndTypeAttr      getFlowIdByNameAndTypeFunc
+	publishFlowVersionAttr          publishFlowVersionFunc
 	createExportJobAttr             createExportJobFunc
 	getExportJobStatusByIdAttr      getExportJobStatusByIdFunc
 	pollExportJobForDownloadUrlAttr pollExportJobForDownloadUrlFunc
@@ -66,6 +68,7 @@ func newArchitectFlowProxy(clientConfig *platformclientv2.Configuration) *archit
 		createArchitectFlowJobsAttr:     createArchitectFlowJobsFn,
 		getArchitectFlowJobsAttr:        getArchitectFlowJobsFn,
 		getFlowIdByNameAndTypeAttr:      getFlowIdByNameAndTypeFn,
+		publishFlowVersionAttr:          publishFlowVersionFn,
 		generateDownloadUrlAttr:         generateDownloadUrlFn,
 		createExportJobAttr:             createExportJobFn,
 		getExportJobStatusByIdAttr:      getExportJobStatusByIdFn,
@@ -101,6 +104,12 @@ func (a *architectFlowProxy) GetFlowsDeployJob(ctx context.Context, jobId string
 	return a.getArchitectFlowJobsAttr(ctx, a, jobId)
 }
 
+// PublishFlowVersion publishes an existing version of a flow, making it the version used by live interactions.
+// Publishing is asynchronous; the flow's published version is updated once the operation completes.
+func (a *architectFlowProxy) PublishFlowVersion(ctx context.Context, flowId, version string) (*platformclientv2.APIResponse, error) {
+	return a.publishFlowVersionAttr(ctx, a, flowId, version)
+}
+
 // GetAllFlows retrieves all architect flows that match the given criteria using pagination.
 //
 // The function fetches flows in batches of 100 items per page and aggregates them into a single slice.
@@ -297,6 +306,18 @@ func deleteArchitectFlowFn(ctx context.Context, p *architectFlowProxy, flowId st
 	return resp, err
 }
 
+func publishFlowVersionFn(ctx context.Context, p *architectFlowProxy, flowId, version string) (*platformclientv2.APIResponse, error) {
+	ctx = provider.EnsureResourceContext(ctx, ResourceType)
+	log.Printf("Publishing version %s of flow %s", version, flowId)
+	queryParams := customapi.NewQueryParams(map[string]string{"flow": flowId, "version": version})
+	resp, err := customapi.DoNoResponse(ctx, p.customApiClient, customapi.MethodPost, "/api/v2/flows/actions/publish", nil, queryParams)
+	if err != nil {
+		return resp, err
+	}
+	rc.DeleteCacheItem(p.flowCache, flowId)
+	return resp, nil
+}
+
 func createArchitectFlowJobsFn(ctx context.Context, p *architectFlowProxy) (*platformclientv2.Registerarchitectjobresponse, *platformclientv2.APIResponse, error) {
 	// Only set resource context if it doesn't already exist
 	// This preserves resource_name and resource_id that may have been set by the caller
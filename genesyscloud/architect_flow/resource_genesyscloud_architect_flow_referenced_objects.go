@@ -0,0 +1,205 @@
+package architect_flow
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"regexp"
+	"sort"
+	"strconv"
+
+	"github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/util/files"
+	"github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/validators"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"gopkg.in/yaml.v3"
+)
+
+/*
+The resource_genesyscloud_architect_flow_referenced_objects.go file extracts the Genesys Cloud objects a flow
+references by name from its YAML configuration. The result is exposed on the resource as referenced_objects and
+is used by the exporter to add depends_on entries without calling the dependency tracking API.
+*/
+
+// ReferencedObject is a Genesys Cloud object referenced by name from a flow configuration
+type ReferencedObject struct {
+	Type string
+	Name string
+}
+
+// referencedObjectKeys maps the Architect YAML keys that reference objects by name to their Terraform resource types
+var referencedObjectKeys = map[string]string{
+	"queue":          "genesyscloud_routing_queue",
+	"targetQueue":    "genesyscloud_routing_queue",
+	"dataAction":     "genesyscloud_integration_action",
+	"schedule":       "genesyscloud_architect_schedules",
+	"scheduleGroup":  "genesyscloud_architect_schedulegroups",
+	"emergencyGroup": "genesyscloud_architect_emergencygroup",
+	"targetFlow":     ResourceType,
+	"contactList":    "genesyscloud_outbound_contact_list",
+	"wrapupCode":     "genesyscloud_routing_wrapupcode",
+	"skill":          "genesyscloud_routing_skill",
+	"languageSkill":  "genesyscloud_routing_language",
+}
+
+const userPromptResourceType = "genesyscloud_architect_user_prompt"
+
+// userPromptRegex matches user prompts referenced in expressions or literals, e.g. Prompt.welcome_message.
+// System prompts are referenced as PromptSystem.<name> and are not matched.
+var userPromptRegex = regexp.MustCompile(`\bPrompt\.([A-Za-z0-9_]+)`)
+
+var referencedObjectResource = &schema.Resource{
+	Schema: map[string]*schema.Schema{
+		"type": {
+			Description: "The Terraform resource type of the referenced object.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"name": {
+			Description: "The name the flow uses to reference the object.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+	},
+}
+
+// referencedObjectsChanged marks referenced_objects as unknown whenever the flow configuration or its substitutions change
+func referencedObjectsChanged(ctx context.Context, d *schema.ResourceDiff, meta any) bool {
+	return d.HasChanges("substitutions", "template") || validators.ValidateTemplatedFileContentHashChanged("filepath", "file_content_hash", "substitutions", "template", S3Enabled)(ctx, d, meta)
+}
+
+// getReferencedObjectsFromFile reads the flow configuration file and extracts the objects it references after substitutions and templating are applied
+func getReferencedObjectsFromFile(ctx context.Context, path string, substitutions map[string]any, templateSettings *files.TemplateSettings) ([]ReferencedObject, error) {
+	rendered, err := readRenderedFlowFile(ctx, path, substitutions, templateSettings)
+	if err != nil {
+		return nil, err
+	}
+	return extractReferencedObjects(rendered)
+}
+
+// readRenderedFlowFile reads the flow configuration file and applies the substitutions and template settings to it
+func readRenderedFlowFile(ctx context.Context, path string, substitutions map[string]any, templateSettings *files.TemplateSettings) (string, error) {
+	reader, file, err := files.DownloadOrOpenFile(ctx, path, S3Enabled)
+	if err != nil {
+		return "", err
+	}
+	if file != nil {
+		defer file.Close()
+	}
+
+	content, err := io.ReadAll(reader)
+	if err != nil {
+		return "", err
+	}
+	return files.RenderFileContent(string(content), substitutions, templateSettings)
+}
+
+// extractReferencedObjects parses the flow configuration and returns the sorted, de-duplicated list of objects it references by name
+func extractReferencedObjects(content string) ([]ReferencedObject, error) {
+	var document yaml.Node
+	if err := yaml.Unmarshal([]byte(content), &document); err != nil {
+		return nil, fmt.Errorf("failed to parse flow configuration: %v", err)
+	}
+
+	found := make(map[ReferencedObject]bool)
+	collectReferencedObjects(&document, found)
+
+	objects := make([]ReferencedObject, 0, len(found))
+	for object := range found {
+		objects = append(objects, object)
+	}
+	sort.Slice(objects, func(i, j int) bool {
+		if objects[i].Type != objects[j].Type {
+			return objects[i].Type < objects[j].Type
+		}
+		return objects[i].Name < objects[j].Name
+	})
+	return objects, nil
+}
+
+func collectReferencedObjects(node *yaml.Node, found map[ReferencedObject]bool) {
+	switch node.Kind {
+	case yaml.ScalarNode:
+		for _, match := range userPromptRegex.FindAllStringSubmatch(node.Value, -1) {
+			found[ReferencedObject{Type: userPromptResourceType, Name: match[1]}] = true
+		}
+	case yaml.MappingNode:
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			key, value := node.Content[i], node.Content[i+1]
+			if resourceType, ok := referencedObjectKeys[key.Value]; ok {
+				if name := getReferencedName(value); name != "" {
+					found[ReferencedObject{Type: resourceType, Name: name}] = true
+				}
+			}
+			collectReferencedObjects(value, found)
+		}
+	default:
+		for _, child := range node.Content {
+			collectReferencedObjects(child, found)
+		}
+	}
+}
+
+// getReferencedName returns the literal name of a reference written either as `lit: {name: X}` or `name: X`.
+// References resolved from expressions at runtime cannot be determined and return an empty string.
+func getReferencedName(node *yaml.Node) string {
+	if node.Kind != yaml.MappingNode {
+		return ""
+	}
+	if lit := getMappingValue(node, "lit"); lit != nil {
+		node = lit
+	}
+	if name := getMappingValue(node, "name"); name != nil && name.Kind == yaml.ScalarNode {
+		return name.Value
+	}
+	return ""
+}
+
+func getMappingValue(node *yaml.Node, key string) *yaml.Node {
+	if node.Kind != yaml.MappingNode {
+		return nil
+	}
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		if node.Content[i].Value == key {
+			return node.Content[i+1]
+		}
+	}
+	return nil
+}
+
+func flattenReferencedObjects(objects []ReferencedObject) []interface{} {
+	objectList := make([]interface{}, 0, len(objects))
+	for _, object := range objects {
+		objectList = append(objectList, map[string]interface{}{
+			"type": object.Type,
+			"name": object.Name,
+		})
+	}
+	return objectList
+}
+
+// setReferencedObjectsStateAttributes writes the referenced objects to flattened instance state attributes
+func setReferencedObjectsStateAttributes(attributes map[string]string, objects []ReferencedObject) {
+	attributes["referenced_objects.#"] = strconv.Itoa(len(objects))
+	for i, object := range objects {
+		attributes[fmt.Sprintf("referenced_objects.%d.type", i)] = object.Type
+		attributes[fmt.Sprintf("referenced_objects.%d.name", i)] = object.Name
+	}
+}
+
+// GetReferencedObjectsFromStateAttributes reads the referenced objects of a flow from its flattened instance state attributes
+func GetReferencedObjectsFromStateAttributes(attributes map[string]string) []ReferencedObject {
+	count, err := strconv.Atoi(attributes["referenced_objects.#"])
+	if err != nil {
+		return nil
+	}
+
+	objects := make([]ReferencedObject, 0, count)
+	for i := 0; i < count; i++ {
+		objects = append(objects, ReferencedObject{
+			Type: attributes[fmt.Sprintf("referenced_objects.%d.type", i)],
+			Name: attributes[fmt.Sprintf("referenced_objects.%d.name", i)],
+		})
+	}
+	return objects
+}
@@ -9,6 +9,7 @@ package architect_flow
 import (
 	"strings"
 
+	"github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/util/files"
 	"github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/validators"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
@@ -78,7 +79,9 @@ Export block label: "{type}_{name}"`,
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 		CustomizeDiff: customdiff.All(
-			customdiff.ComputedIf("file_content_hash", validators.ValidateFileContentHashChanged("filepath", "file_content_hash", S3Enabled)),
+			customdiff.ComputedIf("file_content_hash", validators.ValidateTemplatedFileContentHashChanged("filepath", "file_content_hash", "substitutions", "template", S3Enabled)),
+			customdiff.ComputedIf("referenced_objects", referencedObjectsChanged),
+			validateFlowYaml,
 		),
 		SchemaVersion: 1,
 		Schema: map[string]*schema.Schema{
@@ -111,10 +114,61 @@ Export block label: "{type}_{name}"`,
 				Optional:    true,
 			},
 			"substitutions": {
-				Description: "A substitution is a key value pair where the key is the value you want to replace, and the value is the value to substitute in its place.",
+				Description: "A substitution is a key value pair where the key is the value you want to replace, and the value is the value to substitute in its place. When substitutions are set, any `{{placeholder}}` in a local file without a matching substitution is reported during plan.",
 				Type:        schema.TypeMap,
 				Optional:    true,
 			},
+			"template": {
+				Description: "Opt-in templating of the YAML file with Go `text/template` syntax, supporting conditionals, loops over lists and typed values. The file is rendered after `substitutions` are applied and `file_content_hash` hashes the rendered output.",
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Elem:        files.TemplateResource,
+			},
+			"referenced_objects": {
+				Description: "Objects the flow references by name in its YAML configuration, such as queues, data actions, user prompts, schedules and other flows. Only literal references are detected; references built from expressions at runtime are not included.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        referencedObjectResource,
+			},
+			"publish": {
+				Description: "Whether the deployed configuration is published. When false, the configuration is kept as a new unpublished version and the version that was published before the update is republished, e.g. for UAT. The Archy deploy job always publishes, so the deployed version is live until the previous version is republished. A flow without a published version has no version to republish, so the first deployment of a flow is published regardless of this setting.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+			},
+			"pinned_version": {
+				Description: "Version of the flow to publish, e.g. `3.0`. Takes precedence over `publish`; configuration changes are kept as unpublished versions while a version is pinned.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"rollback_on_failure": {
+				Description: "Republish `previous_published_version` when the post-publish validation fails. The validation checks that the intended version becomes the flow's published version.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+			},
+			"rollback_on_destroy": {
+				Description: "On destroy, republish `previous_published_version` instead of deleting the flow. Flows without a previous published version are deleted.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+			},
+			"deployed_version": {
+				Description: "Version of the flow created by the most recent deployment of its configuration.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"published_version": {
+				Description: "Version of the flow that is currently published.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"previous_published_version": {
+				Description: "Version of the flow that was published before the most recent update. Used for rollbacks.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
 			"force_unlock": {
 				Description: `Will perform a force unlock on an architect flow before beginning the publication process.  NOTE: The force unlock publishes the 'draft'
 				              architect flow and then publishes the flow named in this resource. This mirrors the behavior found in the archy CLI tool.`,
@@ -180,4 +180,12 @@ func updateResourceConfigAndState(configMap map[string]any, resource resourceExp
 	} else {
 		resource.State.Attributes["file_content_hash"] = hash
 	}
+
+	// Record the objects referenced in the exported file so the exporter can add depends_on entries for them
+	referencedObjects, err := getReferencedObjectsFromFile(context.Background(), exportFilePathIncludingExportDirName, nil, nil)
+	if err != nil {
+		log.Printf("Error determining objects referenced by exported flow '%s': %s", exportFilePathIncludingExportDirName, err)
+	} else {
+		setReferencedObjectsStateAttributes(resource.State.Attributes, referencedObjects)
+	}
 }
@@ -0,0 +1,219 @@
+package architect_flow
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/util"
+	"github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/util/files"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+/*
+The resource_genesyscloud_architect_flow_versions.go file controls which version of a flow is published. The Archy
+deploy job always publishes the uploaded configuration, so drafts and configurations deployed alongside a pinned version
+republish the version that was live before the update as soon as the job finishes. Rollbacks republish the same version.
+*/
+
+const publishValidationTimeout = 2 * time.Minute
+
+// flowVersionSettings holds the version related settings of a flow resource
+type flowVersionSettings struct {
+	publish           bool
+	pinnedVersion     string
+	rollbackOnFailure bool
+}
+
+func getFlowVersionSettings(d *schema.ResourceData) flowVersionSettings {
+	return flowVersionSettings{
+		publish:           d.Get("publish").(bool),
+		pinnedVersion:     d.Get("pinned_version").(string),
+		rollbackOnFailure: d.Get("rollback_on_failure").(bool),
+	}
+}
+
+// keepsDeployedVersion reports whether the version published by the Archy deploy job stays published. A flow without a
+// published version has no version to republish, so its first deployment is always published.
+func (s flowVersionSettings) keepsDeployedVersion(previousVersion string) bool {
+	return previousVersion == "" || (s.publish && s.pinnedVersion == "")
+}
+
+// flowConfigurationChanged reports whether an update changes the configuration of the flow rather than only the
+// version that should be published
+func flowConfigurationChanged(d *schema.ResourceData) bool {
+	return d.Id() == "" || d.HasChanges("filepath", "file_content_hash", "update_trigger_hash", "substitutions", "template")
+}
+
+// getPublishedFlowVersion returns the published version of a flow, or an empty string if the flow has never been published
+func getPublishedFlowVersion(ctx context.Context, p *architectFlowProxy, flowId string) (string, error) {
+	flow, resp, err := p.GetFlow(ctx, flowId)
+	if err != nil {
+		if util.IsStatus404(resp) {
+			return "", nil
+		}
+		return "", fmt.Errorf("failed to read flow %s: %v", flowId, err)
+	}
+	if flow.PublishedVersion == nil || flow.PublishedVersion.Id == nil {
+		return "", nil
+	}
+	return *flow.PublishedVersion.Id, nil
+}
+
+// publishAndValidateFlowVersion publishes a version of a flow and waits until Genesys Cloud reports it as the published version
+func publishAndValidateFlowVersion(ctx context.Context, p *architectFlowProxy, flowId, version string, timeout time.Duration) diag.Diagnostics {
+	resp, err := p.PublishFlowVersion(ctx, flowId, version)
+	if err != nil {
+		return util.BuildAPIDiagnosticError(ResourceType, fmt.Sprintf("failed to publish version %s of flow %s: %s", version, flowId, err), resp)
+	}
+	return validatePublishedFlowVersion(ctx, p, flowId, version, timeout)
+}
+
+// validatePublishedFlowVersion is the post-publish validation. It waits until expectedVersion is the published version of the flow.
+func validatePublishedFlowVersion(ctx context.Context, p *architectFlowProxy, flowId, expectedVersion string, timeout time.Duration) diag.Diagnostics {
+	return util.WithRetries(ctx, timeout, func() *retry.RetryError {
+		publishedVersion, err := getPublishedFlowVersion(ctx, p, flowId)
+		if err != nil {
+			return retry.NonRetryableError(err)
+		}
+		if publishedVersion != expectedVersion {
+			return retry.RetryableError(fmt.Errorf("flow %s has published version %q, expected version %q", flowId, publishedVersion, expectedVersion))
+		}
+		return nil
+	})
+}
+
+// waitForDeployedFlowVersion is the post-publish validation of a deploy job. It waits until the flow has a published
+// version other than previousVersion, the version that was published before the update, and returns it.
+func waitForDeployedFlowVersion(ctx context.Context, p *architectFlowProxy, flowId, previousVersion string, timeout time.Duration) (string, diag.Diagnostics) {
+	var deployedVersion string
+	diags := util.WithRetries(ctx, timeout, func() *retry.RetryError {
+		publishedVersion, err := getPublishedFlowVersion(ctx, p, flowId)
+		if err != nil {
+			return retry.NonRetryableError(err)
+		}
+		if publishedVersion == "" || publishedVersion == previousVersion {
+			return retry.RetryableError(fmt.Errorf("flow %s still has published version %q after the deploy job", flowId, publishedVersion))
+		}
+		deployedVersion = publishedVersion
+		return nil
+	})
+	return deployedVersion, diags
+}
+
+// rollBackFlowVersion republishes previousVersion after the post-publish validation of the version described by failedVersion returned diags
+func rollBackFlowVersion(ctx context.Context, p *architectFlowProxy, flowId, previousVersion, failedVersion string, diags diag.Diagnostics) (string, diag.Diagnostics) {
+	log.Printf("Post-publish validation failed for flow %s, rolling back to version %s", flowId, previousVersion)
+	if rollbackDiags := publishAndValidateFlowVersion(ctx, p, flowId, previousVersion, publishValidationTimeout); rollbackDiags.HasError() {
+		return "", append(diags, rollbackDiags...)
+	}
+	return previousVersion, append(diags, util.BuildDiagnosticError(ResourceType, fmt.Sprintf("rolled back flow %s to version %s after post-publish validation of %s failed", flowId, previousVersion, failedVersion), fmt.Errorf("post-publish validation failed"))...)
+}
+
+// publishFlowVersionWithRollback publishes targetVersion and, when rollbackOnFailure is set, republishes previousVersion
+// if it does not become the published version. It returns the version that is published afterwards.
+func publishFlowVersionWithRollback(ctx context.Context, p *architectFlowProxy, flowId, targetVersion, previousVersion string, rollbackOnFailure bool) (string, diag.Diagnostics) {
+	if targetVersion == previousVersion {
+		return targetVersion, nil
+	}
+	diags := publishAndValidateFlowVersion(ctx, p, flowId, targetVersion, publishValidationTimeout)
+	if !diags.HasError() {
+		return targetVersion, nil
+	}
+	if !rollbackOnFailure || previousVersion == "" {
+		return "", diags
+	}
+	return rollBackFlowVersion(ctx, p, flowId, previousVersion, "version "+targetVersion, diags)
+}
+
+// applyDeployJobFlowVersion runs after a successful deploy job. It determines the version the job published, rolling back
+// to previousVersion when rollback_on_failure is set and that version does not replace it, and publishes the pinned version
+// if one is set. It returns the version published by the deploy job and the version that is published afterwards.
+func applyDeployJobFlowVersion(ctx context.Context, p *architectFlowProxy, flowId string, settings flowVersionSettings, previousVersion string) (deployedVersion, publishedVersion string, diags diag.Diagnostics) {
+	if settings.rollbackOnFailure && previousVersion != "" {
+		var validationDiags diag.Diagnostics
+		if deployedVersion, validationDiags = waitForDeployedFlowVersion(ctx, p, flowId, previousVersion, publishValidationTimeout); validationDiags.HasError() {
+			publishedVersion, diags = rollBackFlowVersion(ctx, p, flowId, previousVersion, "the version deployed by the deploy job", validationDiags)
+			return "", publishedVersion, diags
+		}
+	} else {
+		var err error
+		if deployedVersion, err = getPublishedFlowVersion(ctx, p, flowId); err != nil {
+			return "", "", util.BuildDiagnosticError(ResourceType, fmt.Sprintf("failed to determine the version deployed for flow %s", flowId), err)
+		}
+	}
+
+	if !settings.publish && settings.pinnedVersion == "" {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  fmt.Sprintf("Flow %s was published", flowId),
+			Detail:   "publish is false but the flow had no published version to check the configuration in against, so the deployed version is published.",
+		})
+	}
+
+	if settings.pinnedVersion == "" {
+		return deployedVersion, deployedVersion, diags
+	}
+	log.Printf("Deploy job published version %s of flow %s, publishing pinned version %s instead", deployedVersion, flowId, settings.pinnedVersion)
+	publishedVersion, pinDiags := publishFlowVersionWithRollback(ctx, p, flowId, settings.pinnedVersion, previousVersion, settings.rollbackOnFailure)
+	return deployedVersion, publishedVersion, append(diags, pinDiags...)
+}
+
+// deployDraftFlowConfiguration deploys the configuration of a flow without leaving it published. Archy is the only
+// tooling that understands the flow YAML format and its deploy job always publishes, so the version the job deployed is
+// replaced by previousVersion as soon as it is live. The flow is unlocked if anything fails, so a failed job or
+// republish never leaves it locked for later deployments or edits in the UI. It returns the deployed version.
+func deployDraftFlowConfiguration(ctx context.Context, d *schema.ResourceData, p *architectFlowProxy, flowName, filePath string, substitutions map[string]any, templateSettings *files.TemplateSettings, previousVersion string) (deployedVersion string, diags diag.Diagnostics) {
+	flowId := d.Id()
+	defer func() {
+		if diags.HasError() {
+			diags = append(diags, unlockFlowAfterFailure(ctx, p, flowId)...)
+		}
+	}()
+
+	if _, diags = deployFlowWithJob(ctx, d, p, flowName, filePath, substitutions, templateSettings); diags.HasError() {
+		return "", diags
+	}
+	return restoreDraftFlowVersion(ctx, p, flowId, previousVersion)
+}
+
+// restoreDraftFlowVersion waits for the version published by a deploy job and republishes previousVersion, keeping the
+// deployed version as the latest unpublished version of the flow. It returns the deployed version.
+func restoreDraftFlowVersion(ctx context.Context, p *architectFlowProxy, flowId, previousVersion string) (string, diag.Diagnostics) {
+	deployedVersion, diags := waitForDeployedFlowVersion(ctx, p, flowId, previousVersion, publishValidationTimeout)
+	if diags.HasError() {
+		return "", diags
+	}
+	log.Printf("Deploy job published version %s of flow %s, republishing version %s", deployedVersion, flowId, previousVersion)
+	if diags := publishAndValidateFlowVersion(ctx, p, flowId, previousVersion, publishValidationTimeout); diags.HasError() {
+		return "", diags
+	}
+	return deployedVersion, nil
+}
+
+// unlockFlowAfterFailure releases the lock a failed deployment may have left on a flow. A failed unlock is reported as a
+// warning so it does not hide the error that caused it.
+func unlockFlowAfterFailure(ctx context.Context, p *architectFlowProxy, flowId string) diag.Diagnostics {
+	if resp, err := p.ForceUnlockFlow(ctx, flowId); err != nil {
+		log.Printf("Failed to unlock flow %s after a failed deployment: %v", flowId, err)
+		return diag.Diagnostics{{
+			Severity: diag.Warning,
+			Summary:  fmt.Sprintf("Failed to unlock flow %s", flowId),
+			Detail:   fmt.Sprintf("The flow may still be locked after the failed deployment: %v %s", err, resp),
+		}}
+	}
+	return nil
+}
+
+// applyDraftFlowVersion runs after the configuration was deployed as a draft. The version published before the update
+// stays published unless a pinned version is set. It returns the version that is published afterwards.
+func applyDraftFlowVersion(ctx context.Context, p *architectFlowProxy, flowId string, settings flowVersionSettings, previousVersion string) (string, diag.Diagnostics) {
+	if settings.pinnedVersion == "" {
+		return previousVersion, nil
+	}
+	return publishFlowVersionWithRollback(ctx, p, flowId, settings.pinnedVersion, previousVersion, settings.rollbackOnFailure)
+}
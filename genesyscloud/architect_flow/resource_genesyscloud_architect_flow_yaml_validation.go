@@ -0,0 +1,156 @@
+package architect_flow
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"net/url"
+	"os"
+	"regexp"
+	"strings"
+
+	"github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/util/files"
+	"github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/util/lists"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"gopkg.in/yaml.v3"
+)
+
+/*
+The resource_genesyscloud_architect_flow_yaml_validation.go file performs a local structural check of the flow
+YAML during plan so malformed configuration is reported before a deploy job is started in Genesys Cloud. Only local
+files that already exist are checked: files created during the same apply, and S3 or HTTP files, which would otherwise
+be downloaded a second time on every plan, are left to the deploy job.
+*/
+
+// requiredFlowSections are the sections every flow definition must declare under its flow type
+var requiredFlowSections = []string{
+	"name",
+	"defaultLanguage",
+}
+
+var placeholderRegex = regexp.MustCompile(`{{([^{}]+)}}`)
+
+// validateFlowYaml is a CustomizeDiff function that validates the flow configuration file after substitutions are applied
+func validateFlowYaml(_ context.Context, d *schema.ResourceDiff, _ any) error {
+	if !d.NewValueKnown("filepath") || !d.NewValueKnown("substitutions") || !d.NewValueKnown("template") {
+		log.Printf("Skipping flow YAML validation for %s as the file path, substitutions or template are not yet known", d.Id())
+		return nil
+	}
+
+	path := d.Get("filepath").(string)
+	if path == "" {
+		return nil
+	}
+
+	content, found, err := readLocalFlowConfiguration(path)
+	if err != nil {
+		return fmt.Errorf("failed to read flow configuration file %s: %v", path, err)
+	}
+	if !found {
+		log.Printf("Skipping flow YAML validation for %s as %s is not a local file that exists yet", d.Id(), path)
+		return nil
+	}
+
+	substitutions, _ := d.Get("substitutions").(map[string]any)
+	templateSettings, err := files.BuildTemplateSettings(d, "template")
+	if err != nil {
+		return err
+	}
+	if err := validateFlowYamlContent(string(content), substitutions, templateSettings); err != nil {
+		return fmt.Errorf("invalid flow configuration file %s: %w", path, err)
+	}
+	return nil
+}
+
+// readLocalFlowConfiguration returns the content of a local flow configuration file. found is false when the path is
+// an S3 or HTTP URL, or a local file that does not exist yet because another resource creates it during the apply.
+func readLocalFlowConfiguration(path string) (content []byte, found bool, err error) {
+	if u, err := url.ParseRequestURI(path); err == nil && u.Scheme != "" {
+		return nil, false, nil
+	}
+	content, err = os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return nil, false, nil
+	}
+	if err != nil {
+		return nil, false, err
+	}
+	return content, true, nil
+}
+
+// validateFlowYamlContent checks that the flow configuration parses as YAML, declares a single known flow type and
+// contains the required sections for that flow type. When substitutions are set, it also checks that every placeholder
+// has a matching substitution, while literal {{placeholders}} are allowed in flows without substitutions. When templating
+// is enabled, the template itself reports unresolved values and the rendered output is validated.
+func validateFlowYamlContent(content string, substitutions map[string]any, templateSettings *files.TemplateSettings) error {
+	if templateSettings == nil && len(substitutions) > 0 {
+		if err := findUnresolvedPlaceholders(content, substitutions); err != nil {
+			return err
+		}
+	}
+
+	rendered, err := files.RenderFileContent(content, substitutions, templateSettings)
+	if err != nil {
+		return err
+	}
+
+	var document yaml.Node
+	if err := yaml.Unmarshal([]byte(rendered), &document); err != nil {
+		return fmt.Errorf("failed to parse YAML: %v", err)
+	}
+	if len(document.Content) == 0 {
+		return errors.New("file is empty")
+	}
+
+	root := document.Content[0]
+	if root.Kind != yaml.MappingNode || len(root.Content) == 0 {
+		return fmt.Errorf("line %d: expected a mapping with the flow type as its only key", root.Line)
+	}
+	if len(root.Content) > 2 {
+		return fmt.Errorf("line %d: expected a single flow type at the top level, found %d keys", root.Content[2].Line, len(root.Content)/2)
+	}
+
+	flowTypeNode, flowNode := root.Content[0], root.Content[1]
+	if !lists.ItemInSlice(strings.ToLower(flowTypeNode.Value), validFlowTypes) {
+		return fmt.Errorf("line %d: unknown flow type %q. Valid options: %s", flowTypeNode.Line, flowTypeNode.Value, strings.Join(validFlowTypes, ", "))
+	}
+	if flowNode.Kind != yaml.MappingNode {
+		return fmt.Errorf("line %d: the %s flow definition must be a mapping", flowNode.Line, flowTypeNode.Value)
+	}
+
+	declaredSections := make(map[string]bool)
+	for i := 0; i < len(flowNode.Content); i += 2 {
+		declaredSections[flowNode.Content[i].Value] = true
+	}
+
+	var missingSections []string
+	for _, section := range requiredFlowSections {
+		if !declaredSections[section] {
+			missingSections = append(missingSections, section)
+		}
+	}
+	if len(missingSections) > 0 {
+		return fmt.Errorf("line %d: the %s flow definition is missing required sections: %s", flowTypeNode.Line, flowTypeNode.Value, strings.Join(missingSections, ", "))
+	}
+
+	return nil
+}
+
+// findUnresolvedPlaceholders reports every {{placeholder}} in content that has no matching substitution, along with its line number
+func findUnresolvedPlaceholders(content string, substitutions map[string]any) error {
+	var unresolved []string
+	for i, line := range strings.Split(content, "\n") {
+		for _, match := range placeholderRegex.FindAllStringSubmatch(line, -1) {
+			if _, ok := substitutions[match[1]]; !ok {
+				unresolved = append(unresolved, fmt.Sprintf("line %d: %s", i+1, match[0]))
+			}
+		}
+	}
+
+	if len(unresolved) > 0 {
+		return fmt.Errorf("unresolved placeholders without a matching substitution:\n%s", strings.Join(unresolved, "\n"))
+	}
+	return nil
+}
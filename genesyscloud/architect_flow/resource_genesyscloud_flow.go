@@ -83,6 +83,9 @@ func readFlow(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagno
 
 		resourcedata.SetNillableValue(d, "name", flow.Name)
 		resourcedata.SetNillableValue(d, "type", flow.VarType)
+		if flow.PublishedVersion != nil {
+			resourcedata.SetNillableValue(d, "published_version", flow.PublishedVersion.Id)
+		}
 
 		log.Printf("Read flow %s, %s", d.Id(), *flow.Name)
 		return nil
@@ -145,6 +148,74 @@ func updateFlow(ctx context.Context, d *schema.ResourceData, meta any) (diags di
 		}
 	}
 
+	// Record the version that is live before the update so drafts, pins and rollbacks can republish it
+	var previousVersion string
+	if d.Id() != "" {
+		version, err := getPublishedFlowVersion(ctx, p, d.Id())
+		if err != nil {
+			setFileContentHashToNil(d)
+			return util.BuildDiagnosticError(ResourceType, fmt.Sprintf("Failed to get the published version of flow %s", d.Id()), err)
+		}
+		previousVersion = version
+	}
+
+	settings := getFlowVersionSettings(d)
+	if !flowConfigurationChanged(d) {
+		return updateFlowPublishedVersion(ctx, d, meta, p, settings, previousVersion)
+	}
+
+	filePath := d.Get("filepath").(string)
+	substitutions := d.Get("substitutions").(map[string]any)
+	templateSettings, err := files.BuildTemplateSettings(d, "template")
+	if err != nil {
+		setFileContentHashToNil(d)
+		return append(diags, diag.FromErr(err)...)
+	}
+
+	var flowID, deployedVersion, publishedVersion string
+	var versionDiags diag.Diagnostics
+	if settings.keepsDeployedVersion(previousVersion) {
+		flowID, versionDiags = deployFlowWithJob(ctx, d, p, flowName, filePath, substitutions, templateSettings)
+		if versionDiags.HasError() {
+			setFileContentHashToNil(d)
+			return append(diags, versionDiags...)
+		}
+		d.SetId(flowID)
+		deployedVersion, publishedVersion, versionDiags = applyDeployJobFlowVersion(ctx, p, flowID, settings, previousVersion)
+	} else {
+		flowID = d.Id()
+		log.Printf("Deploying flow %s, %s without publishing it", flowName, flowID)
+		if deployedVersion, versionDiags = deployDraftFlowConfiguration(ctx, d, p, flowName, filePath, substitutions, templateSettings, previousVersion); !versionDiags.HasError() {
+			publishedVersion, versionDiags = applyDraftFlowVersion(ctx, p, flowID, settings, previousVersion)
+		}
+	}
+	diags = append(diags, versionDiags...)
+	if diags.HasError() {
+		setFileContentHashToNil(d)
+		return diags
+	}
+	_ = d.Set("deployed_version", deployedVersion)
+	_ = d.Set("published_version", publishedVersion)
+	_ = d.Set("previous_published_version", previousVersion)
+
+	filePathHash, err := files.HashRenderedFileContent(ctx, filePath, S3Enabled, substitutions, templateSettings)
+	if err != nil {
+		return append(diags, util.BuildDiagnosticError(ResourceType, fmt.Sprintf("Failed to get the file content hash for the flow %s", flowID), err)...)
+	}
+	_ = d.Set("file_content_hash", filePathHash)
+
+	referencedObjects, err := getReferencedObjectsFromFile(ctx, filePath, substitutions, templateSettings)
+	if err != nil {
+		log.Printf("Unable to determine the objects referenced by flow %s: %v", flowID, err)
+	}
+	_ = d.Set("referenced_objects", flattenReferencedObjects(referencedObjects))
+
+	log.Printf("Updated flow %s, %s", flowName, d.Id())
+	return append(diags, readFlow(ctx, d, meta)...)
+}
+
+// deployFlowWithJob uploads the flow configuration to an Archy deploy job, which publishes it, and returns the id of the flow
+func deployFlowWithJob(ctx context.Context, d *schema.ResourceData, p *architectFlowProxy, flowName, filePath string, substitutions map[string]any, templateSettings *files.TemplateSettings) (string, diag.Diagnostics) {
 	flowJob, response, err := p.CreateFlowsDeployJob(ctx)
 
 	if err != nil || response.Error != nil {
@@ -154,31 +225,26 @@ func updateFlow(ctx context.Context, d *schema.ResourceData, meta any) (diags di
 		} else {
 			errorString = response.ErrorMessage
 		}
-		setFileContentHashToNil(d)
-		return util.BuildAPIDiagnosticError(ResourceType, fmt.Sprintf("Failed to register job %s", errorString), response)
+		return "", util.BuildAPIDiagnosticError(ResourceType, fmt.Sprintf("Failed to register job %s", errorString), response)
 	}
 
 	presignedUrl := *flowJob.PresignedUrl
 	jobId := *flowJob.Id
 	headers := *flowJob.Headers
 
-	filePath := d.Get("filepath").(string)
-	substitutions := d.Get("substitutions").(map[string]any)
-
 	reader, _, err := files.DownloadOrOpenFile(ctx, filePath, S3Enabled)
 	if err != nil {
-		setFileContentHashToNil(d)
-		return append(diags, diag.FromErr(err)...)
+		return "", diag.FromErr(err)
 	}
 
 	log.Printf("Uploading flow  %s, %s, %s", flowName, d.Id(), jobId)
 
 	s3Uploader := files.NewS3Uploader(reader, nil, substitutions, headers, "PUT", presignedUrl)
+	s3Uploader.SetTemplateSettings(templateSettings)
 
 	_, uploadErr := s3Uploader.UploadWithRetries(ctx, filePath, 20*time.Second)
 	if uploadErr != nil {
-		setFileContentHashToNil(d)
-		return append(diags, diag.FromErr(uploadErr)...)
+		return "", diag.FromErr(uploadErr)
 	}
 
 	log.Printf("Uploaded flow %s, %s, %s", flowName, d.Id(), jobId)
@@ -239,24 +305,36 @@ func updateFlow(ctx context.Context, d *schema.ResourceData, meta any) (diags di
 	})
 
 	if retryErr != nil {
-		setFileContentHashToNil(d)
-		return append(diags, retryErr...)
+		return "", retryErr
 	}
 
 	if flowID == "" {
-		setFileContentHashToNil(d)
-		return append(diags, util.BuildDiagnosticError(ResourceType, fmt.Sprintf("Failed to get the flowId from Architect Job (%s).", jobId), fmt.Errorf("FlowID is nil"))...)
+		return "", util.BuildDiagnosticError(ResourceType, fmt.Sprintf("Failed to get the flowId from Architect Job (%s).", jobId), fmt.Errorf("FlowID is nil"))
 	}
 
-	filePathHash, err := files.HashFileContent(ctx, filePath, S3Enabled)
-	if err != nil {
-		return append(diags, util.BuildDiagnosticError(ResourceType, fmt.Sprintf("Failed to get the file content hash for the flow %s", flowID), err)...)
-	}
-	_ = d.Set("file_content_hash", filePathHash)
+	return flowID, nil
+}
 
-	d.SetId(flowID)
+// updateFlowPublishedVersion handles updates that leave the configuration of the flow unchanged, such as a change of
+// pinned_version. The existing version is published without running a deploy job.
+func updateFlowPublishedVersion(ctx context.Context, d *schema.ResourceData, meta any, p *architectFlowProxy, settings flowVersionSettings, previousVersion string) diag.Diagnostics {
+	targetVersion := settings.pinnedVersion
+	if targetVersion == "" {
+		targetVersion = previousVersion
+		if deployedVersion := d.Get("deployed_version").(string); settings.publish && deployedVersion != "" {
+			targetVersion = deployedVersion
+		}
+	}
 
-	log.Printf("Updated flow %s, %s", flowName, d.Id())
+	log.Printf("Publishing version %s of flow %s without redeploying it", targetVersion, d.Id())
+	publishedVersion, diags := publishFlowVersionWithRollback(ctx, p, d.Id(), targetVersion, previousVersion, settings.rollbackOnFailure)
+	if diags.HasError() {
+		return diags
+	}
+	if publishedVersion != previousVersion {
+		_ = d.Set("previous_published_version", previousVersion)
+	}
+	_ = d.Set("published_version", publishedVersion)
 	return append(diags, readFlow(ctx, d, meta)...)
 }
 
@@ -277,6 +355,11 @@ func deleteFlow(ctx context.Context, d *schema.ResourceData, meta any) diag.Diag
 	// Set resource context for SDK debug logging before entering retry loop
 	ctx = util.SetResourceContext(ctx, d, ResourceType)
 
+	if previousVersion := d.Get("previous_published_version").(string); d.Get("rollback_on_destroy").(bool) && previousVersion != "" {
+		log.Printf("Rolling back flow %s to version %s instead of deleting it", d.Id(), previousVersion)
+		return publishAndValidateFlowVersion(ctx, p, d.Id(), previousVersion, publishValidationTimeout)
+	}
+
 	return util.WithRetries(ctx, 30*time.Second, func() *retry.RetryError {
 		resp, err := p.DeleteFlow(ctx, d.Id())
 		if err != nil {
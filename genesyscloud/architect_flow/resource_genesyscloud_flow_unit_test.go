@@ -1,10 +1,15 @@
 package architect_flow
 
 import (
+	"context"
 	"fmt"
+	"os"
+	"path/filepath"
 	"strings"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/mypurecloud/platform-client-sdk-go/v192/platformclientv2"
 )
 
@@ -222,3 +227,346 @@ func TestUnitPollExportJobForDownloadUrlFn(t *testing.T) {
 		})
 	}
 }
+
+func TestUnitValidateFlowYamlContent(t *testing.T) {
+	tests := []struct {
+		name          string
+		content       string
+		substitutions map[string]any
+		expectedError string
+	}{
+		{
+			name:    "Should accept a valid flow after substitutions",
+			content: "inboundCall:\n  name: \"{{flow_name}}\"\n  defaultLanguage: en-us\n",
+			substitutions: map[string]any{
+				"flow_name": "My Flow",
+			},
+		},
+		{
+			name:          "Should report unresolved placeholders with line numbers",
+			content:       "inboundCall:\n  name: \"{{flow_name}}\"\n  defaultLanguage: \"{{language}}\"\n",
+			substitutions: map[string]any{"flow_name": "My Flow"},
+			expectedError: "line 3: {{language}}",
+		},
+		{
+			name:    "Should accept literal placeholders in a flow without substitutions",
+			content: "inboundCall:\n  name: My Flow\n  defaultLanguage: en-us\n  description: \"{{not a substitution}}\"\n",
+		},
+		{
+			name:          "Should reject malformed YAML",
+			content:       "inboundCall:\n  name: [unclosed\n",
+			expectedError: "failed to parse YAML",
+		},
+		{
+			name:          "Should reject an unknown flow type",
+			content:       "inboundCal:\n  name: My Flow\n  defaultLanguage: en-us\n",
+			expectedError: "line 1: unknown flow type \"inboundCal\"",
+		},
+		{
+			name:          "Should reject more than one flow type",
+			content:       "inboundCall:\n  name: My Flow\n  defaultLanguage: en-us\nworkflow:\n  name: Other\n",
+			expectedError: "line 4: expected a single flow type",
+		},
+		{
+			name:          "Should report missing required sections",
+			content:       "workflow:\n  name: My Flow\n",
+			expectedError: "missing required sections: defaultLanguage",
+		},
+		{
+			name:          "Should reject an empty file",
+			content:       "",
+			expectedError: "file is empty",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateFlowYamlContent(tt.content, tt.substitutions, nil)
+
+			if tt.expectedError != "" {
+				if err == nil {
+					t.Errorf("expected error containing %q, got nil", tt.expectedError)
+					return
+				}
+				if !strings.Contains(err.Error(), tt.expectedError) {
+					t.Errorf("expected error containing %q, got %q", tt.expectedError, err.Error())
+				}
+				return
+			}
+
+			if err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+		})
+	}
+}
+
+func TestUnitReadLocalFlowConfiguration(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "flow.yaml")
+	if err := os.WriteFile(path, []byte("inboundCall:\n  name: My Flow\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name          string
+		path          string
+		expectedFound bool
+	}{
+		{name: "Should read an existing local file", path: path, expectedFound: true},
+		{name: "Should skip a local file that is created during the apply", path: filepath.Join(dir, "missing.yaml")},
+		{name: "Should skip an S3 file", path: "s3://bucket/flows/flow.yaml"},
+		{name: "Should skip an HTTP file", path: "https://example.com/flow.yaml"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			content, found, err := readLocalFlowConfiguration(tt.path)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if found != tt.expectedFound {
+				t.Errorf("expected found to be %v, got %v", tt.expectedFound, found)
+			}
+			if found && !strings.Contains(string(content), "My Flow") {
+				t.Errorf("unexpected content %q", content)
+			}
+		})
+	}
+}
+
+func TestUnitExtractReferencedObjects(t *testing.T) {
+	content := `inboundCall:
+  name: Referencing Flow
+  defaultLanguage: en-us
+  initialGreeting:
+    exp: AudioPlaybackOptions(ToAudio(Prompt.welcome_message), true)
+  holdMusic:
+    lit:
+      name: PromptSystem.on_hold_music
+  tasks:
+    - task:
+        actions:
+          - transferToAcd:
+              targetQueue:
+                lit:
+                  name: Support Queue
+          - transferToFlow:
+              targetFlow:
+                name: Billing Flow
+          - callData:
+              dataAction:
+                lit:
+                  name: Lookup Customer
+          - transferToAcd:
+              targetQueue:
+                exp: Task.queue
+          - transferToAcd:
+              targetQueue:
+                lit:
+                  name: Support Queue
+`
+	objects, err := extractReferencedObjects(content)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected := []ReferencedObject{
+		{Type: userPromptResourceType, Name: "welcome_message"},
+		{Type: ResourceType, Name: "Billing Flow"},
+		{Type: "genesyscloud_integration_action", Name: "Lookup Customer"},
+		{Type: "genesyscloud_routing_queue", Name: "Support Queue"},
+	}
+	if len(objects) != len(expected) {
+		t.Fatalf("expected %d referenced objects, got %d: %v", len(expected), len(objects), objects)
+	}
+	for i := range expected {
+		if objects[i] != expected[i] {
+			t.Errorf("expected referenced object %d to be %v, got %v", i, expected[i], objects[i])
+		}
+	}
+
+	attributes := make(map[string]string)
+	setReferencedObjectsStateAttributes(attributes, objects)
+	fromState := GetReferencedObjectsFromStateAttributes(attributes)
+	if len(fromState) != len(objects) || fromState[1] != objects[1] {
+		t.Errorf("expected referenced objects to round trip through state attributes, got %v", fromState)
+	}
+}
+
+func TestUnitFlowVersionSettingsKeepsDeployedVersion(t *testing.T) {
+	tests := []struct {
+		name            string
+		settings        flowVersionSettings
+		previousVersion string
+		expected        bool
+	}{
+		{
+			name:            "Publish keeps the deployed version",
+			settings:        flowVersionSettings{publish: true},
+			previousVersion: "1.0",
+			expected:        true,
+		},
+		{
+			name:            "Draft republishes the previous version",
+			settings:        flowVersionSettings{publish: false},
+			previousVersion: "1.0",
+			expected:        false,
+		},
+		{
+			name:     "Draft without a previous version stays published",
+			settings: flowVersionSettings{publish: false},
+			expected: true,
+		},
+		{
+			name:            "Pinned version republishes the previous version",
+			settings:        flowVersionSettings{publish: true, pinnedVersion: "0.5"},
+			previousVersion: "1.0",
+			expected:        false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.settings.keepsDeployedVersion(tt.previousVersion); got != tt.expected {
+				t.Errorf("expected keepsDeployedVersion to be %v, got %v", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestUnitApplyDeployJobFlowVersionPinned(t *testing.T) {
+	const mockFlowId = "mock-id"
+	publishedVersion := "2.0"
+	var publishCalls []string
+
+	proxyInstance := &architectFlowProxy{
+		getArchitectFlowAttr: func(ctx context.Context, p *architectFlowProxy, id string) (*platformclientv2.Flow, *platformclientv2.APIResponse, error) {
+			return &platformclientv2.Flow{
+				Id:               &id,
+				PublishedVersion: &platformclientv2.Flowversion{Id: platformclientv2.String(publishedVersion)},
+			}, nil, nil
+		},
+		publishFlowVersionAttr: func(ctx context.Context, p *architectFlowProxy, flowId, version string) (*platformclientv2.APIResponse, error) {
+			publishCalls = append(publishCalls, version)
+			publishedVersion = version
+			return nil, nil
+		},
+	}
+
+	settings := flowVersionSettings{publish: true, pinnedVersion: "1.0", rollbackOnFailure: true}
+	deployed, published, diags := applyDeployJobFlowVersion(context.Background(), proxyInstance, mockFlowId, settings, "1.5")
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+	if deployed != "2.0" {
+		t.Errorf("expected deployed version 2.0, got %q", deployed)
+	}
+	if published != "1.0" {
+		t.Errorf("expected published version 1.0, got %q", published)
+	}
+	if len(publishCalls) != 1 || publishCalls[0] != "1.0" {
+		t.Errorf("expected a single publish of version 1.0, got %v", publishCalls)
+	}
+}
+
+func TestUnitRestoreDraftFlowVersion(t *testing.T) {
+	const mockFlowId = "mock-id"
+	publishedVersion := "3.0"
+	var publishCalls []string
+
+	proxyInstance := &architectFlowProxy{
+		getArchitectFlowAttr: func(ctx context.Context, p *architectFlowProxy, id string) (*platformclientv2.Flow, *platformclientv2.APIResponse, error) {
+			return &platformclientv2.Flow{
+				Id:               &id,
+				PublishedVersion: &platformclientv2.Flowversion{Id: platformclientv2.String(publishedVersion)},
+			}, nil, nil
+		},
+		publishFlowVersionAttr: func(ctx context.Context, p *architectFlowProxy, flowId, version string) (*platformclientv2.APIResponse, error) {
+			publishCalls = append(publishCalls, version)
+			publishedVersion = version
+			return nil, nil
+		},
+	}
+
+	deployed, diags := restoreDraftFlowVersion(context.Background(), proxyInstance, mockFlowId, "2.0")
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+	if deployed != "3.0" {
+		t.Errorf("expected deployed version 3.0, got %q", deployed)
+	}
+	if len(publishCalls) != 1 || publishCalls[0] != "2.0" {
+		t.Errorf("expected a single publish of version 2.0, got %v", publishCalls)
+	}
+
+	published, diags := applyDraftFlowVersion(context.Background(), proxyInstance, mockFlowId, flowVersionSettings{publish: false}, "2.0")
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+	if published != "2.0" {
+		t.Errorf("expected version 2.0 to stay published, got %q", published)
+	}
+}
+
+func TestUnitDeployDraftFlowConfigurationUnlocksOnFailure(t *testing.T) {
+	const mockFlowId = "mock-id"
+
+	tests := []struct {
+		name            string
+		unlockErr       error
+		expectedWarning bool
+	}{
+		{
+			name: "Flow is unlocked after the deploy job fails",
+		},
+		{
+			name:            "Failed unlock is reported as a warning",
+			unlockErr:       fmt.Errorf("mock unlock error"),
+			expectedWarning: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var unlocked []string
+			proxyInstance := &architectFlowProxy{
+				createArchitectFlowJobsAttr: func(ctx context.Context, p *architectFlowProxy) (*platformclientv2.Registerarchitectjobresponse, *platformclientv2.APIResponse, error) {
+					return nil, &platformclientv2.APIResponse{StatusCode: 500}, fmt.Errorf("mock register error")
+				},
+				forceUnlockFlowAttr: func(ctx context.Context, p *architectFlowProxy, flowId string) (*platformclientv2.APIResponse, error) {
+					unlocked = append(unlocked, flowId)
+					return nil, tt.unlockErr
+				},
+				publishFlowVersionAttr: func(ctx context.Context, p *architectFlowProxy, flowId, version string) (*platformclientv2.APIResponse, error) {
+					t.Errorf("expected no version to be published, got %s", version)
+					return nil, nil
+				},
+			}
+
+			d := schema.TestResourceDataRaw(t, ResourceArchitectFlow().Schema, map[string]any{})
+			d.SetId(mockFlowId)
+
+			deployed, diags := deployDraftFlowConfiguration(context.Background(), d, proxyInstance, "Test Flow", "flow.yaml", nil, nil, "2.0")
+			if !diags.HasError() {
+				t.Fatal("expected the failed deploy job to return an error")
+			}
+			if deployed != "" {
+				t.Errorf("expected no deployed version, got %q", deployed)
+			}
+			if len(unlocked) != 1 || unlocked[0] != mockFlowId {
+				t.Errorf("expected flow %s to be unlocked once, got %v", mockFlowId, unlocked)
+			}
+
+			var hasWarning bool
+			for _, diagnostic := range diags {
+				if diagnostic.Severity == diag.Warning {
+					hasWarning = true
+				}
+			}
+			if hasWarning != tt.expectedWarning {
+				t.Errorf("expected unlock warning to be %v, got %v", tt.expectedWarning, hasWarning)
+			}
+		})
+	}
+}
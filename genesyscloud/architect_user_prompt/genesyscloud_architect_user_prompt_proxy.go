@@ -314,7 +314,7 @@ func createOrUpdateArchitectUserPromptResourcesFn(ctx context.Context, p *archit
 
 	var allLanguages []string
 
-	resourcesToCreate, resourcesToUpdate, resourcesToDelete, resp, err := p.buildUserPromptResourcesForCreateAndUpdate(ctx, d, promptId, create)
+	resourcesToCreate, resourcesToUpdate, resourcesToDelete, skipUpload, resp, err := p.buildUserPromptResourcesForCreateAndUpdate(ctx, d, promptId, create)
 	if err != nil {
 		return resp, err
 	}
@@ -342,7 +342,9 @@ func createOrUpdateArchitectUserPromptResourcesFn(ctx context.Context, p *archit
 			return resp, fmt.Errorf("failed to update user prompt resource for language '%s': %w", *r.Language, err)
 		}
 
-		if err = p.retrieveFilenameAndUploadPromptAsset(ctx, resource); err != nil {
+		if skipUpload[*r.Language] {
+			log.Printf("Audio file for language %s is unchanged, skipping upload", *r.Language)
+		} else if err = p.retrieveFilenameAndUploadPromptAsset(ctx, resource); err != nil {
 			return nil, err
 		}
 
@@ -463,43 +465,48 @@ func (p *architectUserPromptProxy) retrieveFilenameAndUploadPromptAsset(ctx cont
 	return nil
 }
 
-func (p *architectUserPromptProxy) buildUserPromptResourcesForCreateAndUpdate(ctx context.Context, d *schema.ResourceData, promptId string, create bool) ([]platformclientv2.Promptassetcreate, []platformclientv2.Promptasset, []string, *platformclientv2.APIResponse, error) {
-	var (
-		toCreate          []platformclientv2.Promptassetcreate
-		toUpdate          []platformclientv2.Promptasset
-		toDelete          []string
-		existingResources *[]platformclientv2.Promptasset
-	)
-
-	resources, ok := d.Get("resources").(*schema.Set)
+// buildUserPromptResourcesForCreateAndUpdate works out which prompt resources have to be created, updated and
+// deleted. Existing resources that did not change are left out, and the languages whose audio file did not change
+// since the last apply are returned in skipUpload so that their file is not uploaded again.
+func (p *architectUserPromptProxy) buildUserPromptResourcesForCreateAndUpdate(ctx context.Context, d *schema.ResourceData, promptId string, create bool) (toCreate []platformclientv2.Promptassetcreate, toUpdate []platformclientv2.Promptasset, toDelete []string, skipUpload map[string]bool, resp *platformclientv2.APIResponse, err error) {
+	var existingResources *[]platformclientv2.Promptasset
+	skipUpload = make(map[string]bool)
 
-	if checkEmptyResource(resources) {
-		resources = nil
+	resources, err := getPromptResourceMaps(d)
+	if err != nil {
+		return nil, nil, nil, nil, nil, err
 	}
 
-	if (!ok || resources == nil) && create {
-		return toCreate, toUpdate, toDelete, nil, nil
+	if len(resources) == 0 && create {
+		return toCreate, toUpdate, toDelete, skipUpload, nil, nil
 	}
 
 	if !create {
 		// Look up the existing resources for this prompt
 		userPrompt, resp, err := p.getArchitectUserPrompt(ctx, d.Id(), true, true, nil, false)
 		if err != nil {
-			return toCreate, toUpdate, toDelete, resp, fmt.Errorf("failed to lookup existing resources for prompt '%s': %v", d.Id(), err)
+			return toCreate, toUpdate, toDelete, skipUpload, resp, fmt.Errorf("failed to lookup existing resources for prompt '%s': %v", d.Id(), err)
 		}
 		existingResources = userPrompt.Resources
 	}
 
-	if !ok || resources == nil {
+	if len(resources) == 0 {
 		if existingResources != nil {
 			for _, r := range *existingResources {
 				toDelete = append(toDelete, *r.Language)
 			}
 		}
-		return toCreate, toUpdate, toDelete, nil, nil
+		return toCreate, toUpdate, toDelete, skipUpload, nil, nil
 	} else {
-		for _, promptResource := range resources.List() {
-			languageExists := false
+		audioHashes, err := hashPromptAudioFiles(resources)
+		if err != nil {
+			return nil, nil, nil, nil, nil, err
+		}
+		oldAudioHashes, _ := d.GetChange("audio_file_hashes")
+		previousAudioHashes, _ := oldAudioHashes.(map[string]any)
+
+		for _, promptResource := range resources {
+			var existingResource *platformclientv2.Promptasset
 			promptResourceMap, ok := promptResource.(map[string]any)
 			if !ok {
 				continue
@@ -513,16 +520,22 @@ func (p *architectUserPromptProxy) buildUserPromptResourcesForCreateAndUpdate(ct
 
 			if existingResources != nil {
 				// Check if language resource already exists
-				for _, r := range *existingResources {
+				for i, r := range *existingResources {
 					if *r.Language == resourceLanguage {
-						languageExists = true
+						existingResource = &(*existingResources)[i]
 						break
 					}
 				}
 			}
 
-			if languageExists {
+			if existingResource != nil {
 				updateResourceStruct := buildUserPromptResourceForUpdate(promptResourceMap)
+				audioUnchanged := isPromptAudioUnchanged(existingResource, updateResourceStruct, audioHashes[resourceLanguage], previousAudioHashes[resourceLanguage])
+				if audioUnchanged && isPromptResourceUnchanged(existingResource, updateResourceStruct) {
+					log.Printf("User prompt resource for language %s is unchanged", resourceLanguage)
+					continue
+				}
+				skipUpload[resourceLanguage] = audioUnchanged
 				toUpdate = append(toUpdate, *updateResourceStruct)
 			} else {
 				createResourceStruct := buildUserPromptResourceForCreate(promptResourceMap)
@@ -534,7 +547,7 @@ func (p *architectUserPromptProxy) buildUserPromptResourcesForCreateAndUpdate(ct
 	if existingResources != nil {
 		for _, re := range *existingResources {
 			resourceExists := false
-			for _, promptResource := range resources.List() {
+			for _, promptResource := range resources {
 				promptResourceMap, ok := promptResource.(map[string]interface{})
 				if !ok {
 					continue
@@ -552,7 +565,7 @@ func (p *architectUserPromptProxy) buildUserPromptResourcesForCreateAndUpdate(ct
 		}
 	}
 
-	return toCreate, toUpdate, toDelete, nil, nil
+	return toCreate, toUpdate, toDelete, skipUpload, nil, nil
 }
 
 // the resources section of the schema is modified , to nil resources usecase.
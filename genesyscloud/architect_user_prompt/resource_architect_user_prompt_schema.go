@@ -100,6 +100,7 @@ func ResourceArchitectUserPrompt() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		CustomizeDiff: customizeUserPromptDiff,
 		SchemaVersion: 1,
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -120,6 +121,23 @@ func ResourceArchitectUserPrompt() *schema.Resource {
 				ConfigMode:  schema.SchemaConfigModeAttr,
 				Elem:        userPromptResource,
 			},
+			"audio_directory": {
+				Description: "Path to a directory holding one WAV file per language, named after the language (e.g. `prompts/welcome/en-us.wav`). Every file is added as a resource of the prompt. A language in the directory may also be declared in a `resources` block to set its text or TTS string, but without a `filename`.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"audio_file_hashes": {
+				Description: "SHA-256 hash of the local audio file of each language. Used to detect changes and to upload only the languages whose file changed. Local WAV files are validated during plan.",
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"media_uris": {
+				Description: "URI of the audio of each language that has audio, to preview the prompt.",
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
 		},
 	}
 }
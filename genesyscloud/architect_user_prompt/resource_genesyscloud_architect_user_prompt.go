@@ -87,6 +87,7 @@ func createUserPrompt(ctx context.Context, d *schema.ResourceData, meta any) dia
 	log.Printf("Updated prompt resources. Prompt ID: '%s'", *userPrompt.Id)
 
 	d.SetId(*userPrompt.Id)
+	setAudioFileHashes(d)
 	log.Printf("Created user prompt %s %s", name, *userPrompt.Id)
 	return readUserPrompt(ctx, d, meta)
 }
@@ -110,6 +111,7 @@ func readUserPrompt(ctx context.Context, d *schema.ResourceData, meta any) diag.
 		resourcedata.SetNillableValue(d, "name", userPrompt.Name)
 		resourcedata.SetNillableValue(d, "description", userPrompt.Description)
 		_ = d.Set("resources", flattenPromptResources(d, userPrompt.Resources))
+		_ = d.Set("media_uris", flattenPromptMediaUris(userPrompt.Resources))
 
 		log.Printf("Read Audio Prompt %s %s", d.Id(), *userPrompt.Id)
 		return cc.CheckState(d)
@@ -132,12 +134,16 @@ func updateUserPrompt(ctx context.Context, d *schema.ResourceData, meta any) dia
 	log.Printf("Updating prompt resources. Prompt ID: '%s'", d.Id())
 	resp, err = proxy.createOrUpdateArchitectUserPromptResources(ctx, d, d.Id(), false)
 	if err != nil {
+		// Keep the previous hashes so that the files are uploaded again on the next apply
+		oldHashes, _ := d.GetChange("audio_file_hashes")
+		_ = d.Set("audio_file_hashes", oldHashes)
 		if resp != nil {
 			return util.BuildAPIDiagnosticError(ResourceType, err.Error(), resp)
 		}
 		return util.BuildDiagnosticError(ResourceType, err.Error(), err)
 	}
 	log.Printf("Updated prompt resources. Prompt ID: '%s'", d.Id())
+	setAudioFileHashes(d)
 
 	log.Printf("Updated User Prompt %s", d.Id())
 	return readUserPrompt(ctx, d, meta)
@@ -166,3 +172,18 @@ func deleteUserPrompt(ctx context.Context, d *schema.ResourceData, meta any) dia
 		return retry.RetryableError(util.BuildWithRetriesApiDiagnosticError(ResourceType, fmt.Sprintf("user prompt %s still exists", name), resp))
 	})
 }
+
+// setAudioFileHashes records the hash of the audio file uploaded for each language
+func setAudioFileHashes(d *schema.ResourceData) {
+	resources, err := getPromptResourceMaps(d)
+	if err != nil {
+		log.Printf("Failed to read the audio files of user prompt %s: %v", d.Id(), err)
+		return
+	}
+	hashes, err := hashPromptAudioFiles(resources)
+	if err != nil {
+		log.Printf("Failed to hash the audio files of user prompt %s: %v", d.Id(), err)
+		return
+	}
+	_ = d.Set("audio_file_hashes", hashes)
+}
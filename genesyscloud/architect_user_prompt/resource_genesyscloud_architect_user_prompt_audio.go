@@ -0,0 +1,362 @@
+package architect_user_prompt
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"maps"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	architectlanguages "github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/util/architectlanguages"
+	utilAws "github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/util/aws"
+	"github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/util/lists"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mypurecloud/platform-client-sdk-go/v192/platformclientv2"
+)
+
+/*
+The resource_genesyscloud_architect_user_prompt_audio.go file validates the local audio files of a user prompt during
+plan, tracks a hash per language so that only the languages whose file changed are uploaded, and expands the
+audio_directory convention (<directory>/<language>.wav) into prompt resources.
+*/
+
+const (
+	wavFormatPcm        = 0x0001
+	wavFormatALaw       = 0x0006
+	wavFormatMuLaw      = 0x0007
+	wavFormatExtensible = 0xFFFE
+)
+
+// supportedWavSampleRates are the sample rates Genesys Cloud accepts for prompt audio
+var supportedWavSampleRates = []int{8000, 11025, 16000, 22050, 32000, 44100, 48000}
+
+// wavFormat is the content of the fmt chunk of a WAV file
+type wavFormat struct {
+	AudioFormat   uint16
+	Channels      uint16
+	SampleRate    uint32
+	BitsPerSample uint16
+}
+
+// validateWavFile checks that a WAV file uses a codec, sample rate and channel count Genesys Cloud can transcode
+func validateWavFile(path string) error {
+	file, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	format, err := readWavFormat(file)
+	if err != nil {
+		return err
+	}
+	return validateWavFormat(format)
+}
+
+// readWavFormat reads the fmt chunk of a RIFF/WAVE file and makes sure a data chunk follows
+func readWavFormat(r io.ReadSeeker) (*wavFormat, error) {
+	header := make([]byte, 12)
+	if _, err := io.ReadFull(r, header); err != nil {
+		return nil, fmt.Errorf("file is too short to be a WAV file")
+	}
+	if string(header[0:4]) != "RIFF" || string(header[8:12]) != "WAVE" {
+		return nil, fmt.Errorf("file is not a RIFF/WAVE file")
+	}
+
+	var format *wavFormat
+	chunkHeader := make([]byte, 8)
+	for {
+		if _, err := io.ReadFull(r, chunkHeader); err != nil {
+			if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+				break
+			}
+			return nil, err
+		}
+		chunkId := string(chunkHeader[0:4])
+		chunkSize := int64(binary.LittleEndian.Uint32(chunkHeader[4:8]))
+
+		switch chunkId {
+		case "fmt ":
+			if chunkSize < 16 {
+				return nil, fmt.Errorf("fmt chunk is too short")
+			}
+			chunk := make([]byte, chunkSize)
+			if _, err := io.ReadFull(r, chunk); err != nil {
+				return nil, fmt.Errorf("failed to read fmt chunk: %v", err)
+			}
+			format = &wavFormat{
+				AudioFormat:   binary.LittleEndian.Uint16(chunk[0:2]),
+				Channels:      binary.LittleEndian.Uint16(chunk[2:4]),
+				SampleRate:    binary.LittleEndian.Uint32(chunk[4:8]),
+				BitsPerSample: binary.LittleEndian.Uint16(chunk[14:16]),
+			}
+			// WAVE_FORMAT_EXTENSIBLE stores the actual codec in the first two bytes of the sub format GUID
+			if format.AudioFormat == wavFormatExtensible && chunkSize >= 26 {
+				format.AudioFormat = binary.LittleEndian.Uint16(chunk[24:26])
+			}
+			chunkSize = 0
+		case "data":
+			if format == nil {
+				return nil, fmt.Errorf("data chunk found before the fmt chunk")
+			}
+			if chunkSize == 0 {
+				return nil, fmt.Errorf("file contains no audio data")
+			}
+			return format, nil
+		}
+
+		// Chunks are padded to an even number of bytes
+		if _, err := r.Seek(chunkSize+chunkSize%2, io.SeekCurrent); err != nil {
+			return nil, err
+		}
+	}
+
+	if format == nil {
+		return nil, fmt.Errorf("file has no fmt chunk")
+	}
+	return nil, fmt.Errorf("file has no data chunk")
+}
+
+func validateWavFormat(format *wavFormat) error {
+	switch format.AudioFormat {
+	case wavFormatPcm:
+		if format.BitsPerSample != 8 && format.BitsPerSample != 16 {
+			return fmt.Errorf("PCM audio must be 8 or 16 bit, got %d bit", format.BitsPerSample)
+		}
+	case wavFormatALaw, wavFormatMuLaw:
+		if format.BitsPerSample != 8 {
+			return fmt.Errorf("A-law and µ-law audio must be 8 bit, got %d bit", format.BitsPerSample)
+		}
+	default:
+		return fmt.Errorf("unsupported codec 0x%04x, only PCM, A-law and µ-law are supported", format.AudioFormat)
+	}
+
+	if format.Channels != 1 && format.Channels != 2 {
+		return fmt.Errorf("audio must be mono or stereo, got %d channels", format.Channels)
+	}
+	if !lists.ItemInSlice(int(format.SampleRate), supportedWavSampleRates) {
+		return fmt.Errorf("unsupported sample rate %d Hz, supported sample rates are %v", format.SampleRate, supportedWavSampleRates)
+	}
+	return nil
+}
+
+// isLocalAudioFile reports whether a filename refers to a file on disk rather than S3 or a URL. Only local files are
+// validated and hashed during plan.
+func isLocalAudioFile(filename string) bool {
+	if filename == "" || utilAws.IsS3Path(filename) {
+		return false
+	}
+	if u, err := url.ParseRequestURI(filename); err == nil && u.Scheme != "" {
+		return false
+	}
+	return true
+}
+
+// listAudioDirectory returns the audio file of each language in an audio directory, keyed by language. Files must be
+// named after the language they hold, e.g. en-us.wav.
+func listAudioDirectory(directory string) (map[string]string, error) {
+	entries, err := os.ReadDir(directory)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read audio directory %s: %v", directory, err)
+	}
+
+	audioFiles := make(map[string]string)
+	for _, entry := range entries {
+		if entry.IsDir() || !strings.EqualFold(filepath.Ext(entry.Name()), ".wav") {
+			continue
+		}
+		language := strings.ToLower(strings.TrimSuffix(entry.Name(), filepath.Ext(entry.Name())))
+		if !lists.ItemInSlice(language, architectlanguages.Languages) {
+			return nil, fmt.Errorf("audio file %s is not named after a supported language, e.g. en-us.wav", entry.Name())
+		}
+		if _, ok := audioFiles[language]; ok {
+			return nil, fmt.Errorf("audio directory %s has more than one file for language %s", directory, language)
+		}
+		audioFiles[language] = filepath.Join(directory, entry.Name())
+	}
+	return audioFiles, nil
+}
+
+// buildAudioDirectoryResources expands the audio directory into prompt resources. A language that is also declared
+// in the resources block takes its file from the directory, but may not set a filename of its own.
+func buildAudioDirectoryResources(directory string, configured []any) ([]any, error) {
+	audioFiles, err := listAudioDirectory(directory)
+	if err != nil {
+		return nil, err
+	}
+
+	var resources []any
+	for _, r := range configured {
+		resourceMap, ok := r.(map[string]any)
+		if !ok {
+			continue
+		}
+		language, _ := resourceMap["language"].(string)
+		audioFile, inDirectory := audioFiles[language]
+		if !inDirectory {
+			resources = append(resources, resourceMap)
+			continue
+		}
+		if filename, _ := resourceMap["filename"].(string); filename != "" {
+			return nil, fmt.Errorf("language %s has a filename and a file in audio directory %s", language, directory)
+		}
+		merged := make(map[string]any, len(resourceMap))
+		for k, v := range resourceMap {
+			merged[k] = v
+		}
+		merged["filename"] = audioFile
+		resources = append(resources, merged)
+		delete(audioFiles, language)
+	}
+
+	languages := make([]string, 0, len(audioFiles))
+	for language := range audioFiles {
+		languages = append(languages, language)
+	}
+	sort.Strings(languages)
+	for _, language := range languages {
+		resources = append(resources, map[string]any{
+			"language": language,
+			"filename": audioFiles[language],
+		})
+	}
+	return resources, nil
+}
+
+// getPromptResourceMaps returns the resources of the prompt, including those declared by the audio directory
+func getPromptResourceMaps(d interface{ Get(string) any }) ([]any, error) {
+	var configured []any
+	if resources, ok := d.Get("resources").(*schema.Set); ok && resources != nil && !checkEmptyResource(resources) {
+		configured = resources.List()
+	}
+
+	directory, _ := d.Get("audio_directory").(string)
+	if directory == "" {
+		return configured, nil
+	}
+	return buildAudioDirectoryResources(directory, configured)
+}
+
+// hashPromptAudioFiles hashes the local audio file of every language
+func hashPromptAudioFiles(resources []any) (map[string]string, error) {
+	hashes := make(map[string]string)
+	for _, r := range resources {
+		resourceMap, ok := r.(map[string]any)
+		if !ok {
+			continue
+		}
+		language, _ := resourceMap["language"].(string)
+		filename, _ := resourceMap["filename"].(string)
+		if language == "" || !isLocalAudioFile(filename) {
+			continue
+		}
+		hash, err := hashLocalFile(filename)
+		if err != nil {
+			return nil, fmt.Errorf("failed to hash audio file %s for language %s: %v", filename, language, err)
+		}
+		hashes[language] = hash
+	}
+	return hashes, nil
+}
+
+func hashLocalFile(path string) (string, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer file.Close()
+
+	hash := sha256.New()
+	if _, err := io.Copy(hash, file); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+// customizeUserPromptDiff validates the local WAV files of the prompt and records the hash of each audio file so
+// that a change to a file shows in the plan for its language only
+func customizeUserPromptDiff(ctx context.Context, d *schema.ResourceDiff, _ any) error {
+	if !d.NewValueKnown("resources") || !d.NewValueKnown("audio_directory") {
+		log.Printf("Skipping audio validation for user prompt %s as its resources are not yet known", d.Id())
+		return nil
+	}
+
+	resources, err := getPromptResourceMaps(d)
+	if err != nil {
+		return err
+	}
+
+	for _, r := range resources {
+		resourceMap, ok := r.(map[string]any)
+		if !ok {
+			continue
+		}
+		filename, _ := resourceMap["filename"].(string)
+		if !isLocalAudioFile(filename) || !strings.EqualFold(filepath.Ext(filename), ".wav") {
+			continue
+		}
+		if err := validateWavFile(filename); err != nil {
+			return fmt.Errorf("invalid audio file %s for language %v: %w", filename, resourceMap["language"], err)
+		}
+	}
+
+	hashes, err := hashPromptAudioFiles(resources)
+	if err != nil {
+		return err
+	}
+
+	oldHashes, _ := d.Get("audio_file_hashes").(map[string]any)
+	if !maps.Equal(lists.ConvertMapStringAnyToMapStringString(oldHashes), hashes) {
+		return d.SetNew("audio_file_hashes", hashes)
+	}
+	return nil
+}
+
+// isPromptAudioUnchanged reports whether the audio file of an existing prompt resource is the one that was uploaded
+// on the last apply. Files that cannot be hashed locally, such as S3 objects, are always uploaded.
+func isPromptAudioUnchanged(existing *platformclientv2.Promptasset, updated *platformclientv2.Promptasset, newHash string, previousHash any) bool {
+	newFilename := getFilenameTag(updated)
+	if newFilename == "" {
+		return true
+	}
+	if newFilename != getFilenameTag(existing) || newHash == "" || newHash != previousHash {
+		return false
+	}
+	// A previous upload that was never transcoded has to be repeated
+	return existing.UploadStatus != nil && *existing.UploadStatus == "transcoded"
+}
+
+// isPromptResourceUnchanged reports whether the text, TTS and filename of an existing prompt resource match the
+// configuration
+func isPromptResourceUnchanged(existing *platformclientv2.Promptasset, updated *platformclientv2.Promptasset) bool {
+	return stringValue(existing.TtsString) == stringValue(updated.TtsString) &&
+		stringValue(existing.Text) == stringValue(updated.Text) &&
+		getFilenameTag(existing) == getFilenameTag(updated)
+}
+
+func getFilenameTag(asset *platformclientv2.Promptasset) string {
+	if asset == nil || asset.Tags == nil {
+		return ""
+	}
+	if filenames := (*asset.Tags)["filename"]; len(filenames) > 0 {
+		return filenames[0]
+	}
+	return ""
+}
+
+func stringValue(value *string) string {
+	if value == nil {
+		return ""
+	}
+	return *value
+}
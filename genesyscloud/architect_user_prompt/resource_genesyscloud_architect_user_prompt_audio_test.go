@@ -0,0 +1,149 @@
+package architect_user_prompt
+
+import (
+	"encoding/binary"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/mypurecloud/platform-client-sdk-go/v192/platformclientv2"
+	"github.com/stretchr/testify/assert"
+)
+
+// buildWav builds a WAV file with the given format and a short silent data chunk
+func buildWav(audioFormat, channels uint16, sampleRate uint32, bitsPerSample uint16) []byte {
+	data := make([]byte, 160)
+	fmtChunk := make([]byte, 16)
+	binary.LittleEndian.PutUint16(fmtChunk[0:2], audioFormat)
+	binary.LittleEndian.PutUint16(fmtChunk[2:4], channels)
+	binary.LittleEndian.PutUint32(fmtChunk[4:8], sampleRate)
+	binary.LittleEndian.PutUint32(fmtChunk[8:12], sampleRate*uint32(channels)*uint32(bitsPerSample)/8)
+	binary.LittleEndian.PutUint16(fmtChunk[12:14], channels*bitsPerSample/8)
+	binary.LittleEndian.PutUint16(fmtChunk[14:16], bitsPerSample)
+
+	var wav []byte
+	wav = append(wav, "RIFF"...)
+	wav = binary.LittleEndian.AppendUint32(wav, uint32(4+8+len(fmtChunk)+8+5+1+8+len(data)))
+	wav = append(wav, "WAVE"...)
+	wav = append(wav, "fmt "...)
+	wav = binary.LittleEndian.AppendUint32(wav, uint32(len(fmtChunk)))
+	wav = append(wav, fmtChunk...)
+	// An odd sized chunk that has to be skipped, including its padding byte
+	wav = append(wav, "LIST"...)
+	wav = binary.LittleEndian.AppendUint32(wav, 5)
+	wav = append(wav, "INFO0\x00"...)
+	wav = append(wav, "data"...)
+	wav = binary.LittleEndian.AppendUint32(wav, uint32(len(data)))
+	return append(wav, data...)
+}
+
+func TestUnitValidateWavFile(t *testing.T) {
+	dir := t.TempDir()
+	testCases := []struct {
+		name    string
+		content []byte
+		valid   bool
+	}{
+		{name: "pcm_16bit_16khz", content: buildWav(wavFormatPcm, 1, 16000, 16), valid: true},
+		{name: "mulaw_8khz", content: buildWav(wavFormatMuLaw, 1, 8000, 8), valid: true},
+		{name: "pcm_24bit", content: buildWav(wavFormatPcm, 1, 16000, 24)},
+		{name: "pcm_12khz", content: buildWav(wavFormatPcm, 1, 12000, 16)},
+		{name: "ieee_float", content: buildWav(0x0003, 1, 16000, 32)},
+		{name: "six_channels", content: buildWav(wavFormatPcm, 6, 16000, 16)},
+		{name: "not_a_wav", content: []byte("ID3 this is an mp3 file")},
+		{name: "empty", content: []byte{}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			path := filepath.Join(dir, tc.name+".wav")
+			assert.NoError(t, os.WriteFile(path, tc.content, 0644))
+			err := validateWavFile(path)
+			if tc.valid {
+				assert.NoError(t, err)
+			} else {
+				assert.Error(t, err)
+			}
+		})
+	}
+
+	// The audio files used by the acceptance tests must pass the validation
+	for _, path := range []string{
+		"../../test/data/resource/genesyscloud_architect_user_prompt/test-prompt-01.wav",
+		"../../test/data/resource/genesyscloud_architect_user_prompt/test-prompt-02.wav",
+	} {
+		assert.NoError(t, validateWavFile(path), path)
+	}
+}
+
+func TestUnitBuildAudioDirectoryResources(t *testing.T) {
+	dir := t.TempDir()
+	for _, name := range []string{"en-us.wav", "es-us.wav", "notes.txt"} {
+		assert.NoError(t, os.WriteFile(filepath.Join(dir, name), buildWav(wavFormatPcm, 1, 8000, 16), 0644))
+	}
+
+	configured := []any{
+		map[string]any{"language": "en-us", "tts_string": "Welcome", "filename": ""},
+		map[string]any{"language": "fr-fr", "tts_string": "Bienvenue", "filename": ""},
+	}
+	resources, err := buildAudioDirectoryResources(dir, configured)
+	assert.NoError(t, err)
+	assert.Equal(t, []any{
+		map[string]any{"language": "fr-fr", "tts_string": "Bienvenue", "filename": ""},
+		map[string]any{"language": "en-us", "tts_string": "Welcome", "filename": filepath.Join(dir, "en-us.wav")},
+		map[string]any{"language": "es-us", "filename": filepath.Join(dir, "es-us.wav")},
+	}, reorderByLanguage(resources, "fr-fr", "en-us", "es-us"))
+
+	hashes, err := hashPromptAudioFiles(resources)
+	assert.NoError(t, err)
+	assert.Len(t, hashes, 2)
+	assert.Equal(t, hashes["en-us"], hashes["es-us"])
+
+	// A language cannot take its file from both the directory and the resources block
+	configured[0].(map[string]any)["filename"] = "other.wav"
+	_, err = buildAudioDirectoryResources(dir, configured)
+	assert.Error(t, err)
+
+	// Files must be named after a language
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, "welcome.wav"), buildWav(wavFormatPcm, 1, 8000, 16), 0644))
+	_, err = listAudioDirectory(dir)
+	assert.Error(t, err)
+}
+
+func TestUnitIsPromptAudioUnchanged(t *testing.T) {
+	transcoded := "transcoded"
+	existing := &platformclientv2.Promptasset{
+		Tags:         &map[string][]string{"filename": {"prompts/welcome/en-us.wav"}},
+		UploadStatus: &transcoded,
+	}
+	updated := &platformclientv2.Promptasset{
+		Tags: &map[string][]string{"filename": {"prompts/welcome/en-us.wav"}},
+	}
+
+	assert.True(t, isPromptAudioUnchanged(existing, updated, "hash-1", "hash-1"))
+	assert.False(t, isPromptAudioUnchanged(existing, updated, "hash-2", "hash-1"))
+	// Hashes recorded before this attribute existed are missing
+	assert.False(t, isPromptAudioUnchanged(existing, updated, "hash-1", nil))
+
+	renamed := &platformclientv2.Promptasset{Tags: &map[string][]string{"filename": {"prompts/welcome/en-US.wav"}}}
+	assert.False(t, isPromptAudioUnchanged(existing, renamed, "hash-1", "hash-1"))
+
+	failed := "failed"
+	existing.UploadStatus = &failed
+	assert.False(t, isPromptAudioUnchanged(existing, updated, "hash-1", "hash-1"))
+
+	// Resources without a file have nothing to upload
+	assert.True(t, isPromptAudioUnchanged(existing, &platformclientv2.Promptasset{}, "", nil))
+}
+
+func reorderByLanguage(resources []any, languages ...string) []any {
+	var ordered []any
+	for _, language := range languages {
+		for _, r := range resources {
+			if r.(map[string]any)["language"] == language {
+				ordered = append(ordered, r)
+			}
+		}
+	}
+	return ordered
+}
@@ -45,14 +45,29 @@ func flattenPromptResources(d *schema.ResourceData, promptResources *[]platformc
 		return nil
 	}
 
+	// Languages that come from the audio directory are only kept when they are also declared in a resources block,
+	// and then without the filename that the directory supplied
+	var directoryFiles map[string]string
+	if directory, _ := d.Get("audio_directory").(string); directory != "" {
+		var err error
+		if directoryFiles, err = listAudioDirectory(directory); err != nil {
+			log.Printf("Failed to list audio directory %s: %v", directory, err)
+		}
+	}
+
 	for _, sdkPromptAsset := range *promptResources {
+		_, fromDirectory := directoryFiles[*sdkPromptAsset.Language]
+		if fromDirectory && !isLanguageInResources(d, *sdkPromptAsset.Language) {
+			continue
+		}
+
 		promptResource := make(map[string]any)
 
 		resourcedata.SetMapValueIfNotNil(promptResource, "language", sdkPromptAsset.Language)
 		resourcedata.SetMapValueIfNotNil(promptResource, "tts_string", sdkPromptAsset.TtsString)
 		resourcedata.SetMapValueIfNotNil(promptResource, "text", sdkPromptAsset.Text)
 
-		if sdkPromptAsset.Tags != nil && len(*sdkPromptAsset.Tags) > 0 {
+		if sdkPromptAsset.Tags != nil && len(*sdkPromptAsset.Tags) > 0 && !fromDirectory {
 			t := *sdkPromptAsset.Tags
 			promptResource["filename"] = t["filename"][0]
 		}
@@ -81,6 +96,33 @@ func flattenPromptResources(d *schema.ResourceData, promptResources *[]platformc
 	return resourceSet
 }
 
+func isLanguageInResources(d *schema.ResourceData, language string) bool {
+	resources, ok := d.Get("resources").(*schema.Set)
+	if !ok || resources == nil {
+		return false
+	}
+	for _, r := range resources.List() {
+		if rMap, ok := r.(map[string]any); ok && rMap["language"] == language {
+			return true
+		}
+	}
+	return false
+}
+
+// flattenPromptMediaUris returns the URI of the audio of each language that has audio
+func flattenPromptMediaUris(promptResources *[]platformclientv2.Promptasset) map[string]any {
+	mediaUris := make(map[string]any)
+	if promptResources == nil {
+		return mediaUris
+	}
+	for _, r := range *promptResources {
+		if r.Language != nil && r.MediaUri != nil && *r.MediaUri != "" {
+			mediaUris[*r.Language] = *r.MediaUri
+		}
+	}
+	return mediaUris
+}
+
 // updateFilenamesInExportConfigMap replaces (or creates) the filenames key in configMap with the FileName fields in audioDataList
 // which point towards the downloaded audio files stored in the export folder.
 // Since a language can only appear once in a resources array, we can match resources[n]["language"] with audioDataList[n].Language
@@ -38,6 +38,24 @@ func getAllAuthDivisions(ctx context.Context, clientConfig *platformclientv2.Con
 	return resources, nil
 }
 
+// GetAuthDivisionNames returns the names of all divisions of the org by ID
+func GetAuthDivisionNames(ctx context.Context, clientConfig *platformclientv2.Configuration) (map[string]string, diag.Diagnostics) {
+	proxy := getAuthDivisionProxy(clientConfig)
+
+	divisions, resp, getErr := proxy.getAllAuthDivision(ctx, "")
+	if getErr != nil {
+		return nil, util.BuildAPIDiagnosticError(ResourceType, fmt.Sprintf("Failed to get divisions | error: %s", getErr), resp)
+	}
+
+	names := make(map[string]string, len(*divisions))
+	for _, division := range *divisions {
+		if division.Id != nil && division.Name != nil {
+			names[*division.Id] = *division.Name
+		}
+	}
+	return names, nil
+}
+
 func createAuthDivision(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sdkConfig := meta.(*provider.ProviderMeta).ClientConfig
 	proxy := getAuthDivisionProxy(sdkConfig)
@@ -0,0 +1,47 @@
+package authorization_policy
+
+// AuthorizationPolicy is the ABAC policy model used by the /api/v2/authorization/policies endpoints
+type AuthorizationPolicy struct {
+	Id           *string           `json:"id,omitempty"`
+	Name         *string           `json:"name,omitempty"`
+	Description  *string           `json:"description,omitempty"`
+	PolicyTarget *string           `json:"policyTarget,omitempty"`
+	ActionSet    *[]string         `json:"actionSet,omitempty"`
+	Effect       *string           `json:"effect,omitempty"`
+	Enabled      *bool             `json:"enabled,omitempty"`
+	Conditions   *PolicyConditions `json:"conditions,omitempty"`
+}
+
+type PolicyConditions struct {
+	Conjunction *string       `json:"conjunction,omitempty"`
+	Terms       *[]PolicyTerm `json:"terms,omitempty"`
+}
+
+type PolicyTerm struct {
+	VariableName *string          `json:"variableName,omitempty"`
+	Operator     *string          `json:"operator,omitempty"`
+	Operands     *[]PolicyOperand `json:"operands,omitempty"`
+}
+
+type PolicyOperand struct {
+	Type  *string `json:"type,omitempty"`
+	Value *string `json:"value,omitempty"`
+}
+
+type AuthorizationPolicyEntityListing struct {
+	Entities  *[]AuthorizationPolicy `json:"entities,omitempty"`
+	PageCount *int                   `json:"pageCount,omitempty"`
+}
+
+// PolicyTarget describes an object and action that policies can be written against
+type PolicyTarget struct {
+	Name       *string                  `json:"name,omitempty"`
+	Attributes *[]PolicyTargetAttribute `json:"attributes,omitempty"`
+	Presets    *[]PolicyTargetAttribute `json:"presets,omitempty"`
+}
+
+type PolicyTargetAttribute struct {
+	Name        *string `json:"name,omitempty"`
+	Type        *string `json:"type,omitempty"`
+	Description *string `json:"description,omitempty"`
+}
@@ -0,0 +1,43 @@
+package authorization_policy
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/provider"
+	"github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/util"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+/*
+   The data_source_genesyscloud_authorization_policy_target.go contains the data source implementation
+   for the authorization policy target.
+*/
+
+// dataSourceAuthorizationPolicyTargetRead retrieves by name the attributes and presets of an authorization policy target
+func dataSourceAuthorizationPolicyTargetRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sdkConfig := meta.(*provider.ProviderMeta).ClientConfig
+	proxy := getAuthorizationPolicyProxy(sdkConfig)
+
+	name := d.Get("name").(string)
+
+	return util.WithRetries(ctx, 15*time.Second, func() *retry.RetryError {
+		target, resp, err := proxy.getAuthorizationPolicyTarget(ctx, name)
+		if err != nil {
+			diagErr := util.BuildWithRetriesApiDiagnosticError(TargetDataSourceType, fmt.Sprintf("Error requesting authorization policy target %s | error: %s", name, err), resp)
+			if util.IsStatus404(resp) {
+				return retry.RetryableError(diagErr)
+			}
+			return retry.NonRetryableError(diagErr)
+		}
+
+		d.SetId(name)
+		_ = d.Set("attributes", flattenPolicyTargetAttributes(target.Attributes))
+		_ = d.Set("presets", flattenPolicyTargetAttributes(target.Presets))
+		return nil
+	})
+}
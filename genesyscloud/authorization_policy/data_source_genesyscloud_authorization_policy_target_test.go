@@ -0,0 +1,41 @@
+package authorization_policy
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/provider"
+	"github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/util"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+/*
+Test Class for the authorization policy target Data Source
+*/
+
+func TestAccDataSourceAuthorizationPolicyTarget(t *testing.T) {
+	t.Parallel()
+	var (
+		dataSourceLabel = "target"
+		targetName      = "directory:user:add"
+		fullPath        = "data." + TargetDataSourceType + "." + dataSourceLabel
+	)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { util.TestAccPreCheck(t) },
+		ProviderFactories: provider.GetProviderFactories(providerResources, providerDataSources),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`data "%s" "%s" {
+		name = "%s"
+	}
+	`, TargetDataSourceType, dataSourceLabel, targetName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fullPath, "id", targetName),
+					resource.TestCheckResourceAttrSet(fullPath, "attributes.#"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,64 @@
+package authorization_policy
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/provider"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mypurecloud/platform-client-sdk-go/v192/platformclientv2"
+)
+
+/*
+   The genesyscloud_authorization_policy_init_test.go file is used to initialize the data sources and resources
+   used in testing the authorization_policy resource.
+*/
+
+var (
+	providerDataSources map[string]*schema.Resource
+	providerResources   map[string]*schema.Resource
+	sdkConfig           *platformclientv2.Configuration
+)
+
+type registerTestInstance struct {
+	resourceMapMutex   sync.RWMutex
+	datasourceMapMutex sync.RWMutex
+}
+
+// registerTestResources registers all resources used in the tests
+func (r *registerTestInstance) registerTestResources() {
+	r.resourceMapMutex.Lock()
+	defer r.resourceMapMutex.Unlock()
+
+	providerResources[ResourceType] = ResourceAuthorizationPolicy()
+}
+
+// registerTestDataSources registers all data sources used in the tests.
+func (r *registerTestInstance) registerTestDataSources() {
+	r.datasourceMapMutex.Lock()
+	defer r.datasourceMapMutex.Unlock()
+
+	providerDataSources[TargetDataSourceType] = DataSourceAuthorizationPolicyTarget()
+}
+
+// initTestResources initializes all test resources and data sources.
+func initTestResources() {
+	sdkConfig = provider.SdkConfigurationForTests()
+	providerDataSources = make(map[string]*schema.Resource)
+	providerResources = make(map[string]*schema.Resource)
+
+	regInstance := &registerTestInstance{}
+
+	regInstance.registerTestResources()
+	regInstance.registerTestDataSources()
+}
+
+// TestMain is a "setup" function called by the testing framework when run the test
+func TestMain(m *testing.M) {
+	// Run setup function before starting the test suite for the authorization_policy package
+	initTestResources()
+
+	// Run the test suite for the authorization_policy package
+	m.Run()
+}
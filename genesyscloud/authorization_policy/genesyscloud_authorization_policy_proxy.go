@@ -0,0 +1,161 @@
+package authorization_policy
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	customapi "github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/custom_api_client"
+	"github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/provider"
+
+	"github.com/mypurecloud/platform-client-sdk-go/v192/platformclientv2"
+)
+
+/*
+The genesyscloud_authorization_policy_proxy.go file contains the proxy structures and methods that interact
+with the Genesys Cloud authorization policies API. The policies API is not covered by the generated SDK, so
+calls are made through the custom API client. We use composition here for each function on the proxy so
+individual functions can be stubbed out during testing.
+*/
+
+// internalProxy holds a proxy instance that can be used throughout the package
+var internalProxy *authorizationPolicyProxy
+
+const policiesPath = "/api/v2/authorization/policies"
+
+// Type definitions for each func on our proxy so we can easily mock them out later
+type createAuthorizationPolicyFunc func(ctx context.Context, p *authorizationPolicyProxy, targetName string, policy *AuthorizationPolicy) (*AuthorizationPolicy, *platformclientv2.APIResponse, error)
+type getAllAuthorizationPoliciesFunc func(ctx context.Context, p *authorizationPolicyProxy) (*[]AuthorizationPolicy, *platformclientv2.APIResponse, error)
+type getAuthorizationPolicyByIdFunc func(ctx context.Context, p *authorizationPolicyProxy, id string) (*AuthorizationPolicy, *platformclientv2.APIResponse, error)
+type updateAuthorizationPolicyFunc func(ctx context.Context, p *authorizationPolicyProxy, id string, policy *AuthorizationPolicy) (*AuthorizationPolicy, *platformclientv2.APIResponse, error)
+type deleteAuthorizationPolicyFunc func(ctx context.Context, p *authorizationPolicyProxy, id string) (*platformclientv2.APIResponse, error)
+type getAuthorizationPolicyTargetFunc func(ctx context.Context, p *authorizationPolicyProxy, targetName string) (*PolicyTarget, *platformclientv2.APIResponse, error)
+
+// authorizationPolicyProxy contains all of the methods that call genesys cloud APIs.
+type authorizationPolicyProxy struct {
+	clientConfig                     *platformclientv2.Configuration
+	customApiClient                  *customapi.Client
+	createAuthorizationPolicyAttr    createAuthorizationPolicyFunc
+	getAllAuthorizationPoliciesAttr  getAllAuthorizationPoliciesFunc
+	getAuthorizationPolicyByIdAttr   getAuthorizationPolicyByIdFunc
+	updateAuthorizationPolicyAttr    updateAuthorizationPolicyFunc
+	deleteAuthorizationPolicyAttr    deleteAuthorizationPolicyFunc
+	getAuthorizationPolicyTargetAttr getAuthorizationPolicyTargetFunc
+}
+
+// newAuthorizationPolicyProxy initializes the authorization policy proxy with all of the data needed to communicate with Genesys Cloud
+func newAuthorizationPolicyProxy(clientConfig *platformclientv2.Configuration) *authorizationPolicyProxy {
+	return &authorizationPolicyProxy{
+		clientConfig:                     clientConfig,
+		customApiClient:                  customapi.NewClient(clientConfig, ResourceType),
+		createAuthorizationPolicyAttr:    createAuthorizationPolicyFn,
+		getAllAuthorizationPoliciesAttr:  getAllAuthorizationPoliciesFn,
+		getAuthorizationPolicyByIdAttr:   getAuthorizationPolicyByIdFn,
+		updateAuthorizationPolicyAttr:    updateAuthorizationPolicyFn,
+		deleteAuthorizationPolicyAttr:    deleteAuthorizationPolicyFn,
+		getAuthorizationPolicyTargetAttr: getAuthorizationPolicyTargetFn,
+	}
+}
+
+// getAuthorizationPolicyProxy acts as a singleton to for the internalProxy.  It also ensures
+// that we can still proxy our tests by directly setting internalProxy package variable
+func getAuthorizationPolicyProxy(clientConfig *platformclientv2.Configuration) *authorizationPolicyProxy {
+	if internalProxy == nil {
+		internalProxy = newAuthorizationPolicyProxy(clientConfig)
+	}
+	return internalProxy
+}
+
+// createAuthorizationPolicy creates a Genesys Cloud authorization policy for a policy target
+func (p *authorizationPolicyProxy) createAuthorizationPolicy(ctx context.Context, targetName string, policy *AuthorizationPolicy) (*AuthorizationPolicy, *platformclientv2.APIResponse, error) {
+	return p.createAuthorizationPolicyAttr(ctx, p, targetName, policy)
+}
+
+// getAllAuthorizationPolicies retrieves all Genesys Cloud authorization policies
+func (p *authorizationPolicyProxy) getAllAuthorizationPolicies(ctx context.Context) (*[]AuthorizationPolicy, *platformclientv2.APIResponse, error) {
+	return p.getAllAuthorizationPoliciesAttr(ctx, p)
+}
+
+// getAuthorizationPolicyById returns a single Genesys Cloud authorization policy by Id
+func (p *authorizationPolicyProxy) getAuthorizationPolicyById(ctx context.Context, id string) (*AuthorizationPolicy, *platformclientv2.APIResponse, error) {
+	return p.getAuthorizationPolicyByIdAttr(ctx, p, id)
+}
+
+// updateAuthorizationPolicy updates a Genesys Cloud authorization policy
+func (p *authorizationPolicyProxy) updateAuthorizationPolicy(ctx context.Context, id string, policy *AuthorizationPolicy) (*AuthorizationPolicy, *platformclientv2.APIResponse, error) {
+	return p.updateAuthorizationPolicyAttr(ctx, p, id, policy)
+}
+
+// deleteAuthorizationPolicy deletes a Genesys Cloud authorization policy by Id
+func (p *authorizationPolicyProxy) deleteAuthorizationPolicy(ctx context.Context, id string) (*platformclientv2.APIResponse, error) {
+	return p.deleteAuthorizationPolicyAttr(ctx, p, id)
+}
+
+// getAuthorizationPolicyTarget returns a Genesys Cloud authorization policy target with its attributes and presets
+func (p *authorizationPolicyProxy) getAuthorizationPolicyTarget(ctx context.Context, targetName string) (*PolicyTarget, *platformclientv2.APIResponse, error) {
+	return p.getAuthorizationPolicyTargetAttr(ctx, p, targetName)
+}
+
+// createAuthorizationPolicyFn is an implementation function for creating a Genesys Cloud authorization policy
+func createAuthorizationPolicyFn(ctx context.Context, p *authorizationPolicyProxy, targetName string, policy *AuthorizationPolicy) (*AuthorizationPolicy, *platformclientv2.APIResponse, error) {
+	ctx = provider.EnsureResourceContext(ctx, ResourceType)
+	return customapi.Do[AuthorizationPolicy](ctx, p.customApiClient, customapi.MethodPost, policiesPath+"/targets/"+url.PathEscape(targetName), policy, nil)
+}
+
+// getAllAuthorizationPoliciesFn is the implementation for retrieving all authorization policies in Genesys Cloud
+func getAllAuthorizationPoliciesFn(ctx context.Context, p *authorizationPolicyProxy) (*[]AuthorizationPolicy, *platformclientv2.APIResponse, error) {
+	ctx = provider.EnsureResourceContext(ctx, ResourceType)
+
+	var allPolicies []AuthorizationPolicy
+	queryParams := customapi.NewQueryParams(map[string]string{"pageSize": "100", "pageNumber": "1"})
+
+	policies, resp, err := customapi.Do[AuthorizationPolicyEntityListing](ctx, p.customApiClient, customapi.MethodGet, policiesPath, nil, queryParams)
+	if err != nil {
+		return nil, resp, fmt.Errorf("failed to get authorization policies: %v", err)
+	}
+	if policies.Entities == nil || len(*policies.Entities) == 0 {
+		return &allPolicies, resp, nil
+	}
+	allPolicies = append(allPolicies, *policies.Entities...)
+
+	if policies.PageCount == nil {
+		return &allPolicies, resp, nil
+	}
+	for pageNum := 2; pageNum <= *policies.PageCount; pageNum++ {
+		queryParams.Set("pageNumber", fmt.Sprintf("%v", pageNum))
+		policies, resp, err := customapi.Do[AuthorizationPolicyEntityListing](ctx, p.customApiClient, customapi.MethodGet, policiesPath, nil, queryParams)
+		if err != nil {
+			return nil, resp, fmt.Errorf("failed to get authorization policies: %v", err)
+		}
+		if policies.Entities == nil || len(*policies.Entities) == 0 {
+			break
+		}
+		allPolicies = append(allPolicies, *policies.Entities...)
+	}
+
+	return &allPolicies, resp, nil
+}
+
+// getAuthorizationPolicyByIdFn is an implementation of the function to get a Genesys Cloud authorization policy by Id
+func getAuthorizationPolicyByIdFn(ctx context.Context, p *authorizationPolicyProxy, id string) (*AuthorizationPolicy, *platformclientv2.APIResponse, error) {
+	ctx = provider.EnsureResourceContext(ctx, ResourceType)
+	return customapi.Do[AuthorizationPolicy](ctx, p.customApiClient, customapi.MethodGet, policiesPath+"/"+id, nil, nil)
+}
+
+// updateAuthorizationPolicyFn is an implementation of the function to update a Genesys Cloud authorization policy
+func updateAuthorizationPolicyFn(ctx context.Context, p *authorizationPolicyProxy, id string, policy *AuthorizationPolicy) (*AuthorizationPolicy, *platformclientv2.APIResponse, error) {
+	ctx = provider.EnsureResourceContext(ctx, ResourceType)
+	return customapi.Do[AuthorizationPolicy](ctx, p.customApiClient, customapi.MethodPut, policiesPath+"/"+id, policy, nil)
+}
+
+// deleteAuthorizationPolicyFn is an implementation function for deleting a Genesys Cloud authorization policy
+func deleteAuthorizationPolicyFn(ctx context.Context, p *authorizationPolicyProxy, id string) (*platformclientv2.APIResponse, error) {
+	ctx = provider.EnsureResourceContext(ctx, ResourceType)
+	return customapi.DoNoResponse(ctx, p.customApiClient, customapi.MethodDelete, policiesPath+"/"+id, nil, nil)
+}
+
+// getAuthorizationPolicyTargetFn is an implementation of the function to get a Genesys Cloud authorization policy target by name
+func getAuthorizationPolicyTargetFn(ctx context.Context, p *authorizationPolicyProxy, targetName string) (*PolicyTarget, *platformclientv2.APIResponse, error) {
+	ctx = provider.EnsureResourceContext(ctx, ResourceType)
+	return customapi.Do[PolicyTarget](ctx, p.customApiClient, customapi.MethodGet, policiesPath+"/targets/"+url.PathEscape(targetName), nil, nil)
+}
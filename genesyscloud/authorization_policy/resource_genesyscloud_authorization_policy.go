@@ -0,0 +1,131 @@
+package authorization_policy
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/consistency_checker"
+	"github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/provider"
+	resourceExporter "github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/resource_exporter"
+	"github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/util"
+	"github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/util/constants"
+	"github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/util/lists"
+	"github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/util/resourcedata"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mypurecloud/platform-client-sdk-go/v192/platformclientv2"
+)
+
+/*
+The resource_genesyscloud_authorization_policy.go contains all of the methods that perform the core logic for a resource.
+*/
+
+// getAllAuthorizationPolicies retrieves all of the authorization policies via Terraform in the Genesys Cloud and is used for the exporter
+func getAllAuthorizationPolicies(ctx context.Context, clientConfig *platformclientv2.Configuration) (resourceExporter.ResourceIDMetaMap, diag.Diagnostics) {
+	proxy := getAuthorizationPolicyProxy(clientConfig)
+	resources := make(resourceExporter.ResourceIDMetaMap)
+
+	policies, resp, err := proxy.getAllAuthorizationPolicies(ctx)
+	if err != nil {
+		return nil, util.BuildAPIDiagnosticError(ResourceType, fmt.Sprintf("Failed to get authorization policies error: %s", err), resp)
+	}
+
+	for _, policy := range *policies {
+		log.Printf("Dealing with authorization policy id: %s", *policy.Id)
+		resources[*policy.Id] = &resourceExporter.ResourceMeta{BlockLabel: *policy.Name}
+	}
+	return resources, nil
+}
+
+// createAuthorizationPolicy is used by the authorization_policy resource to create a Genesys Cloud authorization policy
+func createAuthorizationPolicy(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sdkConfig := meta.(*provider.ProviderMeta).ClientConfig
+	proxy := getAuthorizationPolicyProxy(sdkConfig)
+
+	policyRequest := getAuthorizationPolicyFromResourceData(d)
+
+	log.Printf("Creating authorization policy %s", *policyRequest.Name)
+	policy, resp, err := proxy.createAuthorizationPolicy(ctx, *policyRequest.PolicyTarget, &policyRequest)
+	if err != nil {
+		return util.BuildAPIDiagnosticError(ResourceType, fmt.Sprintf("Failed to create authorization policy %s error: %s", *policyRequest.Name, err), resp)
+	}
+
+	d.SetId(*policy.Id)
+	log.Printf("Created authorization policy %s", *policy.Id)
+	return readAuthorizationPolicy(ctx, d, meta)
+}
+
+// readAuthorizationPolicy is used by the authorization_policy resource to read an authorization policy from genesys cloud
+func readAuthorizationPolicy(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sdkConfig := meta.(*provider.ProviderMeta).ClientConfig
+	proxy := getAuthorizationPolicyProxy(sdkConfig)
+	cc := consistency_checker.NewConsistencyCheck(ctx, d, meta, ResourceAuthorizationPolicy(), constants.ConsistencyChecks(), ResourceType)
+
+	log.Printf("Reading authorization policy %s", d.Id())
+
+	return util.WithRetriesForRead(ctx, d, func() *retry.RetryError {
+		policy, resp, getErr := proxy.getAuthorizationPolicyById(ctx, d.Id())
+		if getErr != nil {
+			diagErr := util.BuildWithRetriesApiDiagnosticError(ResourceType, fmt.Sprintf("Failed to read authorization policy %s | error: %s", d.Id(), getErr), resp)
+			if util.IsStatus404(resp) {
+				return retry.RetryableError(diagErr)
+			}
+			return retry.NonRetryableError(diagErr)
+		}
+
+		resourcedata.SetNillableValue(d, "name", policy.Name)
+		resourcedata.SetNillableValue(d, "description", policy.Description)
+		resourcedata.SetNillableValue(d, "policy_target", policy.PolicyTarget)
+		_ = d.Set("action_set", lists.StringListToSetOrNil(policy.ActionSet))
+		resourcedata.SetNillableValue(d, "effect", policy.Effect)
+		resourcedata.SetNillableValue(d, "enabled", policy.Enabled)
+		resourcedata.SetNillableValueWithInterfaceArrayWithFunc(d, "condition", policy.Conditions, flattenPolicyConditions)
+
+		log.Printf("Read authorization policy %s %s", d.Id(), *policy.Name)
+		return cc.CheckState(d)
+	})
+}
+
+// updateAuthorizationPolicy is used by the authorization_policy resource to update an authorization policy in Genesys Cloud
+func updateAuthorizationPolicy(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sdkConfig := meta.(*provider.ProviderMeta).ClientConfig
+	proxy := getAuthorizationPolicyProxy(sdkConfig)
+
+	policyRequest := getAuthorizationPolicyFromResourceData(d)
+	policyRequest.Id = platformclientv2.String(d.Id())
+
+	log.Printf("Updating authorization policy %s", *policyRequest.Name)
+	if _, resp, err := proxy.updateAuthorizationPolicy(ctx, d.Id(), &policyRequest); err != nil {
+		return util.BuildAPIDiagnosticError(ResourceType, fmt.Sprintf("Failed to update authorization policy %s error: %s", *policyRequest.Name, err), resp)
+	}
+
+	log.Printf("Updated authorization policy %s", d.Id())
+	return readAuthorizationPolicy(ctx, d, meta)
+}
+
+// deleteAuthorizationPolicy is used by the authorization_policy resource to delete an authorization policy from Genesys cloud
+func deleteAuthorizationPolicy(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sdkConfig := meta.(*provider.ProviderMeta).ClientConfig
+	proxy := getAuthorizationPolicyProxy(sdkConfig)
+
+	resp, err := proxy.deleteAuthorizationPolicy(ctx, d.Id())
+	if err != nil {
+		return util.BuildAPIDiagnosticError(ResourceType, fmt.Sprintf("Failed to delete authorization policy %s error: %s", d.Id(), err), resp)
+	}
+
+	return util.WithRetries(ctx, 180*time.Second, func() *retry.RetryError {
+		_, resp, err := proxy.getAuthorizationPolicyById(ctx, d.Id())
+		if err != nil {
+			if util.IsStatus404(resp) {
+				log.Printf("Deleted authorization policy %s", d.Id())
+				return nil
+			}
+			return retry.NonRetryableError(util.BuildWithRetriesApiDiagnosticError(ResourceType, fmt.Sprintf("Error deleting authorization policy %s | error: %s", d.Id(), err), resp))
+		}
+		return retry.RetryableError(util.BuildWithRetriesApiDiagnosticError(ResourceType, fmt.Sprintf("Authorization policy %s still exists", d.Id()), resp))
+	})
+}
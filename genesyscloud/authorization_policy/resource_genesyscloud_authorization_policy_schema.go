@@ -0,0 +1,203 @@
+package authorization_policy
+
+import (
+	"github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/provider"
+	resourceExporter "github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/resource_exporter"
+	registrar "github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/resource_register"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+/*
+resource_genesyscloud_authorization_policy_schema.go holds four functions within it:
+
+1.  The registration code that registers the Datasource, Resource and Exporter for the package.
+2.  The resource schema definitions for the authorization_policy resource.
+3.  The datasource schema definitions for the authorization_policy_target datasource.
+4.  The resource exporter configuration for the authorization_policy exporter.
+*/
+const ResourceType = "genesyscloud_authorization_policy"
+const TargetDataSourceType = "genesyscloud_authorization_policy_target"
+
+// SetRegistrar registers all of the resources, datasources and exporters in the package
+func SetRegistrar(regInstance registrar.Registrar) {
+	regInstance.RegisterResource(ResourceType, ResourceAuthorizationPolicy())
+	regInstance.RegisterDataSource(TargetDataSourceType, DataSourceAuthorizationPolicyTarget())
+	regInstance.RegisterExporter(ResourceType, AuthorizationPolicyExporter())
+}
+
+var (
+	operandResource = &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"type": {
+				Description:  "The type of the operand. `SCALAR` compares against a literal value, `VARIABLE` against another attribute of the target and `PRESET` against a preset of the target.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice([]string{"SCALAR", "VARIABLE", "PRESET"}, false),
+			},
+			"value": {
+				Description: "The value of the operand. For `VARIABLE` and `PRESET` operands this is the name of the attribute or preset.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+		},
+	}
+
+	termResource = &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"variable_name": {
+				Description: "The name of the target attribute the term evaluates.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"operator": {
+				Description: "The operator used to compare the attribute with the operands, e.g. `EQUALS`, `NOT_EQUALS`, `CONTAINS_ANY` or `CONTAINS_ALL`.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"operand": {
+				Description: "The operands the attribute is compared with.",
+				Type:        schema.TypeList,
+				Required:    true,
+				MinItems:    1,
+				Elem:        operandResource,
+			},
+		},
+	}
+
+	conditionResource = &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"conjunction": {
+				Description:  "How the terms of the condition are combined.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "AND",
+				ValidateFunc: validation.StringInSlice([]string{"AND", "OR"}, false),
+			},
+			"term": {
+				Description: "The terms of the condition.",
+				Type:        schema.TypeList,
+				Required:    true,
+				MinItems:    1,
+				Elem:        termResource,
+			},
+		},
+	}
+
+	targetAttributeResource = &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Description: "The name of the attribute.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"type": {
+				Description: "The data type of the attribute.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"description": {
+				Description: "The description of the attribute.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+)
+
+// ResourceAuthorizationPolicy registers the genesyscloud_authorization_policy resource with Terraform
+func ResourceAuthorizationPolicy() *schema.Resource {
+	return &schema.Resource{
+		Description: `Genesys Cloud attribute-based access control (ABAC) authorization policy`,
+
+		CreateContext: provider.CreateWithPooledClient(createAuthorizationPolicy),
+		ReadContext:   provider.ReadWithPooledClient(readAuthorizationPolicy),
+		UpdateContext: provider.UpdateWithPooledClient(updateAuthorizationPolicy),
+		DeleteContext: provider.DeleteWithPooledClient(deleteAuthorizationPolicy),
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		SchemaVersion: 1,
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Description: "The name of the policy.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"description": {
+				Description: "The description of the policy.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"policy_target": {
+				Description: "The name of the target the policy applies to, e.g. `directory:user:add`. Changing the target will cause the policy to be dropped and recreated.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"action_set": {
+				Description: "The actions of the target governed by the policy.",
+				Type:        schema.TypeSet,
+				Required:    true,
+				MinItems:    1,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"effect": {
+				Description:  "Whether the policy allows or denies the actions when its condition is met.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "ALLOW",
+				ValidateFunc: validation.StringInSlice([]string{"ALLOW", "DENY"}, false),
+			},
+			"enabled": {
+				Description: "Whether the policy is enforced.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+			},
+			"condition": {
+				Description: "The condition under which the policy applies. If not set, the policy always applies.",
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Elem:        conditionResource,
+			},
+		},
+	}
+}
+
+// AuthorizationPolicyExporter returns the resourceExporter object used to hold the genesyscloud_authorization_policy exporter's config
+func AuthorizationPolicyExporter() *resourceExporter.ResourceExporter {
+	return &resourceExporter.ResourceExporter{
+		GetResourcesFunc: provider.GetAllWithPooledClient(getAllAuthorizationPolicies),
+		RefAttrs:         map[string]*resourceExporter.RefAttrSettings{},
+	}
+}
+
+// DataSourceAuthorizationPolicyTarget registers the genesyscloud_authorization_policy_target data source
+func DataSourceAuthorizationPolicyTarget() *schema.Resource {
+	return &schema.Resource{
+		Description: `Genesys Cloud authorization policy target data source. Select a policy target by its name to look up the attributes and presets available to policy conditions.`,
+		ReadContext: provider.ReadWithPooledClient(dataSourceAuthorizationPolicyTargetRead),
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Description: "The name of the policy target, e.g. `directory:user:add`.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"attributes": {
+				Description: "The attributes of the target that can be used as condition variables.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        targetAttributeResource,
+			},
+			"presets": {
+				Description: "The presets of the target that can be used as condition operands.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        targetAttributeResource,
+			},
+		},
+	}
+}
@@ -0,0 +1,106 @@
+package authorization_policy
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/provider"
+	"github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/util"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+/*
+The resource_genesyscloud_authorization_policy_test.go contains all of the test cases for running the resource
+tests for authorization_policy.
+*/
+
+func TestAccResourceAuthorizationPolicy(t *testing.T) {
+	t.Parallel()
+	var (
+		resourceLabel    = "policy"
+		fullResourcePath = ResourceType + "." + resourceLabel
+		policyName       = "Terraform Policy " + uuid.NewString()
+		policyTarget     = "directory:user:add"
+	)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { util.TestAccPreCheck(t) },
+		ProviderFactories: provider.GetProviderFactories(providerResources, providerDataSources),
+		Steps: []resource.TestStep{
+			{
+				// Create
+				Config: GenerateAuthorizationPolicyResource(
+					resourceLabel,
+					policyName,
+					policyTarget,
+					util.GenerateStringArrayEnquote("add"),
+					"ALLOW",
+					GenerateConditionBlock("AND",
+						GenerateTermBlock("user.location", "EQUALS", "SCALAR", "Home"),
+					),
+				),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fullResourcePath, "name", policyName),
+					resource.TestCheckResourceAttr(fullResourcePath, "policy_target", policyTarget),
+					resource.TestCheckResourceAttr(fullResourcePath, "effect", "ALLOW"),
+					resource.TestCheckResourceAttr(fullResourcePath, "enabled", "true"),
+					resource.TestCheckResourceAttr(fullResourcePath, "condition.0.conjunction", "AND"),
+					resource.TestCheckResourceAttr(fullResourcePath, "condition.0.term.0.variable_name", "user.location"),
+					resource.TestCheckResourceAttr(fullResourcePath, "condition.0.term.0.operand.0.value", "Home"),
+				),
+			},
+			{
+				// Update
+				Config: GenerateAuthorizationPolicyResource(
+					resourceLabel,
+					policyName,
+					policyTarget,
+					util.GenerateStringArrayEnquote("add"),
+					"DENY",
+					"enabled = false",
+					GenerateConditionBlock("OR",
+						GenerateTermBlock("user.location", "EQUALS", "SCALAR", "Home"),
+						GenerateTermBlock("user.location", "EQUALS", "SCALAR", "Office"),
+					),
+				),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fullResourcePath, "effect", "DENY"),
+					resource.TestCheckResourceAttr(fullResourcePath, "enabled", "false"),
+					resource.TestCheckResourceAttr(fullResourcePath, "condition.0.conjunction", "OR"),
+					resource.TestCheckResourceAttr(fullResourcePath, "condition.0.term.#", "2"),
+					resource.TestCheckResourceAttr(fullResourcePath, "condition.0.term.1.operand.0.value", "Office"),
+				),
+			},
+			{
+				// Import/Read
+				ResourceName:      fullResourcePath,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+		CheckDestroy: testVerifyAuthorizationPolicyDestroyed,
+	})
+}
+
+func testVerifyAuthorizationPolicyDestroyed(state *terraform.State) error {
+	proxy := getAuthorizationPolicyProxy(sdkConfig)
+	for _, rs := range state.RootModule().Resources {
+		if rs.Type != ResourceType {
+			continue
+		}
+
+		policy, resp, err := proxy.getAuthorizationPolicyById(context.Background(), rs.Primary.ID)
+		if policy != nil && policy.Id != nil {
+			return fmt.Errorf("authorization policy (%s) still exists", rs.Primary.ID)
+		}
+		if util.IsStatus404(resp) {
+			continue
+		}
+		return fmt.Errorf("unexpected error: %s", err)
+	}
+	return nil
+}
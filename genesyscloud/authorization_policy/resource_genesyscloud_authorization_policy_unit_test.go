@@ -0,0 +1,87 @@
+package authorization_policy
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/provider"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mypurecloud/platform-client-sdk-go/v192/platformclientv2"
+	"github.com/stretchr/testify/assert"
+)
+
+/** Unit Test **/
+func TestUnitResourceAuthorizationPolicyCreate(t *testing.T) {
+	tId := uuid.NewString()
+	tName := "Unit Test Policy"
+	tTarget := "directory:user:add"
+	tDivisionId := uuid.NewString()
+
+	var storedPolicy AuthorizationPolicy
+
+	policyProxy := &authorizationPolicyProxy{}
+	policyProxy.createAuthorizationPolicyAttr = func(ctx context.Context, p *authorizationPolicyProxy, targetName string, policy *AuthorizationPolicy) (*AuthorizationPolicy, *platformclientv2.APIResponse, error) {
+		assert.Equal(t, tTarget, targetName)
+		assert.Equal(t, tName, *policy.Name)
+		assert.Equal(t, "DENY", *policy.Effect)
+		assert.Equal(t, false, *policy.Enabled)
+		assert.Equal(t, []string{"add"}, *policy.ActionSet)
+		assert.Equal(t, "OR", *policy.Conditions.Conjunction)
+
+		term := (*policy.Conditions.Terms)[0]
+		assert.Equal(t, "user.division.id", *term.VariableName)
+		assert.Equal(t, "EQUALS", *term.Operator)
+		assert.Equal(t, "SCALAR", *(*term.Operands)[0].Type)
+		assert.Equal(t, tDivisionId, *(*term.Operands)[0].Value)
+
+		storedPolicy = *policy
+		storedPolicy.Id = &tId
+		return &storedPolicy, &platformclientv2.APIResponse{StatusCode: http.StatusOK}, nil
+	}
+	policyProxy.getAuthorizationPolicyByIdAttr = func(ctx context.Context, p *authorizationPolicyProxy, id string) (*AuthorizationPolicy, *platformclientv2.APIResponse, error) {
+		assert.Equal(t, tId, id)
+		return &storedPolicy, &platformclientv2.APIResponse{StatusCode: http.StatusOK}, nil
+	}
+	internalProxy = policyProxy
+	defer func() { internalProxy = nil }()
+
+	ctx := context.Background()
+	gcloud := &provider.ProviderMeta{ClientConfig: &platformclientv2.Configuration{}}
+
+	resourceDataMap := map[string]interface{}{
+		"name":          tName,
+		"policy_target": tTarget,
+		"action_set":    []interface{}{"add"},
+		"effect":        "DENY",
+		"enabled":       false,
+		"condition": []interface{}{
+			map[string]interface{}{
+				"conjunction": "OR",
+				"term": []interface{}{
+					map[string]interface{}{
+						"variable_name": "user.division.id",
+						"operator":      "EQUALS",
+						"operand": []interface{}{
+							map[string]interface{}{
+								"type":  "SCALAR",
+								"value": tDivisionId,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	d := schema.TestResourceDataRaw(t, ResourceAuthorizationPolicy().Schema, resourceDataMap)
+
+	diag := createAuthorizationPolicy(ctx, d, gcloud)
+	assert.Equal(t, false, diag.HasError())
+	assert.Equal(t, tId, d.Id())
+	assert.Equal(t, tName, d.Get("name").(string))
+	assert.Equal(t, false, d.Get("enabled").(bool))
+	assert.Equal(t, "OR", d.Get("condition.0.conjunction").(string))
+	assert.Equal(t, tDivisionId, d.Get("condition.0.term.0.operand.0.value").(string))
+}
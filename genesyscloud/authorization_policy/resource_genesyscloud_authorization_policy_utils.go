@@ -0,0 +1,134 @@
+package authorization_policy
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/util/lists"
+	"github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/util/resourcedata"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mypurecloud/platform-client-sdk-go/v192/platformclientv2"
+)
+
+/*
+The resource_genesyscloud_authorization_policy_utils.go file contains various helper methods to marshal
+and unmarshal data into formats consumable by Terraform and/or Genesys Cloud.
+*/
+
+// getAuthorizationPolicyFromResourceData maps data from schema ResourceData object to an AuthorizationPolicy
+func getAuthorizationPolicyFromResourceData(d *schema.ResourceData) AuthorizationPolicy {
+	return AuthorizationPolicy{
+		Name:         platformclientv2.String(d.Get("name").(string)),
+		Description:  platformclientv2.String(d.Get("description").(string)),
+		PolicyTarget: platformclientv2.String(d.Get("policy_target").(string)),
+		ActionSet:    lists.BuildSdkStringList(d, "action_set"),
+		Effect:       platformclientv2.String(d.Get("effect").(string)),
+		Enabled:      platformclientv2.Bool(d.Get("enabled").(bool)),
+		Conditions:   resourcedata.BuildSdkListFirstElement(d, "condition", buildPolicyConditions, true),
+	}
+}
+
+// buildPolicyConditions maps a condition block to PolicyConditions
+func buildPolicyConditions(conditionMap map[string]interface{}) *PolicyConditions {
+	terms := make([]PolicyTerm, 0)
+	for _, termItem := range conditionMap["term"].([]interface{}) {
+		termMap := termItem.(map[string]interface{})
+
+		operands := make([]PolicyOperand, 0)
+		for _, operandItem := range termMap["operand"].([]interface{}) {
+			operandMap := operandItem.(map[string]interface{})
+			operands = append(operands, PolicyOperand{
+				Type:  platformclientv2.String(operandMap["type"].(string)),
+				Value: platformclientv2.String(operandMap["value"].(string)),
+			})
+		}
+
+		terms = append(terms, PolicyTerm{
+			VariableName: platformclientv2.String(termMap["variable_name"].(string)),
+			Operator:     platformclientv2.String(termMap["operator"].(string)),
+			Operands:     &operands,
+		})
+	}
+
+	return &PolicyConditions{
+		Conjunction: platformclientv2.String(conditionMap["conjunction"].(string)),
+		Terms:       &terms,
+	}
+}
+
+// flattenPolicyConditions maps PolicyConditions to a condition block
+func flattenPolicyConditions(conditions *PolicyConditions) []interface{} {
+	if conditions.Terms == nil || len(*conditions.Terms) == 0 {
+		return nil
+	}
+
+	terms := make([]interface{}, 0)
+	for _, term := range *conditions.Terms {
+		operands := make([]interface{}, 0)
+		if term.Operands != nil {
+			for _, operand := range *term.Operands {
+				operandMap := make(map[string]interface{})
+				resourcedata.SetMapValueIfNotNil(operandMap, "type", operand.Type)
+				resourcedata.SetMapValueIfNotNil(operandMap, "value", operand.Value)
+				operands = append(operands, operandMap)
+			}
+		}
+
+		termMap := make(map[string]interface{})
+		resourcedata.SetMapValueIfNotNil(termMap, "variable_name", term.VariableName)
+		resourcedata.SetMapValueIfNotNil(termMap, "operator", term.Operator)
+		termMap["operand"] = operands
+		terms = append(terms, termMap)
+	}
+
+	conditionMap := make(map[string]interface{})
+	resourcedata.SetMapValueIfNotNil(conditionMap, "conjunction", conditions.Conjunction)
+	conditionMap["term"] = terms
+	return []interface{}{conditionMap}
+}
+
+// flattenPolicyTargetAttributes maps a list of PolicyTargetAttribute to a list of attribute blocks
+func flattenPolicyTargetAttributes(attributes *[]PolicyTargetAttribute) []interface{} {
+	attributeList := make([]interface{}, 0)
+	if attributes == nil {
+		return attributeList
+	}
+	for _, attribute := range *attributes {
+		attributeMap := make(map[string]interface{})
+		resourcedata.SetMapValueIfNotNil(attributeMap, "name", attribute.Name)
+		resourcedata.SetMapValueIfNotNil(attributeMap, "type", attribute.Type)
+		resourcedata.SetMapValueIfNotNil(attributeMap, "description", attribute.Description)
+		attributeList = append(attributeList, attributeMap)
+	}
+	return attributeList
+}
+
+func GenerateAuthorizationPolicyResource(resourceLabel, name, policyTarget, actionSet, effect string, nestedBlocks ...string) string {
+	return fmt.Sprintf(`resource "%s" "%s" {
+		name          = "%s"
+		policy_target = "%s"
+		action_set    = %s
+		effect        = "%s"
+		%s
+	}
+	`, ResourceType, resourceLabel, name, policyTarget, actionSet, effect, strings.Join(nestedBlocks, "\n"))
+}
+
+func GenerateConditionBlock(conjunction string, terms ...string) string {
+	return fmt.Sprintf(`condition {
+			conjunction = "%s"
+			%s
+		}`, conjunction, strings.Join(terms, "\n"))
+}
+
+func GenerateTermBlock(variableName, operator, operandType, operandValue string) string {
+	return fmt.Sprintf(`term {
+				variable_name = "%s"
+				operator      = "%s"
+				operand {
+					type  = "%s"
+					value = "%s"
+				}
+			}`, variableName, operator, operandType, operandValue)
+}
@@ -0,0 +1,247 @@
+package export_cli
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/provider"
+	providerRegistrar "github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/provider_registrar"
+	"github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/tfexporter"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+/*
+The export_cli package implements the "export" subcommand of the provider binary. It runs the same export as a
+genesyscloud_tf_export resource without a Terraform working directory:
+
+ 1. The flags are generated from the genesyscloud_tf_export schema, so every attribute of the resource is available as
+    a flag with its underscores replaced by hyphens, e.g. include_filter_resources becomes --include-filter-resources.
+ 2. The provider is configured from the usual GENESYSCLOUD_* environment variables, exactly as Terraform would
+    configure it from an empty provider block.
+ 3. The genesyscloud_tf_export resource is validated, planned and applied with the configuration built from the flags.
+*/
+
+const (
+	// CommandName is the first argument of the provider binary that runs the export subcommand
+	CommandName = "export"
+
+	exitCodeSuccess = 0
+	exitCodeError   = 1
+	exitCodeUsage   = 2
+)
+
+// Run runs the export subcommand with the arguments that follow the command name and returns the process exit code
+func Run(ctx context.Context, args []string, version string, stdout io.Writer, stderr io.Writer) int {
+	// The provider logs through the standard logger. Like Terraform, only show those logs when TF_LOG is set.
+	if os.Getenv("TF_LOG") == "" {
+		log.SetOutput(io.Discard)
+	}
+
+	providerResources, providerDataSources := providerRegistrar.GetProviderResources()
+	// The export resource validates resource types against the registered exporters, so it must be built after them
+	exportResource := tfexporter.ResourceTfExport()
+
+	config, err := parseExportFlags(args, exportResource.Schema, stderr)
+	if err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return exitCodeSuccess
+		}
+		fmt.Fprintf(stderr, "Error: %v\n", err)
+		return exitCodeUsage
+	}
+
+	resourceConfig := terraform.NewResourceConfigRaw(config)
+	if diags := exportResource.Validate(resourceConfig); diags.HasError() {
+		printDiagnostics(stderr, diags)
+		return exitCodeUsage
+	}
+
+	genesysCloudProvider := provider.New(version, providerResources, providerDataSources)()
+	if diags := genesysCloudProvider.Configure(ctx, terraform.NewResourceConfigRaw(map[string]interface{}{})); diags.HasError() {
+		printDiagnostics(stderr, diags)
+		return exitCodeError
+	}
+	meta := genesysCloudProvider.Meta()
+
+	instanceDiff, err := exportResource.Diff(ctx, nil, resourceConfig, meta)
+	if err != nil {
+		fmt.Fprintf(stderr, "Error: failed to plan export: %v\n", err)
+		return exitCodeError
+	}
+
+	state, diags := exportResource.Apply(ctx, nil, instanceDiff, meta)
+	printDiagnostics(stderr, diags)
+	if diags.HasError() {
+		return exitCodeError
+	}
+
+	exportDirectory := ""
+	if state != nil {
+		exportDirectory = state.ID
+	}
+	fmt.Fprintf(stdout, "Exported Genesys Cloud configuration to %s\n", exportDirectory)
+	return exitCodeSuccess
+}
+
+// flagName returns the command line flag of a genesyscloud_tf_export attribute
+func flagName(attribute string) string {
+	return strings.ReplaceAll(attribute, "_", "-")
+}
+
+// stringListFlag collects the values of a flag that can be repeated, one value per occurrence
+type stringListFlag []string
+
+func (s *stringListFlag) String() string {
+	if s == nil {
+		return ""
+	}
+	return strings.Join(*s, ",")
+}
+
+func (s *stringListFlag) Set(value string) error {
+	*s = append(*s, value)
+	return nil
+}
+
+// stringMapFlag collects the entries of a map flag, one key=value entry per occurrence
+type stringMapFlag map[string]string
+
+func (s stringMapFlag) String() string {
+	entries := make([]string, 0, len(s))
+	for key, value := range s {
+		entries = append(entries, key+"="+value)
+	}
+	sort.Strings(entries)
+	return strings.Join(entries, ",")
+}
+
+func (s stringMapFlag) Set(value string) error {
+	key, mapValue, found := strings.Cut(value, "=")
+	if !found || key == "" {
+		return fmt.Errorf("expected key=value, got %s", strconv.Quote(value))
+	}
+	s[key] = mapValue
+	return nil
+}
+
+// parseExportFlags parses the arguments of the export subcommand into a genesyscloud_tf_export configuration. Only the
+// flags given on the command line are part of the configuration, so the schema defaults apply to the others.
+func parseExportFlags(args []string, exportSchema map[string]*schema.Schema, output io.Writer) (map[string]interface{}, error) {
+	flagSet := flag.NewFlagSet(CommandName, flag.ContinueOnError)
+	flagSet.SetOutput(output)
+	flagSet.Usage = func() {
+		fmt.Fprintf(output, "Usage: terraform-provider-genesyscloud %s [flags]\n\n", CommandName)
+		fmt.Fprintln(output, "Exports Genesys Cloud configuration like a genesyscloud_tf_export resource, without a Terraform working directory.")
+		fmt.Fprintln(output, "Credentials are read from the GENESYSCLOUD_OAUTHCLIENT_ID, GENESYSCLOUD_OAUTHCLIENT_SECRET and GENESYSCLOUD_REGION")
+		fmt.Fprintln(output, "environment variables, or GENESYSCLOUD_ACCESS_TOKEN. Flags that take a list or a map can be repeated.")
+		fmt.Fprintln(output, "\nFlags:")
+		flagSet.PrintDefaults()
+	}
+
+	attributes := make(map[string]string)
+	readers := make(map[string]func() interface{})
+	for _, attribute := range sortedAttributes(exportSchema) {
+		attributeSchema := exportSchema[attribute]
+		if !attributeSchema.Optional && !attributeSchema.Required {
+			continue
+		}
+		name := flagName(attribute)
+		usage := attributeSchema.Description
+		if attributeSchema.Deprecated != "" {
+			usage = "Deprecated: " + attributeSchema.Deprecated + ". " + usage
+		}
+
+		switch attributeSchema.Type {
+		case schema.TypeString:
+			defaultValue, _ := attributeSchema.Default.(string)
+			value := flagSet.String(name, defaultValue, usage)
+			readers[name] = func() interface{} { return *value }
+		case schema.TypeBool:
+			defaultValue, _ := attributeSchema.Default.(bool)
+			value := flagSet.Bool(name, defaultValue, usage)
+			readers[name] = func() interface{} { return *value }
+		case schema.TypeInt:
+			defaultValue, _ := attributeSchema.Default.(int)
+			value := flagSet.Int(name, defaultValue, usage)
+			readers[name] = func() interface{} { return *value }
+		case schema.TypeList, schema.TypeSet:
+			if elem, ok := attributeSchema.Elem.(*schema.Schema); !ok || elem.Type != schema.TypeString {
+				log.Printf("Attribute %s of %s has no command line flag as it is not a list of strings", attribute, tfexporter.ResourceType)
+				continue
+			}
+			value := &stringListFlag{}
+			flagSet.Var(value, name, usage)
+			readers[name] = func() interface{} {
+				list := make([]interface{}, 0, len(*value))
+				for _, item := range *value {
+					list = append(list, item)
+				}
+				return list
+			}
+		case schema.TypeMap:
+			if elem, ok := attributeSchema.Elem.(*schema.Schema); !ok || elem.Type != schema.TypeString {
+				log.Printf("Attribute %s of %s has no command line flag as it is not a map of strings", attribute, tfexporter.ResourceType)
+				continue
+			}
+			value := stringMapFlag{}
+			flagSet.Var(value, name, usage+" Each occurrence sets one key=value entry.")
+			readers[name] = func() interface{} {
+				entries := make(map[string]interface{}, len(value))
+				for key, item := range value {
+					entries[key] = item
+				}
+				return entries
+			}
+		default:
+			log.Printf("Attribute %s of %s has no command line flag as its type is not supported", attribute, tfexporter.ResourceType)
+			continue
+		}
+		attributes[name] = attribute
+	}
+
+	if err := flagSet.Parse(args); err != nil {
+		return nil, err
+	}
+	if flagSet.NArg() > 0 {
+		return nil, fmt.Errorf("unexpected argument %s, all export settings are flags", strconv.Quote(flagSet.Arg(0)))
+	}
+
+	config := make(map[string]interface{})
+	flagSet.Visit(func(f *flag.Flag) {
+		config[attributes[f.Name]] = readers[f.Name]()
+	})
+	return config, nil
+}
+
+func sortedAttributes(exportSchema map[string]*schema.Schema) []string {
+	attributes := make([]string, 0, len(exportSchema))
+	for attribute := range exportSchema {
+		attributes = append(attributes, attribute)
+	}
+	sort.Strings(attributes)
+	return attributes
+}
+
+func printDiagnostics(w io.Writer, diags diag.Diagnostics) {
+	for _, d := range diags {
+		severity := "Error"
+		if d.Severity == diag.Warning {
+			severity = "Warning"
+		}
+		fmt.Fprintf(w, "%s: %s\n", severity, d.Summary)
+		if d.Detail != "" {
+			fmt.Fprintf(w, "  %s\n", d.Detail)
+		}
+	}
+}
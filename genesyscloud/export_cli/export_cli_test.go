@@ -0,0 +1,117 @@
+package export_cli
+
+import (
+	"bytes"
+	"errors"
+	"flag"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func testExportSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"directory": {
+			Description: "Directory where the config and state files will be exported.",
+			Type:        schema.TypeString,
+			Optional:    true,
+			Default:     "./genesyscloud",
+		},
+		"include_filter_resources": {
+			Description: "Include only resources that match either a resource type or a resource type::regular expression.",
+			Type:        schema.TypeList,
+			Optional:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+		},
+		"include_state_file": {
+			Description: "Export a 'terraform.tfstate' file along with the config file.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+		},
+		"max_concurrent_threads": {
+			Description: "Maximum number of concurrent threads to use during export process.",
+			Type:        schema.TypeInt,
+			Optional:    true,
+			Default:     10,
+		},
+		"export_as_hcl": {
+			Description: "Export the config as HCL.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Deprecated:  "Use export_format instead",
+		},
+		"label_templates": {
+			Description: "Go templates used to build the block labels of exported resources, keyed by resource type.",
+			Type:        schema.TypeMap,
+			Optional:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+		},
+		"id": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+// TestParseExportFlags asserts that flags are named after the schema attributes and that only the flags given on the
+// command line end up in the configuration
+func TestParseExportFlags(t *testing.T) {
+	config, err := parseExportFlags([]string{
+		"--directory", "./out",
+		"--include-filter-resources", "genesyscloud_user::^Jane",
+		"--include-filter-resources", "genesyscloud_routing_queue",
+		"--include-state-file",
+		"--max-concurrent-threads=4",
+		"--label-templates", "genesyscloud_routing_queue={{.name}}_{{.division_name}}",
+	}, testExportSchema(), &bytes.Buffer{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := map[string]interface{}{
+		"directory":                "./out",
+		"include_filter_resources": []interface{}{"genesyscloud_user::^Jane", "genesyscloud_routing_queue"},
+		"include_state_file":       true,
+		"max_concurrent_threads":   4,
+		"label_templates":          map[string]interface{}{"genesyscloud_routing_queue": "{{.name}}_{{.division_name}}"},
+	}
+	if !reflect.DeepEqual(expected, config) {
+		t.Errorf("expected config %v, got %v", expected, config)
+	}
+
+	config, err = parseExportFlags(nil, testExportSchema(), &bytes.Buffer{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(config) != 0 {
+		t.Errorf("expected the schema defaults to apply when no flag is given, got %v", config)
+	}
+}
+
+// TestParseExportFlagsErrors asserts that unknown flags, computed attributes and positional arguments are rejected
+func TestParseExportFlagsErrors(t *testing.T) {
+	for _, args := range [][]string{
+		{"--unknown-flag"},
+		{"--id", "abc"},
+		{"--directory", "./out", "genesyscloud_user"},
+		{"--label-templates", "genesyscloud_routing_queue"},
+	} {
+		if _, err := parseExportFlags(args, testExportSchema(), &bytes.Buffer{}); err == nil {
+			t.Errorf("expected an error for arguments %v", args)
+		}
+	}
+
+	var output bytes.Buffer
+	_, err := parseExportFlags([]string{"--help"}, testExportSchema(), &output)
+	if !errors.Is(err, flag.ErrHelp) {
+		t.Errorf("expected flag.ErrHelp, got %v", err)
+	}
+	for _, expected := range []string{"-include-filter-resources", "GENESYSCLOUD_OAUTHCLIENT_ID", "Deprecated: Use export_format instead"} {
+		if !strings.Contains(output.String(), expected) {
+			t.Errorf("expected the usage to contain %q, got %s", expected, output.String())
+		}
+	}
+}
@@ -44,19 +44,21 @@ type getExternalContactByIdFunc func(ctx context.Context, p *externalContactsCon
 type getExternalContactIdBySearchFunc func(ctx context.Context, p *externalContactsContactsProxy, search string) (externalContactId string, retryable bool, response *platformclientv2.APIResponse, err error)
 type updateExternalContactFunc func(ctx context.Context, p *externalContactsContactsProxy, externalContactId string, externalContact platformclientv2.Externalcontact) (*platformclientv2.Externalcontact, *platformclientv2.APIResponse, error)
 type getExternalContactsOrganizationByIdFunc func(ctx context.Context, p *externalContactsContactsProxy, id string) (externalOrganization *platformclientv2.Externalorganization, apiResponse *platformclientv2.APIResponse, err error)
+type getExternalContactsContactSchemaByIdFunc func(ctx context.Context, p *externalContactsContactsProxy, schemaId string) (schema *platformclientv2.Dataschema, apiResponse *platformclientv2.APIResponse, err error)
 
 // externalContactsContactsProxy contains all of the methods that call genesys cloud APIs.
 type externalContactsContactsProxy struct {
-	clientConfig                            *platformclientv2.Configuration
-	externalContactsApi                     *platformclientv2.ExternalContactsApi
-	getAllExternalContactsAttr              getAllExternalContactsFunc
-	createExternalContactAttr               createExternalContactFunc
-	deleteExternalContactByIdAttr           deleteExternalContactFunc
-	getExternalContactByIdAttr              getExternalContactByIdFunc
-	getExternalContactIdBySearchAttr        getExternalContactIdBySearchFunc
-	updateExternalContactAttr               updateExternalContactFunc
-	getExternalContactsOrganizationByIdAttr getExternalContactsOrganizationByIdFunc
-	externalContactsCache                   rc.CacheInterface[platformclientv2.Externalcontact]
+	clientConfig                             *platformclientv2.Configuration
+	externalContactsApi                      *platformclientv2.ExternalContactsApi
+	getAllExternalContactsAttr               getAllExternalContactsFunc
+	createExternalContactAttr                createExternalContactFunc
+	deleteExternalContactByIdAttr            deleteExternalContactFunc
+	getExternalContactByIdAttr               getExternalContactByIdFunc
+	getExternalContactIdBySearchAttr         getExternalContactIdBySearchFunc
+	updateExternalContactAttr                updateExternalContactFunc
+	getExternalContactsOrganizationByIdAttr  getExternalContactsOrganizationByIdFunc
+	getExternalContactsContactSchemaByIdAttr getExternalContactsContactSchemaByIdFunc
+	externalContactsCache                    rc.CacheInterface[platformclientv2.Externalcontact]
 }
 
 // newExternalContactsContactsProxy initializes the External Contacts proxy with all of the data needed to communicate with Genesys Cloud
@@ -64,16 +66,17 @@ func newExternalContactsContactsProxy(clientConfig *platformclientv2.Configurati
 	api := platformclientv2.NewExternalContactsApiWithConfig(clientConfig)
 	externalContactsCache := rc.NewResourceCache[platformclientv2.Externalcontact]()
 	return &externalContactsContactsProxy{
-		clientConfig:                            clientConfig,
-		externalContactsApi:                     api,
-		externalContactsCache:                   externalContactsCache,
-		getAllExternalContactsAttr:              getAllExternalContactsFn,
-		createExternalContactAttr:               createExternalContactFn,
-		getExternalContactByIdAttr:              getExternalContactByIdFn,
-		deleteExternalContactByIdAttr:           deleteExternalContactsFn,
-		getExternalContactIdBySearchAttr:        getExternalContactIdBySearchFn,
-		updateExternalContactAttr:               updateExternalContactFn,
-		getExternalContactsOrganizationByIdAttr: getExternalContactsOrganizationByIdFn,
+		clientConfig:                             clientConfig,
+		externalContactsApi:                      api,
+		externalContactsCache:                    externalContactsCache,
+		getAllExternalContactsAttr:               getAllExternalContactsFn,
+		createExternalContactAttr:                createExternalContactFn,
+		getExternalContactByIdAttr:               getExternalContactByIdFn,
+		deleteExternalContactByIdAttr:            deleteExternalContactsFn,
+		getExternalContactIdBySearchAttr:         getExternalContactIdBySearchFn,
+		updateExternalContactAttr:                updateExternalContactFn,
+		getExternalContactsOrganizationByIdAttr:  getExternalContactsOrganizationByIdFn,
+		getExternalContactsContactSchemaByIdAttr: getExternalContactsContactSchemaByIdFn,
 	}
 }
 
@@ -124,6 +127,11 @@ func (p *externalContactsContactsProxy) getExternalContactsOrganizationById(ctx
 	return p.getExternalContactsOrganizationByIdAttr(ctx, p, id)
 }
 
+// getExternalContactsContactSchemaById returns a single Genesys Cloud external contacts contact schema by Id
+func (p *externalContactsContactsProxy) getExternalContactsContactSchemaById(ctx context.Context, schemaId string) (schema *platformclientv2.Dataschema, apiResponse *platformclientv2.APIResponse, err error) {
+	return p.getExternalContactsContactSchemaByIdAttr(ctx, p, schemaId)
+}
+
 // getAllExternalContactsFn is the implementation for retrieving all external contacts in Genesys Cloud
 func getAllExternalContactsFn(ctx context.Context, p *externalContactsContactsProxy) (*[]platformclientv2.Externalcontact, *platformclientv2.APIResponse, error) {
 	// Set resource context for SDK debug logging
@@ -233,3 +241,11 @@ func getExternalContactsOrganizationByIdFn(ctx context.Context, p *externalConta
 
 	return p.externalContactsApi.GetExternalcontactsOrganization(id, []string{}, false)
 }
+
+// getExternalContactsContactSchemaByIdFn is an implementation of the function to get a Genesys Cloud external contacts contact schema by Id
+func getExternalContactsContactSchemaByIdFn(ctx context.Context, p *externalContactsContactsProxy, schemaId string) (schema *platformclientv2.Dataschema, apiResponse *platformclientv2.APIResponse, err error) {
+	// Set resource context for SDK debug logging
+	ctx = provider.EnsureResourceContext(ctx, ResourceType)
+
+	return p.externalContactsApi.GetExternalcontactsContactsSchema(schemaId)
+}
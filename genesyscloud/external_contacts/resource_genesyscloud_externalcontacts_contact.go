@@ -99,6 +99,9 @@ func createExternalContact(ctx context.Context, d *schema.ResourceData, meta int
 
 	log.Printf("Creating external contact")
 	externalContact := getExternalContactFromResourceData(d)
+	if diagErr := buildSdkContactSchemaAndCustomFields(ctx, ep, d, &externalContact); diagErr != nil {
+		return diagErr
+	}
 	contact, resp, err := ep.createExternalContact(ctx, externalContact)
 	if err != nil {
 		return util.BuildAPIDiagnosticError(ResourceType, fmt.Sprintf("Failed to create external contact error: %s", err), resp)
@@ -153,6 +156,18 @@ func readExternalContact(ctx context.Context, d *schema.ResourceData, meta inter
 		if externalContact.ExternalOrganization != nil && externalContact.ExternalOrganization.Id != nil {
 			_ = d.Set("external_organization_id", externalContact.ExternalOrganization.Id)
 		}
+		if externalContact.Schema != nil {
+			resourcedata.SetNillableValue(d, "schema_id", externalContact.Schema.Id)
+			resourcedata.SetNillableValue(d, "schema_version", externalContact.Schema.Version)
+		} else {
+			_ = d.Set("schema_id", nil)
+			_ = d.Set("schema_version", nil)
+		}
+		customFields, err := flattenCustomFields(externalContact.CustomFields)
+		if err != nil {
+			return retry.NonRetryableError(util.BuildWithRetriesApiDiagnosticError(ResourceType, fmt.Sprintf("Failed to flatten custom fields of external contact %s | error: %s", d.Id(), err), resp))
+		}
+		_ = d.Set("custom_fields", customFields)
 
 		log.Printf("Read external contact %s", d.Id())
 
@@ -167,6 +182,9 @@ func updateExternalContact(ctx context.Context, d *schema.ResourceData, meta int
 
 	log.Printf("Updating external contact %s", d.Id())
 	externalContact := getExternalContactFromResourceData(d)
+	if diagErr := buildSdkContactSchemaAndCustomFields(ctx, ep, d, &externalContact); diagErr != nil {
+		return diagErr
+	}
 	_, resp, err := ep.updateExternalContact(ctx, d.Id(), externalContact)
 	if err != nil {
 		return util.BuildAPIDiagnosticError(ResourceType, fmt.Sprintf("Failed to update external contact %s error: %s", d.Id(), err), resp)
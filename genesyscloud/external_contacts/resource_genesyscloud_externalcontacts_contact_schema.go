@@ -7,10 +7,12 @@ package external_contacts
 // @description: Home of record for external contacts, identifiers, and external orgs.
 
 import (
+	externalContactsContactSchema "github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/external_contacts_contact_schema"
 	externalContactsOrganization "github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/external_contacts_organization"
 	"github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/provider"
 	resourceExporter "github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/resource_exporter"
 	registrar "github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/resource_register"
+	"github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/util"
 	"github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/validators"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -332,6 +334,26 @@ func ResourceExternalContact() *schema.Resource {
 				Type:        schema.TypeString,
 				Optional:    true,
 			},
+			"schema_id": {
+				Description: "The ID of the contact schema defining the custom fields for this contact.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"schema_version": {
+				Description:  "The version of the contact schema used for this contact. Defaults to the latest version of the schema.",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				RequiredWith: []string{"schema_id"},
+			},
+			"custom_fields": {
+				Description:      "JSON formatted object for custom field values defined in the contact schema referenced by `schema_id`.",
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				RequiredWith:     []string{"schema_id"},
+				DiffSuppressFunc: util.SuppressEquivalentJsonDiffs,
+			},
 		},
 	}
 }
@@ -342,7 +364,9 @@ func ExternalContactExporter() *resourceExporter.ResourceExporter {
 		GetResourcesFunc: provider.GetAllWithPooledClient(getAllAuthExternalContacts),
 		RefAttrs: map[string]*resourceExporter.RefAttrSettings{
 			"external_organization_id": {RefType: externalContactsOrganization.ResourceType},
+			"schema_id":                {RefType: externalContactsContactSchema.ResourceType},
 		},
+		JsonEncodeAttributes: []string{"custom_fields"},
 	}
 }
 
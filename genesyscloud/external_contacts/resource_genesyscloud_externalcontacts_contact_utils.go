@@ -1,12 +1,15 @@
 package external_contacts
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
 	"strings"
 
 	"github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/util"
 	"github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/util/resourcedata"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/mypurecloud/platform-client-sdk-go/v192/platformclientv2"
 	"github.com/nyaruka/phonenumbers"
@@ -62,6 +65,66 @@ func getExternalContactFromResourceData(d *schema.ResourceData) platformclientv2
 	return externalContact
 }
 
+// buildSdkContactSchemaAndCustomFields sets the contact schema and custom field values of an external contact.
+// When no schema version is configured the latest version of the schema is used.
+func buildSdkContactSchemaAndCustomFields(ctx context.Context, ep *externalContactsContactsProxy, d *schema.ResourceData, externalContact *platformclientv2.Externalcontact) diag.Diagnostics {
+	schemaId := d.Get("schema_id").(string)
+	if schemaId == "" {
+		return nil
+	}
+
+	version := d.Get("schema_version").(int)
+	// A version held in state belongs to the previous schema if the schema itself has been swapped
+	if version == 0 || (d.HasChange("schema_id") && !d.HasChange("schema_version")) {
+		contactSchema, resp, err := ep.getExternalContactsContactSchemaById(ctx, schemaId)
+		if err != nil {
+			return util.BuildAPIDiagnosticError(ResourceType, fmt.Sprintf("Failed to get contact schema %s error: %s", schemaId, err), resp)
+		}
+		version = *contactSchema.Version
+	}
+	externalContact.Schema = &platformclientv2.Dataschema{
+		Id:      &schemaId,
+		Version: &version,
+	}
+
+	customFields, err := buildCustomFieldsNillable(d.Get("custom_fields").(string))
+	if err != nil {
+		return util.BuildDiagnosticError(ResourceType, "Failed to build custom fields of external contact", err)
+	}
+	externalContact.CustomFields = customFields
+	return nil
+}
+
+// buildCustomFieldsNillable parses the custom fields JSON object of an external contact
+func buildCustomFieldsNillable(fieldsJson string) (*map[string]interface{}, error) {
+	if fieldsJson == "" {
+		return nil, nil
+	}
+
+	fieldsInterface, err := util.JsonStringToInterface(fieldsJson)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse custom fields %s: %v", fieldsJson, err)
+	}
+	fieldsMap, ok := fieldsInterface.(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("custom fields is not a JSON 'object': %v", fieldsJson)
+	}
+
+	return &fieldsMap, nil
+}
+
+// flattenCustomFields maps a Genesys Cloud custom fields *map[string]interface{} into a JSON string
+func flattenCustomFields(customFields *map[string]interface{}) (string, error) {
+	if customFields == nil {
+		return "", nil
+	}
+	cfBytes, err := json.Marshal(customFields)
+	if err != nil {
+		return "", fmt.Errorf("error marshalling custom fields %v: %v", customFields, err)
+	}
+	return string(cfBytes), nil
+}
+
 // buildPhonenumberFromData is a helper method to map phone data to the GenesysCloud platformclientv2.PhoneNumber
 func buildPhonenumberFromData(phoneData []interface{}) *platformclientv2.Phonenumber {
 	phoneMap := phoneData[0].(map[string]interface{})
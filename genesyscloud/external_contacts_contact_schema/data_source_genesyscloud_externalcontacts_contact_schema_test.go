@@ -0,0 +1,49 @@
+package external_contacts_contact_schema
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/provider"
+	"github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/util"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+/*
+Test Class for the external contacts contact schema Data Source
+*/
+
+func TestAccDataSourceExternalContactsContactSchema(t *testing.T) {
+	t.Parallel()
+	var (
+		schemaResourceLabel   = "contact_schema_1"
+		schemaName            = "tf_contact_schema_" + uuid.NewString()[:8]
+		schemaDescription     = "created for CX as Code test case"
+		schemaDataSourceLabel = "contact_schema_data_source_1"
+	)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { util.TestAccPreCheck(t) },
+		ProviderFactories: provider.GetProviderFactories(providerResources, providerDataSources),
+		Steps: []resource.TestStep{
+			{
+				Config: GenerateContactSchemaResourceBasic(schemaResourceLabel, schemaName, schemaDescription) +
+					generateContactSchemaDataSource(schemaDataSourceLabel, schemaName, ResourceType+"."+schemaResourceLabel),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data."+ResourceType+"."+schemaDataSourceLabel, "id", ResourceType+"."+schemaResourceLabel, "id"),
+					resource.TestCheckResourceAttrPair("data."+ResourceType+"."+schemaDataSourceLabel, "version", ResourceType+"."+schemaResourceLabel, "version"),
+				),
+			},
+		},
+	})
+}
+
+func generateContactSchemaDataSource(dataSourceLabel string, name string, dependsOnResource string) string {
+	return fmt.Sprintf(`data "%s" "%s" {
+		name = "%s"
+		depends_on=[%s]
+	}
+	`, ResourceType, dataSourceLabel, name, dependsOnResource)
+}
@@ -0,0 +1,211 @@
+package external_contacts_contact_schema
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+
+	customapi "github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/custom_api_client"
+	"github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/provider"
+	rc "github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/resource_cache"
+
+	"github.com/mypurecloud/platform-client-sdk-go/v192/platformclientv2"
+)
+
+/*
+The genesyscloud_externalcontacts_contact_schema_proxy.go file contains the proxy structures and methods that interact
+with the Genesys Cloud SDK. We use composition here for each function on the proxy so individual functions can be stubbed
+out during testing.
+*/
+
+// internalProxy holds a proxy instance that can be used throughout the package
+var internalProxy *externalContactsContactSchemaProxy
+
+// Type definitions for each func on our proxy so we can easily mock them out later
+type createExternalContactsContactSchemaFunc func(ctx context.Context, p *externalContactsContactSchemaProxy, schema *platformclientv2.Dataschema) (*platformclientv2.Dataschema, *platformclientv2.APIResponse, error)
+type getAllExternalContactsContactSchemasFunc func(ctx context.Context, p *externalContactsContactSchemaProxy) (*[]platformclientv2.Dataschema, *platformclientv2.APIResponse, error)
+type getExternalContactsContactSchemasByNameFunc func(ctx context.Context, p *externalContactsContactSchemaProxy, name string) (schemas *[]platformclientv2.Dataschema, retryable bool, resp *platformclientv2.APIResponse, err error)
+type getExternalContactsContactSchemaByIdFunc func(ctx context.Context, p *externalContactsContactSchemaProxy, id string) (schema *platformclientv2.Dataschema, resp *platformclientv2.APIResponse, err error)
+type updateExternalContactsContactSchemaFunc func(ctx context.Context, p *externalContactsContactSchemaProxy, id string, schema *platformclientv2.Dataschema) (*platformclientv2.Dataschema, *platformclientv2.APIResponse, error)
+type deleteExternalContactsContactSchemaFunc func(ctx context.Context, p *externalContactsContactSchemaProxy, id string) (resp *platformclientv2.APIResponse, err error)
+type getExternalContactsContactSchemaDeletedStatusFunc func(ctx context.Context, p *externalContactsContactSchemaProxy, id string) (isDeleted bool, resp *platformclientv2.APIResponse, err error)
+
+// externalContactsContactSchemaProxy contains all of the methods that call genesys cloud APIs.
+type externalContactsContactSchemaProxy struct {
+	clientConfig                                      *platformclientv2.Configuration
+	externalContactsApi                               *platformclientv2.ExternalContactsApi
+	customApiClient                                   *customapi.Client
+	createExternalContactsContactSchemaAttr           createExternalContactsContactSchemaFunc
+	getAllExternalContactsContactSchemasAttr          getAllExternalContactsContactSchemasFunc
+	getExternalContactsContactSchemasByNameAttr       getExternalContactsContactSchemasByNameFunc
+	getExternalContactsContactSchemaByIdAttr          getExternalContactsContactSchemaByIdFunc
+	updateExternalContactsContactSchemaAttr           updateExternalContactsContactSchemaFunc
+	deleteExternalContactsContactSchemaAttr           deleteExternalContactsContactSchemaFunc
+	getExternalContactsContactSchemaDeletedStatusAttr getExternalContactsContactSchemaDeletedStatusFunc
+	contactSchemaCache                                rc.CacheInterface[platformclientv2.Dataschema]
+}
+
+// newExternalContactsContactSchemaProxy initializes the contact schema proxy with all of the data needed to communicate with Genesys Cloud
+func newExternalContactsContactSchemaProxy(clientConfig *platformclientv2.Configuration) *externalContactsContactSchemaProxy {
+	api := platformclientv2.NewExternalContactsApiWithConfig(clientConfig)
+	contactSchemaCache := rc.NewResourceCache[platformclientv2.Dataschema]()
+
+	return &externalContactsContactSchemaProxy{
+		clientConfig:                                      clientConfig,
+		externalContactsApi:                               api,
+		customApiClient:                                   customapi.NewClient(clientConfig, ResourceType),
+		createExternalContactsContactSchemaAttr:           createExternalContactsContactSchemaFn,
+		getAllExternalContactsContactSchemasAttr:          getAllExternalContactsContactSchemasFn,
+		getExternalContactsContactSchemasByNameAttr:       getExternalContactsContactSchemasByNameFn,
+		getExternalContactsContactSchemaByIdAttr:          getExternalContactsContactSchemaByIdFn,
+		updateExternalContactsContactSchemaAttr:           updateExternalContactsContactSchemaFn,
+		deleteExternalContactsContactSchemaAttr:           deleteExternalContactsContactSchemaFn,
+		getExternalContactsContactSchemaDeletedStatusAttr: getExternalContactsContactSchemaDeletedStatusFn,
+		contactSchemaCache:                                contactSchemaCache,
+	}
+}
+
+// getExternalContactsContactSchemaProxy acts as a singleton to for the internalProxy.  It also ensures
+// that we can still proxy our tests by directly setting internalProxy package variable
+func getExternalContactsContactSchemaProxy(clientConfig *platformclientv2.Configuration) *externalContactsContactSchemaProxy {
+	if internalProxy == nil {
+		internalProxy = newExternalContactsContactSchemaProxy(clientConfig)
+	}
+	return internalProxy
+}
+
+// createExternalContactsContactSchema creates a Genesys Cloud external contacts contact schema
+func (p *externalContactsContactSchemaProxy) createExternalContactsContactSchema(ctx context.Context, schema *platformclientv2.Dataschema) (*platformclientv2.Dataschema, *platformclientv2.APIResponse, error) {
+	return p.createExternalContactsContactSchemaAttr(ctx, p, schema)
+}
+
+// getAllExternalContactsContactSchemas retrieves all Genesys Cloud external contacts contact schemas
+func (p *externalContactsContactSchemaProxy) getAllExternalContactsContactSchemas(ctx context.Context) (*[]platformclientv2.Dataschema, *platformclientv2.APIResponse, error) {
+	return p.getAllExternalContactsContactSchemasAttr(ctx, p)
+}
+
+// getExternalContactsContactSchemasByName returns the Genesys Cloud external contacts contact schemas matching a name
+func (p *externalContactsContactSchemaProxy) getExternalContactsContactSchemasByName(ctx context.Context, name string) (schemas *[]platformclientv2.Dataschema, retryable bool, resp *platformclientv2.APIResponse, err error) {
+	return p.getExternalContactsContactSchemasByNameAttr(ctx, p, name)
+}
+
+// getExternalContactsContactSchemaById returns a single Genesys Cloud external contacts contact schema by Id
+func (p *externalContactsContactSchemaProxy) getExternalContactsContactSchemaById(ctx context.Context, id string) (schema *platformclientv2.Dataschema, resp *platformclientv2.APIResponse, err error) {
+	return p.getExternalContactsContactSchemaByIdAttr(ctx, p, id)
+}
+
+// updateExternalContactsContactSchema updates a Genesys Cloud external contacts contact schema
+func (p *externalContactsContactSchemaProxy) updateExternalContactsContactSchema(ctx context.Context, id string, schema *platformclientv2.Dataschema) (*platformclientv2.Dataschema, *platformclientv2.APIResponse, error) {
+	return p.updateExternalContactsContactSchemaAttr(ctx, p, id, schema)
+}
+
+// deleteExternalContactsContactSchema deletes a Genesys Cloud external contacts contact schema by Id
+func (p *externalContactsContactSchemaProxy) deleteExternalContactsContactSchema(ctx context.Context, id string) (resp *platformclientv2.APIResponse, err error) {
+	return p.deleteExternalContactsContactSchemaAttr(ctx, p, id)
+}
+
+// getExternalContactsContactSchemaDeletedStatus gets the deleted status of a Genesys Cloud external contacts contact schema
+func (p *externalContactsContactSchemaProxy) getExternalContactsContactSchemaDeletedStatus(ctx context.Context, id string) (isDeleted bool, resp *platformclientv2.APIResponse, err error) {
+	return p.getExternalContactsContactSchemaDeletedStatusAttr(ctx, p, id)
+}
+
+// createExternalContactsContactSchemaFn is an implementation function for creating a Genesys Cloud external contacts contact schema
+func createExternalContactsContactSchemaFn(ctx context.Context, p *externalContactsContactSchemaProxy, schema *platformclientv2.Dataschema) (*platformclientv2.Dataschema, *platformclientv2.APIResponse, error) {
+	ctx = provider.EnsureResourceContext(ctx, ResourceType)
+	log.Printf("Creating external contacts contact schema: %s", *schema.Name)
+	createdSchema, resp, err := p.externalContactsApi.PostExternalcontactsContactsSchemas(*schema)
+	if err != nil {
+		return nil, resp, fmt.Errorf("failed to create external contacts contact schema: %s", err)
+	}
+	return createdSchema, resp, nil
+}
+
+// getAllExternalContactsContactSchemasFn is the implementation for retrieving all external contacts contact schemas in Genesys Cloud
+func getAllExternalContactsContactSchemasFn(ctx context.Context, p *externalContactsContactSchemaProxy) (*[]platformclientv2.Dataschema, *platformclientv2.APIResponse, error) {
+	ctx = provider.EnsureResourceContext(ctx, ResourceType)
+
+	// The schemas listing is not paginated and returns every schema in the org in a single call
+	schemas, resp, err := p.externalContactsApi.GetExternalcontactsContactsSchemas()
+	if err != nil {
+		return nil, resp, fmt.Errorf("failed to get all external contacts contact schemas: %v", err)
+	}
+	if schemas.Entities == nil || len(*schemas.Entities) == 0 {
+		return &([]platformclientv2.Dataschema{}), resp, nil
+	}
+
+	for _, schema := range *schemas.Entities {
+		rc.SetCache(p.contactSchemaCache, *schema.Id, schema)
+	}
+	return schemas.Entities, resp, nil
+}
+
+// getExternalContactsContactSchemasByNameFn is an implementation of the function to get Genesys Cloud external contacts contact schemas by name
+func getExternalContactsContactSchemasByNameFn(ctx context.Context, p *externalContactsContactSchemaProxy, name string) (matchingSchemas *[]platformclientv2.Dataschema, retryable bool, resp *platformclientv2.APIResponse, err error) {
+	ctx = provider.EnsureResourceContext(ctx, ResourceType)
+	finalSchemas := []platformclientv2.Dataschema{}
+
+	schemas, resp, err := p.getAllExternalContactsContactSchemas(ctx)
+	if err != nil {
+		return nil, false, resp, err
+	}
+
+	for _, schema := range *schemas {
+		if schema.Name != nil && *schema.Name == name {
+			finalSchemas = append(finalSchemas, schema)
+		}
+	}
+
+	if len(finalSchemas) == 0 {
+		return nil, true, resp, fmt.Errorf("no external contacts contact schema found with name %s", name)
+	}
+	return &finalSchemas, false, resp, nil
+}
+
+// getExternalContactsContactSchemaByIdFn is an implementation of the function to get a Genesys Cloud external contacts contact schema by Id
+func getExternalContactsContactSchemaByIdFn(ctx context.Context, p *externalContactsContactSchemaProxy, id string) (schema *platformclientv2.Dataschema, resp *platformclientv2.APIResponse, err error) {
+	ctx = provider.EnsureResourceContext(ctx, ResourceType)
+	if contactSchema := rc.GetCacheItem(p.contactSchemaCache, id); contactSchema != nil {
+		return contactSchema, nil, nil
+	}
+	return p.externalContactsApi.GetExternalcontactsContactsSchema(id)
+}
+
+// updateExternalContactsContactSchemaFn is an implementation of the function to update a Genesys Cloud external contacts contact schema
+func updateExternalContactsContactSchemaFn(ctx context.Context, p *externalContactsContactSchemaProxy, id string, schemaUpdate *platformclientv2.Dataschema) (*platformclientv2.Dataschema, *platformclientv2.APIResponse, error) {
+	ctx = provider.EnsureResourceContext(ctx, ResourceType)
+	schema, resp, err := p.externalContactsApi.PutExternalcontactsContactsSchema(id, *schemaUpdate)
+	if err != nil {
+		return nil, resp, fmt.Errorf("failed to update external contacts contact schema: %s", err)
+	}
+	rc.DeleteCacheItem(p.contactSchemaCache, id)
+	return schema, resp, nil
+}
+
+// deleteExternalContactsContactSchemaFn is an implementation function for deleting a Genesys Cloud external contacts contact schema
+func deleteExternalContactsContactSchemaFn(ctx context.Context, p *externalContactsContactSchemaProxy, id string) (resp *platformclientv2.APIResponse, err error) {
+	ctx = provider.EnsureResourceContext(ctx, ResourceType)
+	resp, err = p.externalContactsApi.DeleteExternalcontactsContactsSchema(id)
+	if err != nil {
+		return resp, fmt.Errorf("failed to delete external contacts contact schema: %s", err)
+	}
+	rc.DeleteCacheItem(p.contactSchemaCache, id)
+	return resp, nil
+}
+
+// getExternalContactsContactSchemaDeletedStatusFn is an implementation function to get the 'deleted' status of a Genesys Cloud external contacts contact schema
+func getExternalContactsContactSchemaDeletedStatusFn(ctx context.Context, p *externalContactsContactSchemaProxy, id string) (isDeleted bool, resp *platformclientv2.APIResponse, err error) {
+	ctx = provider.EnsureResourceContext(ctx, ResourceType)
+	rawBody, resp, err := customapi.DoRaw(ctx, p.customApiClient, customapi.MethodGet, "/api/v2/externalcontacts/contacts/schemas/"+id, nil, nil)
+	if err != nil {
+		return false, resp, fmt.Errorf("failed to get external contacts contact schema %s: %v", id, err)
+	}
+	var result map[string]interface{}
+	if err := json.Unmarshal(rawBody, &result); err != nil {
+		return false, resp, fmt.Errorf("failed to get deleted status of %s: %v", id, err)
+	}
+	if deleted, ok := result["deleted"].(bool); ok {
+		return deleted, resp, nil
+	}
+	return false, resp, nil
+}
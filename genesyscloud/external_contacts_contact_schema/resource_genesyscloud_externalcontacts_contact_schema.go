@@ -0,0 +1,162 @@
+package external_contacts_contact_schema
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/consistency_checker"
+	"github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/provider"
+	resourceExporter "github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/resource_exporter"
+	"github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/util"
+	"github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/util/constants"
+	"github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/util/resourcedata"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mypurecloud/platform-client-sdk-go/v192/platformclientv2"
+)
+
+/*
+The resource_genesyscloud_externalcontacts_contact_schema.go contains all of the methods that perform the core logic for a resource.
+*/
+
+// getAllExternalContactsContactSchemas retrieves all of the external contacts contact schemas via Terraform in the Genesys Cloud and is used for the exporter
+func getAllExternalContactsContactSchemas(ctx context.Context, clientConfig *platformclientv2.Configuration) (resourceExporter.ResourceIDMetaMap, diag.Diagnostics) {
+	proxy := getExternalContactsContactSchemaProxy(clientConfig)
+	resources := make(resourceExporter.ResourceIDMetaMap)
+
+	schemas, resp, err := proxy.getAllExternalContactsContactSchemas(ctx)
+	if err != nil {
+		return nil, util.BuildAPIDiagnosticError(ResourceType, fmt.Sprintf("Failed to get all external contacts contact schemas error: %s", err), resp)
+	}
+
+	for _, schema := range *schemas {
+		log.Printf("Dealing with external contacts contact schema id: %s", *schema.Id)
+		resources[*schema.Id] = &resourceExporter.ResourceMeta{BlockLabel: *schema.Name}
+	}
+	return resources, nil
+}
+
+// createExternalContactsContactSchema is used by the externalcontacts_contact_schema resource to create Genesys cloud external contacts contact schemas
+func createExternalContactsContactSchema(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sdkConfig := meta.(*provider.ProviderMeta).ClientConfig
+	proxy := getExternalContactsContactSchemaProxy(sdkConfig)
+
+	dataSchema, err := BuildSdkContactSchema(d, nil)
+	if err != nil {
+		return util.BuildDiagnosticError(ResourceType, "create: failed to build external contacts contact schema", err)
+	}
+
+	log.Printf("Creating external contacts contact schema %s", *dataSchema.Name)
+	schema, resp, err := proxy.createExternalContactsContactSchema(ctx, dataSchema)
+	if err != nil {
+		return util.BuildAPIDiagnosticError(ResourceType, fmt.Sprintf("Failed to create external contacts contact schema %s error: %s", *dataSchema.Name, err), resp)
+	}
+
+	d.SetId(*schema.Id)
+
+	// Schemas are always created enabled. If enabled is set to 'false' do an update call to the schema
+	if enabled, ok := d.Get("enabled").(bool); ok && !enabled {
+		log.Printf("Updating external contacts contact schema %s to set 'enabled' to 'false'", *schema.Name)
+		dataSchema.Version = schema.Version
+		if _, resp, err := proxy.updateExternalContactsContactSchema(ctx, *schema.Id, dataSchema); err != nil {
+			return util.BuildAPIDiagnosticError(ResourceType, fmt.Sprintf("Failed to update external contacts contact schema %s error: %s", d.Id(), err), resp)
+		}
+	}
+
+	log.Printf("Created external contacts contact schema %s: %s", *schema.Name, *schema.Id)
+	return readExternalContactsContactSchema(ctx, d, meta)
+}
+
+// readExternalContactsContactSchema is used by the externalcontacts_contact_schema resource to read an external contacts contact schema from genesys cloud
+func readExternalContactsContactSchema(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sdkConfig := meta.(*provider.ProviderMeta).ClientConfig
+	proxy := getExternalContactsContactSchemaProxy(sdkConfig)
+	cc := consistency_checker.NewConsistencyCheck(ctx, d, meta, ResourceExternalContactsContactSchema(), constants.ConsistencyChecks(), ResourceType)
+
+	log.Printf("Reading external contacts contact schema %s", d.Id())
+
+	return util.WithRetriesForRead(ctx, d, func() *retry.RetryError {
+		schema, resp, getErr := proxy.getExternalContactsContactSchemaById(ctx, d.Id())
+		if getErr != nil {
+			if util.IsStatus404(resp) {
+				return retry.RetryableError(util.BuildWithRetriesApiDiagnosticError(ResourceType, fmt.Sprintf("failed to read external contacts contact schema %s | error: %s", d.Id(), getErr), resp))
+			}
+			return retry.NonRetryableError(util.BuildWithRetriesApiDiagnosticError(ResourceType, fmt.Sprintf("failed to read external contacts contact schema %s | error: %s", d.Id(), getErr), resp))
+		}
+
+		schemaProps, err := flattenSchemaProperties(schema.JsonSchema)
+		if err != nil {
+			return retry.NonRetryableError(util.BuildWithRetriesApiDiagnosticError(ResourceType, fmt.Sprintf("error in reading json schema properties of %s | error: %v", *schema.Name, err), resp))
+		}
+
+		resourcedata.SetNillableValue(d, "name", schema.Name)
+		resourcedata.SetNillableValue(d, "properties", schemaProps)
+		resourcedata.SetNillableValue(d, "enabled", schema.Enabled)
+		resourcedata.SetNillableValue(d, "version", schema.Version)
+		if schema.JsonSchema != nil {
+			resourcedata.SetNillableValue(d, "description", schema.JsonSchema.Description)
+			resourcedata.SetNillableValue(d, "required_fields", schema.JsonSchema.Required)
+		}
+
+		log.Printf("Read external contacts contact schema %s %s", d.Id(), *schema.Name)
+		return cc.CheckState(d)
+	})
+}
+
+// updateExternalContactsContactSchema is used by the externalcontacts_contact_schema resource to update an external contacts contact schema in Genesys Cloud
+func updateExternalContactsContactSchema(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sdkConfig := meta.(*provider.ProviderMeta).ClientConfig
+	proxy := getExternalContactsContactSchemaProxy(sdkConfig)
+
+	// Updates must be made against the current version of the schema
+	curSchema, resp, err := proxy.getExternalContactsContactSchemaById(ctx, d.Id())
+	if err != nil {
+		return util.BuildAPIDiagnosticError(ResourceType, fmt.Sprintf("Failed to get external contacts contact schema %s error: %s", d.Id(), err), resp)
+	}
+
+	dataSchema, err := BuildSdkContactSchema(d, curSchema.Version)
+	if err != nil {
+		return util.BuildDiagnosticError(ResourceType, "update: failed to build external contacts contact schema", err)
+	}
+
+	log.Printf("Updating external contacts contact schema %s", d.Id())
+	updatedSchema, resp, err := proxy.updateExternalContactsContactSchema(ctx, d.Id(), dataSchema)
+	if err != nil {
+		return util.BuildAPIDiagnosticError(ResourceType, fmt.Sprintf("Failed to update external contacts contact schema %s error: %s", d.Id(), err), resp)
+	}
+
+	log.Printf("Updated external contacts contact schema %s", *updatedSchema.Id)
+	return readExternalContactsContactSchema(ctx, d, meta)
+}
+
+// deleteExternalContactsContactSchema is used by the externalcontacts_contact_schema resource to delete an external contacts contact schema from Genesys cloud
+func deleteExternalContactsContactSchema(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sdkConfig := meta.(*provider.ProviderMeta).ClientConfig
+	proxy := getExternalContactsContactSchemaProxy(sdkConfig)
+
+	resp, err := proxy.deleteExternalContactsContactSchema(ctx, d.Id())
+	if err != nil {
+		return util.BuildAPIDiagnosticError(ResourceType, fmt.Sprintf("Failed to delete external contacts contact schema %s error: %s", d.Id(), err), resp)
+	}
+
+	return util.WithRetries(ctx, 180*time.Second, func() *retry.RetryError {
+		isDeleted, resp, err := proxy.getExternalContactsContactSchemaDeletedStatus(ctx, d.Id())
+		if err != nil {
+			if util.IsStatus404(resp) {
+				log.Printf("Deleted external contacts contact schema %s", d.Id())
+				return nil
+			}
+			return retry.NonRetryableError(util.BuildWithRetriesApiDiagnosticError(ResourceType, fmt.Sprintf("error deleting external contacts contact schema %s | error: %s", d.Id(), err), resp))
+		}
+
+		if isDeleted {
+			log.Printf("Deleted external contacts contact schema %s", d.Id())
+			return nil
+		}
+		return retry.RetryableError(util.BuildWithRetriesApiDiagnosticError(ResourceType, fmt.Sprintf("external contacts contact schema %s still exists", d.Id()), resp))
+	})
+}
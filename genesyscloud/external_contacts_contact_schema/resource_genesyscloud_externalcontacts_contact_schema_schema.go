@@ -0,0 +1,118 @@
+package external_contacts_contact_schema
+
+// @team: External Contacts
+// @chat: #Genesys Cloud Single Customer View
+// @pm: Cilian Day
+// @jira: RELATE
+// @description: Custom field schemas for external contacts.
+
+import (
+	"github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/provider"
+	resourceExporter "github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/resource_exporter"
+	registrar "github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/resource_register"
+	"github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/util"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+/*
+resource_genesyscloud_externalcontacts_contact_schema_schema.go holds four functions within it:
+
+1.  The registration code that registers the Datasource, Resource and Exporter for the package.
+2.  The resource schema definitions for the externalcontacts_contact_schema resource.
+3.  The datasource schema definitions for the externalcontacts_contact_schema datasource.
+4.  The resource exporter configuration for the externalcontacts_contact_schema exporter.
+*/
+const ResourceType = "genesyscloud_externalcontacts_contact_schema"
+
+// SetRegistrar registers all of the resources, datasources and exporters in the package
+func SetRegistrar(regInstance registrar.Registrar) {
+	regInstance.RegisterResource(ResourceType, ResourceExternalContactsContactSchema())
+	regInstance.RegisterDataSource(ResourceType, DataSourceExternalContactsContactSchema())
+	regInstance.RegisterExporter(ResourceType, ExternalContactsContactSchemaExporter())
+}
+
+// ResourceExternalContactsContactSchema registers the genesyscloud_externalcontacts_contact_schema resource with Terraform
+func ResourceExternalContactsContactSchema() *schema.Resource {
+	return &schema.Resource{
+		Description: `Genesys Cloud external contacts contact schema. Defines the custom fields that can be set on external contacts.`,
+
+		CreateContext: provider.CreateWithPooledClient(createExternalContactsContactSchema),
+		ReadContext:   provider.ReadWithPooledClient(readExternalContactsContactSchema),
+		UpdateContext: provider.UpdateWithPooledClient(updateExternalContactsContactSchema),
+		DeleteContext: provider.DeleteWithPooledClient(deleteExternalContactsContactSchema),
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		SchemaVersion: 1,
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Description:  "The name of the contact schema.",
+				Required:     true,
+				Type:         schema.TypeString,
+				ValidateFunc: validation.StringLenBetween(1, 50),
+			},
+			"description": {
+				Description: "The description of the contact schema.",
+				Optional:    true,
+				Type:        schema.TypeString,
+			},
+			"properties": {
+				Description:      "The properties for the JSON Schema document. Each property defines a custom field that can be set on external contacts.",
+				Optional:         true,
+				Type:             schema.TypeString,
+				DiffSuppressFunc: util.SuppressEquivalentJsonDiffs,
+			},
+			"required_fields": {
+				Description: "The names of the custom fields that must be set on every external contact using this schema.",
+				Optional:    true,
+				Type:        schema.TypeList,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"enabled": {
+				Description: `The schema's enabled/disabled status. A disabled schema cannot be assigned to any other entities, but the data on those entities from the schema still exists.`,
+				Optional:    true,
+				Default:     true,
+				Type:        schema.TypeBool,
+			},
+			"version": {
+				Description: `The version number of the contact schema. The version number is incremented each time the schema is modified.`,
+				Computed:    true,
+				Type:        schema.TypeInt,
+			},
+		},
+	}
+}
+
+// ExternalContactsContactSchemaExporter returns the resourceExporter object used to hold the genesyscloud_externalcontacts_contact_schema exporter's config
+func ExternalContactsContactSchemaExporter() *resourceExporter.ResourceExporter {
+	return &resourceExporter.ResourceExporter{
+		GetResourcesFunc:     provider.GetAllWithPooledClient(getAllExternalContactsContactSchemas),
+		RefAttrs:             map[string]*resourceExporter.RefAttrSettings{},
+		JsonEncodeAttributes: []string{"properties"},
+	}
+}
+
+// DataSourceExternalContactsContactSchema registers the genesyscloud_externalcontacts_contact_schema data source
+func DataSourceExternalContactsContactSchema() *schema.Resource {
+	return &schema.Resource{
+		Description: `Genesys Cloud external contacts contact schema data source. Select a contact schema by its name.`,
+		ReadContext: provider.ReadWithPooledClient(dataSourceExternalContactsContactSchemaRead),
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Description: "External contacts contact schema name.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"version": {
+				Description: "The latest version of the contact schema.",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+		},
+	}
+}
@@ -0,0 +1,76 @@
+package external_contacts_contact_schema
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/util"
+	"github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/util/lists"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mypurecloud/platform-client-sdk-go/v192/platformclientv2"
+)
+
+// BuildSdkContactSchema takes the resource data and builds the SDK platformclientv2.Dataschema
+func BuildSdkContactSchema(d *schema.ResourceData, version *int) (*platformclientv2.Dataschema, error) {
+	requiredFields := lists.InterfaceListToStrings(d.Get("required_fields").([]interface{}))
+
+	dataSchema := &platformclientv2.Dataschema{
+		Name:    platformclientv2.String(d.Get("name").(string)),
+		Version: version,
+		JsonSchema: &platformclientv2.Jsonschemadocument{
+			Schema:      platformclientv2.String("http://json-schema.org/draft-04/schema#"),
+			Title:       platformclientv2.String(d.Get("name").(string)),
+			Description: platformclientv2.String(d.Get("description").(string)),
+			Required:    &requiredFields,
+		},
+		Enabled: platformclientv2.Bool(d.Get("enabled").(bool)),
+	}
+
+	// Custom fields for the schema
+	if d.Get("properties") != "" {
+		var properties map[string]interface{}
+		if err := json.Unmarshal([]byte(d.Get("properties").(string)), &properties); err != nil {
+			return nil, err
+		}
+		dataSchema.JsonSchema.Properties = &properties
+	}
+
+	return dataSchema, nil
+}
+
+// flattenSchemaProperties converts the properties of a JSON schema document into a JSON string
+func flattenSchemaProperties(jsonSchema *platformclientv2.Jsonschemadocument) (*string, error) {
+	if jsonSchema == nil {
+		return nil, nil
+	}
+	schemaProps, err := json.Marshal(jsonSchema.Properties)
+	if err != nil {
+		return nil, err
+	}
+	if string(schemaProps) == util.NullValue {
+		return nil, nil
+	}
+	schemaPropsStr := string(schemaProps)
+	return &schemaPropsStr, nil
+}
+
+// GenerateContactSchemaResourceBasic is a public util method to generate the simplest
+// contact schema terraform resource for testing
+func GenerateContactSchemaResourceBasic(resourceLabel, name, description string) string {
+	return fmt.Sprintf(`resource "%s" "%s" {
+		name = "%s"
+		description = "%s"
+	}
+	`, ResourceType, resourceLabel, name, description)
+}
+
+func GenerateContactSchemaResource(resourceLabel, name, description, properties, enabledStr string) string {
+	return fmt.Sprintf(`resource "%s" "%s" {
+		name = "%s"
+		description = "%s"
+		properties = %s
+		enabled = %s
+	}
+	`, ResourceType, resourceLabel, name, description, properties, enabledStr)
+}
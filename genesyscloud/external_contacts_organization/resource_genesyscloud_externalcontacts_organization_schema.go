@@ -7,6 +7,7 @@ package external_contacts_organization
 // @description: Home of record for external contacts, identifiers, and external orgs.
 
 import (
+	externalContactsOrganizationSchema "github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/external_contacts_organization_schema"
 	"github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/provider"
 	"github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/util"
 	"github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/validators"
@@ -315,14 +316,14 @@ func ResourceExternalContactsOrganization() *schema.Resource {
 				Elem:        trustorResource,
 			},
 			`schema`: {
-				Description: `The schema defining custom fields for this contact`,
+				Description: `The organization schema defining custom fields for this organization`,
 				Optional:    true,
 				Type:        schema.TypeList,
 				MaxItems:    1,
 				Elem:        dataSchemaResource,
 			},
 			`custom_fields`: {
-				Description:      `JSON formatted object for custom field values defined in the schema referenced by the worktype of the workitem.`,
+				Description:      "JSON formatted object for custom field values defined in the organization schema referenced by `schema`.",
 				Optional:         true,
 				Computed:         true,
 				Type:             schema.TypeString,
@@ -343,8 +344,9 @@ func ExternalContactsOrganizationExporter() *resourceExporter.ResourceExporter {
 	return &resourceExporter.ResourceExporter{
 		GetResourcesFunc: provider.GetAllWithPooledClient(getAllAuthExternalContactsOrganizations),
 		RefAttrs: map[string]*resourceExporter.RefAttrSettings{
-			`external_organizations_schemas`: {}, //Need to add this when we implement organization schemas
+			`schema.schema_id`: {RefType: externalContactsOrganizationSchema.ResourceType},
 		},
+		JsonEncodeAttributes: []string{"custom_fields"},
 	}
 }
 
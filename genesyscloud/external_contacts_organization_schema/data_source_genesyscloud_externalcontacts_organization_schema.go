@@ -0,0 +1,49 @@
+package external_contacts_organization_schema
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/provider"
+	"github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/util"
+	"github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/util/resourcedata"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+/*
+   The data_source_genesyscloud_externalcontacts_organization_schema.go contains the data source implementation
+   for the resource.
+*/
+
+// dataSourceExternalContactsOrganizationSchemaRead retrieves by name the id in question
+func dataSourceExternalContactsOrganizationSchemaRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sdkConfig := meta.(*provider.ProviderMeta).ClientConfig
+	proxy := getExternalContactsOrganizationSchemaProxy(sdkConfig)
+
+	name := d.Get("name").(string)
+
+	// As schema names are non-unique, fail in case of multiple results.
+	return util.WithRetries(ctx, 15*time.Second, func() *retry.RetryError {
+		schemas, retryable, resp, err := proxy.getExternalContactsOrganizationSchemasByName(ctx, name)
+		if err != nil && !retryable {
+			return retry.NonRetryableError(util.BuildWithRetriesApiDiagnosticError(ResourceType, fmt.Sprintf("error getting organization schema %s | error: %v", name, err), resp))
+		}
+
+		if retryable {
+			return retry.RetryableError(util.BuildWithRetriesApiDiagnosticError(ResourceType, fmt.Sprintf("no organization schema found with name %s", name), resp))
+		}
+
+		if len(*schemas) > 1 {
+			return retry.NonRetryableError(util.BuildWithRetriesApiDiagnosticError(ResourceType, fmt.Sprintf("ambiguous organization schema name: %s", name), resp))
+		}
+
+		schema := (*schemas)[0]
+		d.SetId(*schema.Id)
+		resourcedata.SetNillableValue(d, "version", schema.Version)
+		return nil
+	})
+}
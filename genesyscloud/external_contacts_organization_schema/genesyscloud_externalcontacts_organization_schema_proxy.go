@@ -0,0 +1,211 @@
+package external_contacts_organization_schema
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+
+	customapi "github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/custom_api_client"
+	"github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/provider"
+	rc "github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/resource_cache"
+
+	"github.com/mypurecloud/platform-client-sdk-go/v192/platformclientv2"
+)
+
+/*
+The genesyscloud_externalcontacts_organization_schema_proxy.go file contains the proxy structures and methods that interact
+with the Genesys Cloud SDK. We use composition here for each function on the proxy so individual functions can be stubbed
+out during testing.
+*/
+
+// internalProxy holds a proxy instance that can be used throughout the package
+var internalProxy *externalContactsOrganizationSchemaProxy
+
+// Type definitions for each func on our proxy so we can easily mock them out later
+type createExternalContactsOrganizationSchemaFunc func(ctx context.Context, p *externalContactsOrganizationSchemaProxy, schema *platformclientv2.Dataschema) (*platformclientv2.Dataschema, *platformclientv2.APIResponse, error)
+type getAllExternalContactsOrganizationSchemasFunc func(ctx context.Context, p *externalContactsOrganizationSchemaProxy) (*[]platformclientv2.Dataschema, *platformclientv2.APIResponse, error)
+type getExternalContactsOrganizationSchemasByNameFunc func(ctx context.Context, p *externalContactsOrganizationSchemaProxy, name string) (schemas *[]platformclientv2.Dataschema, retryable bool, resp *platformclientv2.APIResponse, err error)
+type getExternalContactsOrganizationSchemaByIdFunc func(ctx context.Context, p *externalContactsOrganizationSchemaProxy, id string) (schema *platformclientv2.Dataschema, resp *platformclientv2.APIResponse, err error)
+type updateExternalContactsOrganizationSchemaFunc func(ctx context.Context, p *externalContactsOrganizationSchemaProxy, id string, schema *platformclientv2.Dataschema) (*platformclientv2.Dataschema, *platformclientv2.APIResponse, error)
+type deleteExternalContactsOrganizationSchemaFunc func(ctx context.Context, p *externalContactsOrganizationSchemaProxy, id string) (resp *platformclientv2.APIResponse, err error)
+type getExternalContactsOrganizationSchemaDeletedStatusFunc func(ctx context.Context, p *externalContactsOrganizationSchemaProxy, id string) (isDeleted bool, resp *platformclientv2.APIResponse, err error)
+
+// externalContactsOrganizationSchemaProxy contains all of the methods that call genesys cloud APIs.
+type externalContactsOrganizationSchemaProxy struct {
+	clientConfig                                           *platformclientv2.Configuration
+	externalContactsApi                                    *platformclientv2.ExternalContactsApi
+	customApiClient                                        *customapi.Client
+	createExternalContactsOrganizationSchemaAttr           createExternalContactsOrganizationSchemaFunc
+	getAllExternalContactsOrganizationSchemasAttr          getAllExternalContactsOrganizationSchemasFunc
+	getExternalContactsOrganizationSchemasByNameAttr       getExternalContactsOrganizationSchemasByNameFunc
+	getExternalContactsOrganizationSchemaByIdAttr          getExternalContactsOrganizationSchemaByIdFunc
+	updateExternalContactsOrganizationSchemaAttr           updateExternalContactsOrganizationSchemaFunc
+	deleteExternalContactsOrganizationSchemaAttr           deleteExternalContactsOrganizationSchemaFunc
+	getExternalContactsOrganizationSchemaDeletedStatusAttr getExternalContactsOrganizationSchemaDeletedStatusFunc
+	organizationSchemaCache                                rc.CacheInterface[platformclientv2.Dataschema]
+}
+
+// newExternalContactsOrganizationSchemaProxy initializes the organization schema proxy with all of the data needed to communicate with Genesys Cloud
+func newExternalContactsOrganizationSchemaProxy(clientConfig *platformclientv2.Configuration) *externalContactsOrganizationSchemaProxy {
+	api := platformclientv2.NewExternalContactsApiWithConfig(clientConfig)
+	organizationSchemaCache := rc.NewResourceCache[platformclientv2.Dataschema]()
+
+	return &externalContactsOrganizationSchemaProxy{
+		clientConfig:        clientConfig,
+		externalContactsApi: api,
+		customApiClient:     customapi.NewClient(clientConfig, ResourceType),
+		createExternalContactsOrganizationSchemaAttr:           createExternalContactsOrganizationSchemaFn,
+		getAllExternalContactsOrganizationSchemasAttr:          getAllExternalContactsOrganizationSchemasFn,
+		getExternalContactsOrganizationSchemasByNameAttr:       getExternalContactsOrganizationSchemasByNameFn,
+		getExternalContactsOrganizationSchemaByIdAttr:          getExternalContactsOrganizationSchemaByIdFn,
+		updateExternalContactsOrganizationSchemaAttr:           updateExternalContactsOrganizationSchemaFn,
+		deleteExternalContactsOrganizationSchemaAttr:           deleteExternalContactsOrganizationSchemaFn,
+		getExternalContactsOrganizationSchemaDeletedStatusAttr: getExternalContactsOrganizationSchemaDeletedStatusFn,
+		organizationSchemaCache:                                organizationSchemaCache,
+	}
+}
+
+// getExternalContactsOrganizationSchemaProxy acts as a singleton to for the internalProxy.  It also ensures
+// that we can still proxy our tests by directly setting internalProxy package variable
+func getExternalContactsOrganizationSchemaProxy(clientConfig *platformclientv2.Configuration) *externalContactsOrganizationSchemaProxy {
+	if internalProxy == nil {
+		internalProxy = newExternalContactsOrganizationSchemaProxy(clientConfig)
+	}
+	return internalProxy
+}
+
+// createExternalContactsOrganizationSchema creates a Genesys Cloud external contacts organization schema
+func (p *externalContactsOrganizationSchemaProxy) createExternalContactsOrganizationSchema(ctx context.Context, schema *platformclientv2.Dataschema) (*platformclientv2.Dataschema, *platformclientv2.APIResponse, error) {
+	return p.createExternalContactsOrganizationSchemaAttr(ctx, p, schema)
+}
+
+// getAllExternalContactsOrganizationSchemas retrieves all Genesys Cloud external contacts organization schemas
+func (p *externalContactsOrganizationSchemaProxy) getAllExternalContactsOrganizationSchemas(ctx context.Context) (*[]platformclientv2.Dataschema, *platformclientv2.APIResponse, error) {
+	return p.getAllExternalContactsOrganizationSchemasAttr(ctx, p)
+}
+
+// getExternalContactsOrganizationSchemasByName returns the Genesys Cloud external contacts organization schemas matching a name
+func (p *externalContactsOrganizationSchemaProxy) getExternalContactsOrganizationSchemasByName(ctx context.Context, name string) (schemas *[]platformclientv2.Dataschema, retryable bool, resp *platformclientv2.APIResponse, err error) {
+	return p.getExternalContactsOrganizationSchemasByNameAttr(ctx, p, name)
+}
+
+// getExternalContactsOrganizationSchemaById returns a single Genesys Cloud external contacts organization schema by Id
+func (p *externalContactsOrganizationSchemaProxy) getExternalContactsOrganizationSchemaById(ctx context.Context, id string) (schema *platformclientv2.Dataschema, resp *platformclientv2.APIResponse, err error) {
+	return p.getExternalContactsOrganizationSchemaByIdAttr(ctx, p, id)
+}
+
+// updateExternalContactsOrganizationSchema updates a Genesys Cloud external contacts organization schema
+func (p *externalContactsOrganizationSchemaProxy) updateExternalContactsOrganizationSchema(ctx context.Context, id string, schema *platformclientv2.Dataschema) (*platformclientv2.Dataschema, *platformclientv2.APIResponse, error) {
+	return p.updateExternalContactsOrganizationSchemaAttr(ctx, p, id, schema)
+}
+
+// deleteExternalContactsOrganizationSchema deletes a Genesys Cloud external contacts organization schema by Id
+func (p *externalContactsOrganizationSchemaProxy) deleteExternalContactsOrganizationSchema(ctx context.Context, id string) (resp *platformclientv2.APIResponse, err error) {
+	return p.deleteExternalContactsOrganizationSchemaAttr(ctx, p, id)
+}
+
+// getExternalContactsOrganizationSchemaDeletedStatus gets the deleted status of a Genesys Cloud external contacts organization schema
+func (p *externalContactsOrganizationSchemaProxy) getExternalContactsOrganizationSchemaDeletedStatus(ctx context.Context, id string) (isDeleted bool, resp *platformclientv2.APIResponse, err error) {
+	return p.getExternalContactsOrganizationSchemaDeletedStatusAttr(ctx, p, id)
+}
+
+// createExternalContactsOrganizationSchemaFn is an implementation function for creating a Genesys Cloud external contacts organization schema
+func createExternalContactsOrganizationSchemaFn(ctx context.Context, p *externalContactsOrganizationSchemaProxy, schema *platformclientv2.Dataschema) (*platformclientv2.Dataschema, *platformclientv2.APIResponse, error) {
+	ctx = provider.EnsureResourceContext(ctx, ResourceType)
+	log.Printf("Creating external contacts organization schema: %s", *schema.Name)
+	createdSchema, resp, err := p.externalContactsApi.PostExternalcontactsOrganizationsSchemas(*schema)
+	if err != nil {
+		return nil, resp, fmt.Errorf("failed to create external contacts organization schema: %s", err)
+	}
+	return createdSchema, resp, nil
+}
+
+// getAllExternalContactsOrganizationSchemasFn is the implementation for retrieving all external contacts organization schemas in Genesys Cloud
+func getAllExternalContactsOrganizationSchemasFn(ctx context.Context, p *externalContactsOrganizationSchemaProxy) (*[]platformclientv2.Dataschema, *platformclientv2.APIResponse, error) {
+	ctx = provider.EnsureResourceContext(ctx, ResourceType)
+
+	// The schemas listing is not paginated and returns every schema in the org in a single call
+	schemas, resp, err := p.externalContactsApi.GetExternalcontactsOrganizationsSchemas()
+	if err != nil {
+		return nil, resp, fmt.Errorf("failed to get all external contacts organization schemas: %v", err)
+	}
+	if schemas.Entities == nil || len(*schemas.Entities) == 0 {
+		return &([]platformclientv2.Dataschema{}), resp, nil
+	}
+
+	for _, schema := range *schemas.Entities {
+		rc.SetCache(p.organizationSchemaCache, *schema.Id, schema)
+	}
+	return schemas.Entities, resp, nil
+}
+
+// getExternalContactsOrganizationSchemasByNameFn is an implementation of the function to get Genesys Cloud external contacts organization schemas by name
+func getExternalContactsOrganizationSchemasByNameFn(ctx context.Context, p *externalContactsOrganizationSchemaProxy, name string) (matchingSchemas *[]platformclientv2.Dataschema, retryable bool, resp *platformclientv2.APIResponse, err error) {
+	ctx = provider.EnsureResourceContext(ctx, ResourceType)
+	finalSchemas := []platformclientv2.Dataschema{}
+
+	schemas, resp, err := p.getAllExternalContactsOrganizationSchemas(ctx)
+	if err != nil {
+		return nil, false, resp, err
+	}
+
+	for _, schema := range *schemas {
+		if schema.Name != nil && *schema.Name == name {
+			finalSchemas = append(finalSchemas, schema)
+		}
+	}
+
+	if len(finalSchemas) == 0 {
+		return nil, true, resp, fmt.Errorf("no external contacts organization schema found with name %s", name)
+	}
+	return &finalSchemas, false, resp, nil
+}
+
+// getExternalContactsOrganizationSchemaByIdFn is an implementation of the function to get a Genesys Cloud external contacts organization schema by Id
+func getExternalContactsOrganizationSchemaByIdFn(ctx context.Context, p *externalContactsOrganizationSchemaProxy, id string) (schema *platformclientv2.Dataschema, resp *platformclientv2.APIResponse, err error) {
+	ctx = provider.EnsureResourceContext(ctx, ResourceType)
+	if organizationSchema := rc.GetCacheItem(p.organizationSchemaCache, id); organizationSchema != nil {
+		return organizationSchema, nil, nil
+	}
+	return p.externalContactsApi.GetExternalcontactsOrganizationsSchema(id)
+}
+
+// updateExternalContactsOrganizationSchemaFn is an implementation of the function to update a Genesys Cloud external contacts organization schema
+func updateExternalContactsOrganizationSchemaFn(ctx context.Context, p *externalContactsOrganizationSchemaProxy, id string, schemaUpdate *platformclientv2.Dataschema) (*platformclientv2.Dataschema, *platformclientv2.APIResponse, error) {
+	ctx = provider.EnsureResourceContext(ctx, ResourceType)
+	schema, resp, err := p.externalContactsApi.PutExternalcontactsOrganizationsSchema(id, *schemaUpdate)
+	if err != nil {
+		return nil, resp, fmt.Errorf("failed to update external contacts organization schema: %s", err)
+	}
+	rc.DeleteCacheItem(p.organizationSchemaCache, id)
+	return schema, resp, nil
+}
+
+// deleteExternalContactsOrganizationSchemaFn is an implementation function for deleting a Genesys Cloud external contacts organization schema
+func deleteExternalContactsOrganizationSchemaFn(ctx context.Context, p *externalContactsOrganizationSchemaProxy, id string) (resp *platformclientv2.APIResponse, err error) {
+	ctx = provider.EnsureResourceContext(ctx, ResourceType)
+	resp, err = p.externalContactsApi.DeleteExternalcontactsOrganizationsSchema(id)
+	if err != nil {
+		return resp, fmt.Errorf("failed to delete external contacts organization schema: %s", err)
+	}
+	rc.DeleteCacheItem(p.organizationSchemaCache, id)
+	return resp, nil
+}
+
+// getExternalContactsOrganizationSchemaDeletedStatusFn is an implementation function to get the 'deleted' status of a Genesys Cloud external contacts organization schema
+func getExternalContactsOrganizationSchemaDeletedStatusFn(ctx context.Context, p *externalContactsOrganizationSchemaProxy, id string) (isDeleted bool, resp *platformclientv2.APIResponse, err error) {
+	ctx = provider.EnsureResourceContext(ctx, ResourceType)
+	rawBody, resp, err := customapi.DoRaw(ctx, p.customApiClient, customapi.MethodGet, "/api/v2/externalcontacts/organizations/schemas/"+id, nil, nil)
+	if err != nil {
+		return false, resp, fmt.Errorf("failed to get external contacts organization schema %s: %v", id, err)
+	}
+	var result map[string]interface{}
+	if err := json.Unmarshal(rawBody, &result); err != nil {
+		return false, resp, fmt.Errorf("failed to get deleted status of %s: %v", id, err)
+	}
+	if deleted, ok := result["deleted"].(bool); ok {
+		return deleted, resp, nil
+	}
+	return false, resp, nil
+}
@@ -0,0 +1,118 @@
+package external_contacts_organization_schema
+
+// @team: External Contacts
+// @chat: #Genesys Cloud Single Customer View
+// @pm: Cilian Day
+// @jira: RELATE
+// @description: Custom field schemas for external organizations.
+
+import (
+	"github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/provider"
+	resourceExporter "github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/resource_exporter"
+	registrar "github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/resource_register"
+	"github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/util"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+/*
+resource_genesyscloud_externalcontacts_organization_schema_schema.go holds four functions within it:
+
+1.  The registration code that registers the Datasource, Resource and Exporter for the package.
+2.  The resource schema definitions for the externalcontacts_organization_schema resource.
+3.  The datasource schema definitions for the externalcontacts_organization_schema datasource.
+4.  The resource exporter configuration for the externalcontacts_organization_schema exporter.
+*/
+const ResourceType = "genesyscloud_externalcontacts_organization_schema"
+
+// SetRegistrar registers all of the resources, datasources and exporters in the package
+func SetRegistrar(regInstance registrar.Registrar) {
+	regInstance.RegisterResource(ResourceType, ResourceExternalContactsOrganizationSchema())
+	regInstance.RegisterDataSource(ResourceType, DataSourceExternalContactsOrganizationSchema())
+	regInstance.RegisterExporter(ResourceType, ExternalContactsOrganizationSchemaExporter())
+}
+
+// ResourceExternalContactsOrganizationSchema registers the genesyscloud_externalcontacts_organization_schema resource with Terraform
+func ResourceExternalContactsOrganizationSchema() *schema.Resource {
+	return &schema.Resource{
+		Description: `Genesys Cloud external contacts organization schema. Defines the custom fields that can be set on external organizations.`,
+
+		CreateContext: provider.CreateWithPooledClient(createExternalContactsOrganizationSchema),
+		ReadContext:   provider.ReadWithPooledClient(readExternalContactsOrganizationSchema),
+		UpdateContext: provider.UpdateWithPooledClient(updateExternalContactsOrganizationSchema),
+		DeleteContext: provider.DeleteWithPooledClient(deleteExternalContactsOrganizationSchema),
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		SchemaVersion: 1,
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Description:  "The name of the organization schema.",
+				Required:     true,
+				Type:         schema.TypeString,
+				ValidateFunc: validation.StringLenBetween(1, 50),
+			},
+			"description": {
+				Description: "The description of the organization schema.",
+				Optional:    true,
+				Type:        schema.TypeString,
+			},
+			"properties": {
+				Description:      "The properties for the JSON Schema document. Each property defines a custom field that can be set on external organizations.",
+				Optional:         true,
+				Type:             schema.TypeString,
+				DiffSuppressFunc: util.SuppressEquivalentJsonDiffs,
+			},
+			"required_fields": {
+				Description: "The names of the custom fields that must be set on every external organization using this schema.",
+				Optional:    true,
+				Type:        schema.TypeList,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"enabled": {
+				Description: `The schema's enabled/disabled status. A disabled schema cannot be assigned to any other entities, but the data on those entities from the schema still exists.`,
+				Optional:    true,
+				Default:     true,
+				Type:        schema.TypeBool,
+			},
+			"version": {
+				Description: `The version number of the organization schema. The version number is incremented each time the schema is modified.`,
+				Computed:    true,
+				Type:        schema.TypeInt,
+			},
+		},
+	}
+}
+
+// ExternalContactsOrganizationSchemaExporter returns the resourceExporter object used to hold the genesyscloud_externalcontacts_organization_schema exporter's config
+func ExternalContactsOrganizationSchemaExporter() *resourceExporter.ResourceExporter {
+	return &resourceExporter.ResourceExporter{
+		GetResourcesFunc:     provider.GetAllWithPooledClient(getAllExternalContactsOrganizationSchemas),
+		RefAttrs:             map[string]*resourceExporter.RefAttrSettings{},
+		JsonEncodeAttributes: []string{"properties"},
+	}
+}
+
+// DataSourceExternalContactsOrganizationSchema registers the genesyscloud_externalcontacts_organization_schema data source
+func DataSourceExternalContactsOrganizationSchema() *schema.Resource {
+	return &schema.Resource{
+		Description: `Genesys Cloud external contacts organization schema data source. Select an organization schema by its name.`,
+		ReadContext: provider.ReadWithPooledClient(dataSourceExternalContactsOrganizationSchemaRead),
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Description: "External contacts organization schema name.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"version": {
+				Description: "The latest version of the organization schema.",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+		},
+	}
+}
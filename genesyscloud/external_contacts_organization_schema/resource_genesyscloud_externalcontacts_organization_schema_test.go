@@ -0,0 +1,115 @@
+package external_contacts_organization_schema
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/provider"
+	"github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/util"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/mypurecloud/platform-client-sdk-go/v192/platformclientv2"
+)
+
+/*
+The resource_genesyscloud_externalcontacts_organization_schema_test.go contains all of the test cases for running the resource
+tests for externalcontacts_organization_schema.
+*/
+
+func TestAccResourceExternalContactsOrganizationSchema(t *testing.T) {
+	t.Parallel()
+	var (
+		schemaResourceLabel = "tf_org_schema_1"
+		schemaName          = "tf_org_schema_" + uuid.NewString()[:8]
+		schemaDescription   = "created for CX as Code test case"
+		fullResourcePath    = ResourceType + "." + schemaResourceLabel
+
+		customProperties = `jsonencode({
+			"account_number_text" = {
+				"allOf" = [{ "$ref" = "#/definitions/text" }]
+				"title" = "Account Number"
+				"description" = "CRM account number"
+				"minLength" = 1
+				"maxLength" = 50
+			}
+			"vip_checkbox" = {
+				"allOf" = [{ "$ref" = "#/definitions/checkbox" }]
+				"title" = "VIP"
+				"description" = "Whether the organization is a VIP"
+			}
+		})`
+	)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { util.TestAccPreCheck(t) },
+		ProviderFactories: provider.GetProviderFactories(providerResources, providerDataSources),
+		Steps: []resource.TestStep{
+			// Barebones schema. No custom fields
+			{
+				Config: GenerateOrganizationSchemaResourceBasic(schemaResourceLabel, schemaName, schemaDescription),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fullResourcePath, "name", schemaName),
+					resource.TestCheckResourceAttr(fullResourcePath, "description", schemaDescription),
+					resource.TestCheckResourceAttr(fullResourcePath, "enabled", util.TrueValue),
+					resource.TestCheckResourceAttr(fullResourcePath, "version", "1"),
+				),
+			},
+			// Update with fields
+			{
+				Config: GenerateOrganizationSchemaResource(schemaResourceLabel, schemaName, schemaDescription, customProperties, util.TrueValue),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fullResourcePath, "enabled", util.TrueValue),
+					resource.TestCheckResourceAttr(fullResourcePath, "version", "2"),
+					util.ValidateValueInJsonAttr(fullResourcePath, "properties", "account_number_text.title", "Account Number"),
+					util.ValidateValueInJsonAttr(fullResourcePath, "properties", "vip_checkbox.title", "VIP"),
+				),
+			},
+			// Disable the schema
+			{
+				Config: GenerateOrganizationSchemaResource(schemaResourceLabel, schemaName, schemaDescription, customProperties, util.FalseValue),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fullResourcePath, "enabled", util.FalseValue),
+					util.ValidateValueInJsonAttr(fullResourcePath, "properties", "account_number_text.title", "Account Number"),
+				),
+			},
+			{
+				// Import/Read
+				ResourceName:      fullResourcePath,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+		CheckDestroy: testVerifyOrganizationSchemaDestroyed,
+	})
+}
+
+func testVerifyOrganizationSchemaDestroyed(state *terraform.State) error {
+	externalAPI := platformclientv2.NewExternalContactsApi()
+	for _, rs := range state.RootModule().Resources {
+		if rs.Type != ResourceType {
+			continue
+		}
+
+		var successPayload map[string]interface{}
+		_, resp, err := externalAPI.GetExternalcontactsOrganizationsSchema(rs.Primary.ID)
+		if util.IsStatus404(resp) {
+			continue // does not exist anymore so considered as deleted
+		} else if err != nil {
+			return fmt.Errorf("unexpected error: %s", err)
+		}
+
+		// Deleted schemas are still returned by the API with the 'deleted' property set
+		if err := json.Unmarshal([]byte(resp.RawBody), &successPayload); err != nil {
+			return fmt.Errorf("error verifying if organization schema %s is destroyed: %v", rs.Primary.ID, err)
+		}
+		if isDeleted, ok := successPayload["deleted"].(bool); ok && isDeleted {
+			continue
+		}
+
+		return fmt.Errorf("organization schema (%s) still exists", rs.Primary.ID)
+	}
+	return nil
+}
@@ -0,0 +1,189 @@
+package external_contacts_organization_schema
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/provider"
+	"github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/util"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mypurecloud/platform-client-sdk-go/v192/platformclientv2"
+	"github.com/stretchr/testify/assert"
+)
+
+/** Unit Test **/
+func TestUnitResourceOrganizationSchemaCreate(t *testing.T) {
+	tId := uuid.NewString()
+	tName := "Unit Test Organization Schema"
+	tDescription := "CX as Code Unit Test Organization Schema"
+	tEnabled := false
+	tVersion := 1
+	tJsonSchema := buildTestJsonSchema(tName, tDescription)
+
+	updateCalled := false
+	schemaProxy := &externalContactsOrganizationSchemaProxy{}
+
+	schemaProxy.createExternalContactsOrganizationSchemaAttr = func(ctx context.Context, p *externalContactsOrganizationSchemaProxy, schemaCreate *platformclientv2.Dataschema) (*platformclientv2.Dataschema, *platformclientv2.APIResponse, error) {
+		assert.Equal(t, tName, *schemaCreate.Name)
+		assert.Equal(t, tDescription, *schemaCreate.JsonSchema.Description)
+		assert.Equal(t, *tJsonSchema.Properties, *schemaCreate.JsonSchema.Properties)
+		assert.Equal(t, []string{"custom_attribute_text"}, *schemaCreate.JsonSchema.Required)
+		assert.Nil(t, schemaCreate.Version)
+
+		return &platformclientv2.Dataschema{Id: &tId, Name: &tName, Version: &tVersion}, &platformclientv2.APIResponse{StatusCode: http.StatusOK}, nil
+	}
+
+	schemaProxy.updateExternalContactsOrganizationSchemaAttr = func(ctx context.Context, p *externalContactsOrganizationSchemaProxy, id string, schemaUpdate *platformclientv2.Dataschema) (*platformclientv2.Dataschema, *platformclientv2.APIResponse, error) {
+		assert.Equal(t, tId, id)
+		assert.Equal(t, tVersion, *schemaUpdate.Version)
+		assert.False(t, *schemaUpdate.Enabled)
+		updateCalled = true
+
+		return &platformclientv2.Dataschema{Id: &tId, Name: &tName}, &platformclientv2.APIResponse{StatusCode: http.StatusOK}, nil
+	}
+
+	schemaProxy.getExternalContactsOrganizationSchemaByIdAttr = func(ctx context.Context, p *externalContactsOrganizationSchemaProxy, id string) (*platformclientv2.Dataschema, *platformclientv2.APIResponse, error) {
+		assert.Equal(t, tId, id)
+		return &platformclientv2.Dataschema{
+			Id:         &tId,
+			Name:       &tName,
+			Enabled:    &tEnabled,
+			Version:    platformclientv2.Int(tVersion + 1),
+			JsonSchema: &tJsonSchema,
+		}, &platformclientv2.APIResponse{StatusCode: http.StatusOK}, nil
+	}
+
+	internalProxy = schemaProxy
+	defer func() { internalProxy = nil }()
+
+	ctx := context.Background()
+	gcloud := &provider.ProviderMeta{ClientConfig: &platformclientv2.Configuration{}}
+
+	tProperties, err := json.Marshal(*tJsonSchema.Properties)
+	if err != nil {
+		t.Errorf("failed to build properties for resource map: %v", err)
+	}
+	resourceDataMap := buildOrganizationSchemaResourceMap(tName, tDescription, tEnabled, string(tProperties))
+
+	d := schema.TestResourceDataRaw(t, ResourceExternalContactsOrganizationSchema().Schema, resourceDataMap)
+
+	diag := createExternalContactsOrganizationSchema(ctx, d, gcloud)
+	assert.Equal(t, false, diag.HasError())
+	assert.True(t, updateCalled)
+	assert.Equal(t, tId, d.Id())
+	assert.Equal(t, tVersion+1, d.Get("version").(int))
+	assert.Equal(t, tEnabled, d.Get("enabled").(bool))
+}
+
+func TestUnitResourceOrganizationSchemaRead(t *testing.T) {
+	tId := uuid.NewString()
+	tName := "Unit Test Organization Schema"
+	tDescription := "CX as Code Unit Test Organization Schema"
+	tEnabled := true
+	tVersion := 3
+	tJsonSchema := buildTestJsonSchema(tName, tDescription)
+
+	schemaProxy := &externalContactsOrganizationSchemaProxy{}
+
+	schemaProxy.getExternalContactsOrganizationSchemaByIdAttr = func(ctx context.Context, p *externalContactsOrganizationSchemaProxy, id string) (*platformclientv2.Dataschema, *platformclientv2.APIResponse, error) {
+		assert.Equal(t, tId, id)
+		return &platformclientv2.Dataschema{
+			Id:         &tId,
+			Name:       &tName,
+			Enabled:    &tEnabled,
+			Version:    &tVersion,
+			JsonSchema: &tJsonSchema,
+		}, &platformclientv2.APIResponse{StatusCode: http.StatusOK}, nil
+	}
+
+	internalProxy = schemaProxy
+	defer func() { internalProxy = nil }()
+
+	ctx := context.Background()
+	gcloud := &provider.ProviderMeta{ClientConfig: &platformclientv2.Configuration{}}
+
+	tProperties, err := json.Marshal(*tJsonSchema.Properties)
+	if err != nil {
+		t.Errorf("failed to build properties for resource map: %v", err)
+	}
+	resourceDataMap := buildOrganizationSchemaResourceMap(tName, tDescription, tEnabled, string(tProperties))
+
+	d := schema.TestResourceDataRaw(t, ResourceExternalContactsOrganizationSchema().Schema, resourceDataMap)
+	d.SetId(tId)
+
+	diag := readExternalContactsOrganizationSchema(ctx, d, gcloud)
+	assert.Equal(t, false, diag.HasError())
+	assert.Equal(t, tId, d.Id())
+	assert.Equal(t, tName, d.Get("name").(string))
+	assert.Equal(t, tDescription, d.Get("description").(string))
+	assert.Equal(t, tEnabled, d.Get("enabled").(bool))
+	assert.Equal(t, tVersion, d.Get("version").(int))
+	assert.Equal(t, "custom_attribute_text", d.Get("required_fields.0").(string))
+	assert.True(t, util.EquivalentJsons(string(tProperties), d.Get("properties").(string)))
+}
+
+func TestUnitResourceOrganizationSchemaDelete(t *testing.T) {
+	tId := uuid.NewString()
+
+	schemaProxy := &externalContactsOrganizationSchemaProxy{}
+
+	schemaProxy.deleteExternalContactsOrganizationSchemaAttr = func(ctx context.Context, p *externalContactsOrganizationSchemaProxy, id string) (*platformclientv2.APIResponse, error) {
+		assert.Equal(t, tId, id)
+		return &platformclientv2.APIResponse{StatusCode: http.StatusNoContent}, nil
+	}
+
+	schemaProxy.getExternalContactsOrganizationSchemaDeletedStatusAttr = func(ctx context.Context, p *externalContactsOrganizationSchemaProxy, id string) (bool, *platformclientv2.APIResponse, error) {
+		assert.Equal(t, tId, id)
+		return true, &platformclientv2.APIResponse{StatusCode: http.StatusOK}, nil
+	}
+
+	internalProxy = schemaProxy
+	defer func() { internalProxy = nil }()
+
+	ctx := context.Background()
+	gcloud := &provider.ProviderMeta{ClientConfig: &platformclientv2.Configuration{}}
+
+	resourceDataMap := buildOrganizationSchemaResourceMap("Unit Test Organization Schema", "", true, "")
+
+	d := schema.TestResourceDataRaw(t, ResourceExternalContactsOrganizationSchema().Schema, resourceDataMap)
+	d.SetId(tId)
+
+	diag := deleteExternalContactsOrganizationSchema(ctx, d, gcloud)
+	assert.Nil(t, diag)
+	assert.Equal(t, tId, d.Id())
+}
+
+func buildTestJsonSchema(name string, description string) platformclientv2.Jsonschemadocument {
+	return platformclientv2.Jsonschemadocument{
+		Title:       &name,
+		Description: &description,
+		Required:    &[]string{"custom_attribute_text"},
+		Properties: &map[string]interface{}{
+			"custom_attribute_text": map[string]interface{}{
+				"allOf": []interface{}{
+					map[string]interface{}{
+						"$ref": "#/definitions/text",
+					},
+				},
+				"title":       "custom_attribute",
+				"description": "Custom attribute for text",
+				"minLength":   float64(0),
+				"maxLength":   float64(50),
+			},
+		},
+	}
+}
+
+func buildOrganizationSchemaResourceMap(tName string, tDescription string, tEnabled bool, tProperties string) map[string]interface{} {
+	return map[string]interface{}{
+		"name":            tName,
+		"description":     tDescription,
+		"enabled":         tEnabled,
+		"properties":      tProperties,
+		"required_fields": []interface{}{"custom_attribute_text"},
+	}
+}
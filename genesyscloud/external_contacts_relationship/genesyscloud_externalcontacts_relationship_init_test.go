@@ -0,0 +1,63 @@
+package external_contacts_relationship
+
+import (
+	"sync"
+	"testing"
+
+	externalContactsOrganization "github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/external_contacts_organization"
+	"github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/user"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+/*
+   The genesyscloud_externalcontacts_relationship_init_test.go file is used to initialize the data sources and resources
+   used in testing the externalcontacts_relationship resource.
+*/
+
+// providerDataSources holds a map of all registered datasources
+var providerDataSources map[string]*schema.Resource
+
+// providerResources holds a map of all registered resources
+var providerResources map[string]*schema.Resource
+
+type registerTestInstance struct {
+	resourceMapMutex   sync.RWMutex
+	datasourceMapMutex sync.RWMutex
+}
+
+// registerTestResources registers all resources used in the tests
+func (r *registerTestInstance) registerTestResources() {
+	r.resourceMapMutex.Lock()
+	defer r.resourceMapMutex.Unlock()
+
+	providerResources[ResourceType] = ResourceExternalContactsRelationship()
+	providerResources[externalContactsOrganization.ResourceType] = externalContactsOrganization.ResourceExternalContactsOrganization()
+	providerResources[user.ResourceType] = user.ResourceUser()
+}
+
+// registerTestDataSources registers all data sources used in the tests.
+func (r *registerTestInstance) registerTestDataSources() {
+	r.datasourceMapMutex.Lock()
+	defer r.datasourceMapMutex.Unlock()
+}
+
+// initTestResources initializes all test resources and data sources.
+func initTestResources() {
+	providerDataSources = make(map[string]*schema.Resource)
+	providerResources = make(map[string]*schema.Resource)
+
+	regInstance := &registerTestInstance{}
+
+	regInstance.registerTestResources()
+	regInstance.registerTestDataSources()
+}
+
+// TestMain is a "setup" function called by the testing framework when run the test
+func TestMain(m *testing.M) {
+	// Run setup function before starting the test suite for the externalcontacts_relationship package
+	initTestResources()
+
+	// Run the test suite for the externalcontacts_relationship package
+	m.Run()
+}
@@ -0,0 +1,179 @@
+package external_contacts_relationship
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/provider"
+
+	"github.com/mypurecloud/platform-client-sdk-go/v192/platformclientv2"
+)
+
+/*
+The genesyscloud_externalcontacts_relationship_proxy.go file contains the proxy structures and methods that interact
+with the Genesys Cloud SDK. We use composition here for each function on the proxy so individual functions can be stubbed
+out during testing.
+*/
+
+// internalProxy holds a proxy instance that can be used throughout the package
+var internalProxy *externalContactsRelationshipProxy
+
+// Type definitions for each func on our proxy so we can easily mock them out later
+type createExternalContactsRelationshipFunc func(ctx context.Context, p *externalContactsRelationshipProxy, relationship *platformclientv2.Relationship) (*platformclientv2.Relationship, *platformclientv2.APIResponse, error)
+type getAllExternalOrganizationsFunc func(ctx context.Context, p *externalContactsRelationshipProxy) (*[]platformclientv2.Externalorganization, *platformclientv2.APIResponse, error)
+type getExternalOrganizationRelationshipsFunc func(ctx context.Context, p *externalContactsRelationshipProxy, organizationId string) (*[]platformclientv2.Relationship, *platformclientv2.APIResponse, error)
+type getExternalContactsRelationshipByIdFunc func(ctx context.Context, p *externalContactsRelationshipProxy, id string) (*platformclientv2.Relationship, *platformclientv2.APIResponse, error)
+type updateExternalContactsRelationshipFunc func(ctx context.Context, p *externalContactsRelationshipProxy, id string, relationship *platformclientv2.Relationship) (*platformclientv2.Relationship, *platformclientv2.APIResponse, error)
+type deleteExternalContactsRelationshipFunc func(ctx context.Context, p *externalContactsRelationshipProxy, id string) (*platformclientv2.APIResponse, error)
+
+// externalContactsRelationshipProxy contains all of the methods that call genesys cloud APIs.
+type externalContactsRelationshipProxy struct {
+	clientConfig                             *platformclientv2.Configuration
+	externalContactsApi                      *platformclientv2.ExternalContactsApi
+	createExternalContactsRelationshipAttr   createExternalContactsRelationshipFunc
+	getAllExternalOrganizationsAttr          getAllExternalOrganizationsFunc
+	getExternalOrganizationRelationshipsAttr getExternalOrganizationRelationshipsFunc
+	getExternalContactsRelationshipByIdAttr  getExternalContactsRelationshipByIdFunc
+	updateExternalContactsRelationshipAttr   updateExternalContactsRelationshipFunc
+	deleteExternalContactsRelationshipAttr   deleteExternalContactsRelationshipFunc
+}
+
+// newExternalContactsRelationshipProxy initializes the relationship proxy with all of the data needed to communicate with Genesys Cloud
+func newExternalContactsRelationshipProxy(clientConfig *platformclientv2.Configuration) *externalContactsRelationshipProxy {
+	api := platformclientv2.NewExternalContactsApiWithConfig(clientConfig)
+	return &externalContactsRelationshipProxy{
+		clientConfig:                             clientConfig,
+		externalContactsApi:                      api,
+		createExternalContactsRelationshipAttr:   createExternalContactsRelationshipFn,
+		getAllExternalOrganizationsAttr:          getAllExternalOrganizationsFn,
+		getExternalOrganizationRelationshipsAttr: getExternalOrganizationRelationshipsFn,
+		getExternalContactsRelationshipByIdAttr:  getExternalContactsRelationshipByIdFn,
+		updateExternalContactsRelationshipAttr:   updateExternalContactsRelationshipFn,
+		deleteExternalContactsRelationshipAttr:   deleteExternalContactsRelationshipFn,
+	}
+}
+
+// getExternalContactsRelationshipProxy acts as a singleton to for the internalProxy.  It also ensures
+// that we can still proxy our tests by directly setting internalProxy package variable
+func getExternalContactsRelationshipProxy(clientConfig *platformclientv2.Configuration) *externalContactsRelationshipProxy {
+	if internalProxy == nil {
+		internalProxy = newExternalContactsRelationshipProxy(clientConfig)
+	}
+	return internalProxy
+}
+
+// createExternalContactsRelationship creates a Genesys Cloud external contacts relationship
+func (p *externalContactsRelationshipProxy) createExternalContactsRelationship(ctx context.Context, relationship *platformclientv2.Relationship) (*platformclientv2.Relationship, *platformclientv2.APIResponse, error) {
+	return p.createExternalContactsRelationshipAttr(ctx, p, relationship)
+}
+
+// getAllExternalOrganizations retrieves all Genesys Cloud external organizations
+func (p *externalContactsRelationshipProxy) getAllExternalOrganizations(ctx context.Context) (*[]platformclientv2.Externalorganization, *platformclientv2.APIResponse, error) {
+	return p.getAllExternalOrganizationsAttr(ctx, p)
+}
+
+// getExternalOrganizationRelationships retrieves all of the relationships of a Genesys Cloud external organization
+func (p *externalContactsRelationshipProxy) getExternalOrganizationRelationships(ctx context.Context, organizationId string) (*[]platformclientv2.Relationship, *platformclientv2.APIResponse, error) {
+	return p.getExternalOrganizationRelationshipsAttr(ctx, p, organizationId)
+}
+
+// getExternalContactsRelationshipById returns a single Genesys Cloud external contacts relationship by Id
+func (p *externalContactsRelationshipProxy) getExternalContactsRelationshipById(ctx context.Context, id string) (*platformclientv2.Relationship, *platformclientv2.APIResponse, error) {
+	return p.getExternalContactsRelationshipByIdAttr(ctx, p, id)
+}
+
+// updateExternalContactsRelationship updates a Genesys Cloud external contacts relationship
+func (p *externalContactsRelationshipProxy) updateExternalContactsRelationship(ctx context.Context, id string, relationship *platformclientv2.Relationship) (*platformclientv2.Relationship, *platformclientv2.APIResponse, error) {
+	return p.updateExternalContactsRelationshipAttr(ctx, p, id, relationship)
+}
+
+// deleteExternalContactsRelationship deletes a Genesys Cloud external contacts relationship by Id
+func (p *externalContactsRelationshipProxy) deleteExternalContactsRelationship(ctx context.Context, id string) (*platformclientv2.APIResponse, error) {
+	return p.deleteExternalContactsRelationshipAttr(ctx, p, id)
+}
+
+// createExternalContactsRelationshipFn is an implementation function for creating a Genesys Cloud external contacts relationship
+func createExternalContactsRelationshipFn(ctx context.Context, p *externalContactsRelationshipProxy, relationship *platformclientv2.Relationship) (*platformclientv2.Relationship, *platformclientv2.APIResponse, error) {
+	ctx = provider.EnsureResourceContext(ctx, ResourceType)
+	return p.externalContactsApi.PostExternalcontactsRelationships(*relationship)
+}
+
+// getAllExternalOrganizationsFn is the implementation for retrieving all external organizations in Genesys Cloud
+func getAllExternalOrganizationsFn(ctx context.Context, p *externalContactsRelationshipProxy) (*[]platformclientv2.Externalorganization, *platformclientv2.APIResponse, error) {
+	ctx = provider.EnsureResourceContext(ctx, ResourceType)
+
+	const cursorSize = 200
+
+	var allExternalOrganizations []platformclientv2.Externalorganization
+	var response *platformclientv2.APIResponse
+
+	cursor := ""
+	for {
+		externalOrganizations, resp, err := p.externalContactsApi.GetExternalcontactsScanOrganizations(cursorSize, cursor, "")
+		response = resp
+		if err != nil {
+			return nil, resp, fmt.Errorf("failed to get external organizations: %v", err)
+		}
+		if externalOrganizations.Entities == nil || len(*externalOrganizations.Entities) == 0 {
+			break
+		}
+
+		allExternalOrganizations = append(allExternalOrganizations, *externalOrganizations.Entities...)
+
+		if externalOrganizations.Cursors == nil || externalOrganizations.Cursors.After == nil || *externalOrganizations.Cursors.After == "" {
+			break
+		}
+		cursor = *externalOrganizations.Cursors.After
+	}
+
+	return &allExternalOrganizations, response, nil
+}
+
+// getExternalOrganizationRelationshipsFn is the implementation for retrieving all of the relationships of an external organization in Genesys Cloud
+func getExternalOrganizationRelationshipsFn(ctx context.Context, p *externalContactsRelationshipProxy, organizationId string) (*[]platformclientv2.Relationship, *platformclientv2.APIResponse, error) {
+	ctx = provider.EnsureResourceContext(ctx, ResourceType)
+
+	const pageSize = 100
+	var allRelationships []platformclientv2.Relationship
+
+	relationships, resp, err := p.externalContactsApi.GetExternalcontactsOrganizationRelationships(organizationId, pageSize, 1, "", "")
+	if err != nil {
+		return nil, resp, fmt.Errorf("failed to get relationships of external organization %s: %v", organizationId, err)
+	}
+	if relationships.Entities == nil || len(*relationships.Entities) == 0 {
+		return &allRelationships, resp, nil
+	}
+	allRelationships = append(allRelationships, *relationships.Entities...)
+
+	for pageNum := 2; pageNum <= *relationships.PageCount; pageNum++ {
+		relationships, resp, err := p.externalContactsApi.GetExternalcontactsOrganizationRelationships(organizationId, pageSize, pageNum, "", "")
+		if err != nil {
+			return nil, resp, fmt.Errorf("failed to get relationships of external organization %s: %v", organizationId, err)
+		}
+		if relationships.Entities == nil || len(*relationships.Entities) == 0 {
+			break
+		}
+		allRelationships = append(allRelationships, *relationships.Entities...)
+	}
+
+	return &allRelationships, resp, nil
+}
+
+// getExternalContactsRelationshipByIdFn is an implementation of the function to get a Genesys Cloud external contacts relationship by Id
+func getExternalContactsRelationshipByIdFn(ctx context.Context, p *externalContactsRelationshipProxy, id string) (*platformclientv2.Relationship, *platformclientv2.APIResponse, error) {
+	ctx = provider.EnsureResourceContext(ctx, ResourceType)
+	return p.externalContactsApi.GetExternalcontactsRelationship(id, "")
+}
+
+// updateExternalContactsRelationshipFn is an implementation of the function to update a Genesys Cloud external contacts relationship
+func updateExternalContactsRelationshipFn(ctx context.Context, p *externalContactsRelationshipProxy, id string, relationship *platformclientv2.Relationship) (*platformclientv2.Relationship, *platformclientv2.APIResponse, error) {
+	ctx = provider.EnsureResourceContext(ctx, ResourceType)
+	return p.externalContactsApi.PutExternalcontactsRelationship(id, *relationship)
+}
+
+// deleteExternalContactsRelationshipFn is an implementation function for deleting a Genesys Cloud external contacts relationship
+func deleteExternalContactsRelationshipFn(ctx context.Context, p *externalContactsRelationshipProxy, id string) (*platformclientv2.APIResponse, error) {
+	ctx = provider.EnsureResourceContext(ctx, ResourceType)
+	_, resp, err := p.externalContactsApi.DeleteExternalcontactsRelationship(id)
+	return resp, err
+}
@@ -0,0 +1,155 @@
+package external_contacts_relationship
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/consistency_checker"
+	"github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/provider"
+	resourceExporter "github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/resource_exporter"
+	"github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/util"
+	"github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/util/constants"
+	"github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/util/resourcedata"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mypurecloud/platform-client-sdk-go/v192/platformclientv2"
+)
+
+/*
+The resource_genesyscloud_externalcontacts_relationship.go contains all of the methods that perform the core logic for a resource.
+*/
+
+// getAllExternalContactsRelationships retrieves the relationships of every external organization in Genesys Cloud and is used for the exporter
+func getAllExternalContactsRelationships(ctx context.Context, clientConfig *platformclientv2.Configuration) (resourceExporter.ResourceIDMetaMap, diag.Diagnostics) {
+	proxy := getExternalContactsRelationshipProxy(clientConfig)
+	resources := make(resourceExporter.ResourceIDMetaMap)
+
+	externalOrganizations, resp, err := proxy.getAllExternalOrganizations(ctx)
+	if err != nil {
+		return nil, util.BuildAPIDiagnosticError(ResourceType, fmt.Sprintf("Failed to get external organizations error: %s", err), resp)
+	}
+
+	for _, externalOrganization := range *externalOrganizations {
+		if externalOrganization.Id == nil {
+			continue
+		}
+		relationships, resp, err := proxy.getExternalOrganizationRelationships(ctx, *externalOrganization.Id)
+		if err != nil {
+			return nil, util.BuildAPIDiagnosticError(ResourceType, fmt.Sprintf("Failed to get relationships of external organization %s error: %s", *externalOrganization.Id, err), resp)
+		}
+
+		for _, relationship := range *relationships {
+			if relationship.Id == nil {
+				continue
+			}
+			log.Printf("Dealing with external contacts relationship id: %s", *relationship.Id)
+
+			blockLabel := *relationship.Id
+			if externalOrganization.Name != nil && relationship.Relationship != nil {
+				blockLabel = *externalOrganization.Name + "_" + *relationship.Relationship
+			}
+			meta := &resourceExporter.ResourceMeta{BlockLabel: blockLabel}
+			// The same relationship type can be held by several users within an organization
+			if relationship.User != nil && relationship.User.Id != nil {
+				blockHash, err := util.QuickHashFields(*relationship.User.Id)
+				if err != nil {
+					return nil, util.BuildDiagnosticError(ResourceType, fmt.Sprintf("Failed to generate quick hash for relationship %s", *relationship.Id), err)
+				}
+				meta.BlockHash = blockHash
+			}
+			resources[*relationship.Id] = meta
+		}
+	}
+	return resources, nil
+}
+
+// createExternalContactsRelationship is used by the externalcontacts_relationship resource to create a Genesys Cloud external contacts relationship
+func createExternalContactsRelationship(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sdkConfig := meta.(*provider.ProviderMeta).ClientConfig
+	proxy := getExternalContactsRelationshipProxy(sdkConfig)
+
+	relationship := getRelationshipFromResourceData(d)
+
+	log.Printf("Creating external contacts relationship %s", *relationship.Relationship)
+	createdRelationship, resp, err := proxy.createExternalContactsRelationship(ctx, &relationship)
+	if err != nil {
+		return util.BuildAPIDiagnosticError(ResourceType, fmt.Sprintf("Failed to create external contacts relationship %s error: %s", *relationship.Relationship, err), resp)
+	}
+
+	d.SetId(*createdRelationship.Id)
+	log.Printf("Created external contacts relationship %s", *createdRelationship.Id)
+	return readExternalContactsRelationship(ctx, d, meta)
+}
+
+// readExternalContactsRelationship is used by the externalcontacts_relationship resource to read an external contacts relationship from Genesys Cloud
+func readExternalContactsRelationship(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sdkConfig := meta.(*provider.ProviderMeta).ClientConfig
+	proxy := getExternalContactsRelationshipProxy(sdkConfig)
+	cc := consistency_checker.NewConsistencyCheck(ctx, d, meta, ResourceExternalContactsRelationship(), constants.ConsistencyChecks(), ResourceType)
+
+	log.Printf("Reading external contacts relationship %s", d.Id())
+
+	return util.WithRetriesForRead(ctx, d, func() *retry.RetryError {
+		relationship, resp, getErr := proxy.getExternalContactsRelationshipById(ctx, d.Id())
+		if getErr != nil {
+			if util.IsStatus404(resp) {
+				return retry.RetryableError(util.BuildWithRetriesApiDiagnosticError(ResourceType, fmt.Sprintf("Failed to read external contacts relationship %s | error: %s", d.Id(), getErr), resp))
+			}
+			return retry.NonRetryableError(util.BuildWithRetriesApiDiagnosticError(ResourceType, fmt.Sprintf("Failed to read external contacts relationship %s | error: %s", d.Id(), getErr), resp))
+		}
+
+		resourcedata.SetNillableValue(d, "relationship", relationship.Relationship)
+		if relationship.User != nil {
+			resourcedata.SetNillableValue(d, "user_id", relationship.User.Id)
+		}
+		if relationship.ExternalOrganization != nil {
+			resourcedata.SetNillableValue(d, "external_organization_id", relationship.ExternalOrganization.Id)
+		}
+
+		log.Printf("Read external contacts relationship %s", d.Id())
+		return cc.CheckState(d)
+	})
+}
+
+// updateExternalContactsRelationship is used by the externalcontacts_relationship resource to update an external contacts relationship in Genesys Cloud
+func updateExternalContactsRelationship(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sdkConfig := meta.(*provider.ProviderMeta).ClientConfig
+	proxy := getExternalContactsRelationshipProxy(sdkConfig)
+
+	relationship := getRelationshipFromResourceData(d)
+
+	log.Printf("Updating external contacts relationship %s", d.Id())
+	if _, resp, err := proxy.updateExternalContactsRelationship(ctx, d.Id(), &relationship); err != nil {
+		return util.BuildAPIDiagnosticError(ResourceType, fmt.Sprintf("Failed to update external contacts relationship %s error: %s", d.Id(), err), resp)
+	}
+
+	log.Printf("Updated external contacts relationship %s", d.Id())
+	return readExternalContactsRelationship(ctx, d, meta)
+}
+
+// deleteExternalContactsRelationship is used by the externalcontacts_relationship resource to delete an external contacts relationship from Genesys Cloud
+func deleteExternalContactsRelationship(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sdkConfig := meta.(*provider.ProviderMeta).ClientConfig
+	proxy := getExternalContactsRelationshipProxy(sdkConfig)
+
+	resp, err := proxy.deleteExternalContactsRelationship(ctx, d.Id())
+	if err != nil {
+		return util.BuildAPIDiagnosticError(ResourceType, fmt.Sprintf("Failed to delete external contacts relationship %s error: %s", d.Id(), err), resp)
+	}
+
+	return util.WithRetries(ctx, 180*time.Second, func() *retry.RetryError {
+		_, resp, err := proxy.getExternalContactsRelationshipById(ctx, d.Id())
+		if err != nil {
+			if util.IsStatus404(resp) {
+				log.Printf("Deleted external contacts relationship %s", d.Id())
+				return nil
+			}
+			return retry.NonRetryableError(util.BuildWithRetriesApiDiagnosticError(ResourceType, fmt.Sprintf("Error deleting external contacts relationship %s | error: %s", d.Id(), err), resp))
+		}
+		return retry.RetryableError(util.BuildWithRetriesApiDiagnosticError(ResourceType, fmt.Sprintf("External contacts relationship %s still exists", d.Id()), resp))
+	})
+}
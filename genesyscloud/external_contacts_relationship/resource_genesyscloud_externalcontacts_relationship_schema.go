@@ -0,0 +1,77 @@
+package external_contacts_relationship
+
+// @team: External Contacts
+// @chat: #Genesys Cloud Single Customer View
+// @pm: Cilian Day
+// @jira: RELATE
+// @description: Relationships between Genesys Cloud users and external organizations.
+
+import (
+	externalContactsOrganization "github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/external_contacts_organization"
+	"github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/provider"
+	resourceExporter "github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/resource_exporter"
+	registrar "github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/resource_register"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+/*
+resource_genesyscloud_externalcontacts_relationship_schema.go holds three functions within it:
+
+1.  The registration code that registers the Resource and Exporter for the package.
+2.  The resource schema definitions for the externalcontacts_relationship resource.
+3.  The resource exporter configuration for the externalcontacts_relationship exporter.
+*/
+const ResourceType = "genesyscloud_externalcontacts_relationship"
+
+// SetRegistrar registers all of the resources, datasources and exporters in the package
+func SetRegistrar(regInstance registrar.Registrar) {
+	regInstance.RegisterResource(ResourceType, ResourceExternalContactsRelationship())
+	regInstance.RegisterExporter(ResourceType, ExternalContactsRelationshipExporter())
+}
+
+// ResourceExternalContactsRelationship registers the genesyscloud_externalcontacts_relationship resource with Terraform
+func ResourceExternalContactsRelationship() *schema.Resource {
+	return &schema.Resource{
+		Description: `Genesys Cloud external contacts relationship. Records the relationship (e.g. "Account Manager") a single Genesys Cloud user holds with an external organization.`,
+
+		CreateContext: provider.CreateWithPooledClient(createExternalContactsRelationship),
+		ReadContext:   provider.ReadWithPooledClient(readExternalContactsRelationship),
+		UpdateContext: provider.UpdateWithPooledClient(updateExternalContactsRelationship),
+		DeleteContext: provider.DeleteWithPooledClient(deleteExternalContactsRelationship),
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		SchemaVersion: 1,
+		Schema: map[string]*schema.Schema{
+			"user_id": {
+				Description: "The ID of the Genesys Cloud user in the relationship.",
+				Required:    true,
+				Type:        schema.TypeString,
+			},
+			"external_organization_id": {
+				Description: "The ID of the external organization in the relationship.",
+				Required:    true,
+				Type:        schema.TypeString,
+			},
+			"relationship": {
+				Description:  "The type of relationship the user holds with the external organization, e.g. 'Account Manager'.",
+				Required:     true,
+				Type:         schema.TypeString,
+				ValidateFunc: validation.StringLenBetween(1, 255),
+			},
+		},
+	}
+}
+
+// ExternalContactsRelationshipExporter returns the resourceExporter object used to hold the genesyscloud_externalcontacts_relationship exporter's config
+func ExternalContactsRelationshipExporter() *resourceExporter.ResourceExporter {
+	return &resourceExporter.ResourceExporter{
+		GetResourcesFunc: provider.GetAllWithPooledClient(getAllExternalContactsRelationships),
+		RefAttrs: map[string]*resourceExporter.RefAttrSettings{
+			"user_id":                  {RefType: "genesyscloud_user"},
+			"external_organization_id": {RefType: externalContactsOrganization.ResourceType},
+		},
+	}
+}
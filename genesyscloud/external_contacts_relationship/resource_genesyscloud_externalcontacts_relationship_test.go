@@ -0,0 +1,93 @@
+package external_contacts_relationship
+
+import (
+	"fmt"
+	"testing"
+
+	externalContactsOrganization "github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/external_contacts_organization"
+	"github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/provider"
+	"github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/user"
+	"github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/util"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/mypurecloud/platform-client-sdk-go/v192/platformclientv2"
+)
+
+/*
+The resource_genesyscloud_externalcontacts_relationship_test.go contains all of the test cases for running the resource
+tests for externalcontacts_relationship.
+*/
+
+func TestAccResourceExternalContactsRelationship(t *testing.T) {
+	t.Parallel()
+	var (
+		resourceLabel     = "relationship"
+		fullResourcePath  = ResourceType + "." + resourceLabel
+		relationship1     = "Account Manager"
+		relationship2     = "Technical Contact"
+		userResourceLabel = "relationship_user"
+		userEmail         = "terraform-relationship-" + uuid.NewString() + "@example.com"
+		orgResourceLabel  = "relationship_org"
+		orgName           = "Terraform Relationship Org " + uuid.NewString()
+
+		userRef = user.ResourceType + "." + userResourceLabel + ".id"
+		orgRef  = externalContactsOrganization.ResourceType + "." + orgResourceLabel + ".id"
+
+		baseConfig = user.GenerateBasicUserResource(userResourceLabel, userEmail, "Terraform Relationship User") +
+			fmt.Sprintf(`resource "%s" "%s" {
+		name = "%s"
+	}
+	`, externalContactsOrganization.ResourceType, orgResourceLabel, orgName)
+	)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { util.TestAccPreCheck(t) },
+		ProviderFactories: provider.GetProviderFactories(providerResources, providerDataSources),
+		Steps: []resource.TestStep{
+			{
+				// Create
+				Config: baseConfig + GenerateRelationshipResource(resourceLabel, userRef, orgRef, relationship1),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fullResourcePath, "relationship", relationship1),
+					resource.TestCheckResourceAttrPair(fullResourcePath, "user_id", user.ResourceType+"."+userResourceLabel, "id"),
+					resource.TestCheckResourceAttrPair(fullResourcePath, "external_organization_id", externalContactsOrganization.ResourceType+"."+orgResourceLabel, "id"),
+				),
+			},
+			{
+				// Update
+				Config: baseConfig + GenerateRelationshipResource(resourceLabel, userRef, orgRef, relationship2),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fullResourcePath, "relationship", relationship2),
+				),
+			},
+			{
+				// Import/Read
+				ResourceName:      fullResourcePath,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+		CheckDestroy: testVerifyRelationshipDestroyed,
+	})
+}
+
+func testVerifyRelationshipDestroyed(state *terraform.State) error {
+	externalAPI := platformclientv2.NewExternalContactsApi()
+	for _, rs := range state.RootModule().Resources {
+		if rs.Type != ResourceType {
+			continue
+		}
+
+		relationship, resp, err := externalAPI.GetExternalcontactsRelationship(rs.Primary.ID, "")
+		if relationship != nil {
+			return fmt.Errorf("relationship (%s) still exists", rs.Primary.ID)
+		}
+		if util.IsStatus404(resp) {
+			continue
+		}
+		return fmt.Errorf("unexpected error: %s", err)
+	}
+	return nil
+}
@@ -0,0 +1,137 @@
+package external_contacts_relationship
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/provider"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mypurecloud/platform-client-sdk-go/v192/platformclientv2"
+	"github.com/stretchr/testify/assert"
+)
+
+/** Unit Test **/
+func TestUnitResourceRelationshipCreate(t *testing.T) {
+	tId := uuid.NewString()
+	tUserId := uuid.NewString()
+	tOrganizationId := uuid.NewString()
+	tRelationship := "Account Manager"
+
+	relationshipProxy := &externalContactsRelationshipProxy{}
+
+	relationshipProxy.createExternalContactsRelationshipAttr = func(ctx context.Context, p *externalContactsRelationshipProxy, relationship *platformclientv2.Relationship) (*platformclientv2.Relationship, *platformclientv2.APIResponse, error) {
+		assert.Equal(t, tUserId, *relationship.User.Id)
+		assert.Equal(t, tOrganizationId, *relationship.ExternalOrganization.Id)
+		assert.Equal(t, tRelationship, *relationship.Relationship)
+
+		relationship.Id = &tId
+		return relationship, &platformclientv2.APIResponse{StatusCode: http.StatusOK}, nil
+	}
+
+	relationshipProxy.getExternalContactsRelationshipByIdAttr = func(ctx context.Context, p *externalContactsRelationshipProxy, id string) (*platformclientv2.Relationship, *platformclientv2.APIResponse, error) {
+		assert.Equal(t, tId, id)
+		return &platformclientv2.Relationship{
+			Id:                   &tId,
+			User:                 &platformclientv2.User{Id: &tUserId},
+			ExternalOrganization: &platformclientv2.Externalorganization{Id: &tOrganizationId},
+			Relationship:         &tRelationship,
+		}, &platformclientv2.APIResponse{StatusCode: http.StatusOK}, nil
+	}
+
+	internalProxy = relationshipProxy
+	defer func() { internalProxy = nil }()
+
+	ctx := context.Background()
+	gcloud := &provider.ProviderMeta{ClientConfig: &platformclientv2.Configuration{}}
+
+	resourceDataMap := buildRelationshipResourceMap(tUserId, tOrganizationId, tRelationship)
+	d := schema.TestResourceDataRaw(t, ResourceExternalContactsRelationship().Schema, resourceDataMap)
+
+	diag := createExternalContactsRelationship(ctx, d, gcloud)
+	assert.Equal(t, false, diag.HasError())
+	assert.Equal(t, tId, d.Id())
+	assert.Equal(t, tUserId, d.Get("user_id").(string))
+	assert.Equal(t, tOrganizationId, d.Get("external_organization_id").(string))
+	assert.Equal(t, tRelationship, d.Get("relationship").(string))
+}
+
+func TestUnitResourceRelationshipDelete(t *testing.T) {
+	tId := uuid.NewString()
+
+	relationshipProxy := &externalContactsRelationshipProxy{}
+
+	relationshipProxy.deleteExternalContactsRelationshipAttr = func(ctx context.Context, p *externalContactsRelationshipProxy, id string) (*platformclientv2.APIResponse, error) {
+		assert.Equal(t, tId, id)
+		return &platformclientv2.APIResponse{StatusCode: http.StatusNoContent}, nil
+	}
+
+	relationshipProxy.getExternalContactsRelationshipByIdAttr = func(ctx context.Context, p *externalContactsRelationshipProxy, id string) (*platformclientv2.Relationship, *platformclientv2.APIResponse, error) {
+		assert.Equal(t, tId, id)
+		return nil, &platformclientv2.APIResponse{StatusCode: http.StatusNotFound}, fmt.Errorf("not found")
+	}
+
+	internalProxy = relationshipProxy
+	defer func() { internalProxy = nil }()
+
+	ctx := context.Background()
+	gcloud := &provider.ProviderMeta{ClientConfig: &platformclientv2.Configuration{}}
+
+	resourceDataMap := buildRelationshipResourceMap(uuid.NewString(), uuid.NewString(), "Account Manager")
+	d := schema.TestResourceDataRaw(t, ResourceExternalContactsRelationship().Schema, resourceDataMap)
+	d.SetId(tId)
+
+	diag := deleteExternalContactsRelationship(ctx, d, gcloud)
+	assert.Nil(t, diag)
+	assert.Equal(t, tId, d.Id())
+}
+
+func TestUnitGetAllRelationships(t *testing.T) {
+	tOrganizationId := uuid.NewString()
+	tOrganizationName := "Acme"
+	tRelationshipIds := []string{uuid.NewString(), uuid.NewString()}
+	tRelationship := "Account Manager"
+
+	relationshipProxy := &externalContactsRelationshipProxy{}
+
+	relationshipProxy.getAllExternalOrganizationsAttr = func(ctx context.Context, p *externalContactsRelationshipProxy) (*[]platformclientv2.Externalorganization, *platformclientv2.APIResponse, error) {
+		return &[]platformclientv2.Externalorganization{{Id: &tOrganizationId, Name: &tOrganizationName}}, &platformclientv2.APIResponse{StatusCode: http.StatusOK}, nil
+	}
+
+	relationshipProxy.getExternalOrganizationRelationshipsAttr = func(ctx context.Context, p *externalContactsRelationshipProxy, organizationId string) (*[]platformclientv2.Relationship, *platformclientv2.APIResponse, error) {
+		assert.Equal(t, tOrganizationId, organizationId)
+		var relationships []platformclientv2.Relationship
+		for _, id := range tRelationshipIds {
+			relationships = append(relationships, platformclientv2.Relationship{
+				Id:           platformclientv2.String(id),
+				User:         &platformclientv2.User{Id: platformclientv2.String(uuid.NewString())},
+				Relationship: &tRelationship,
+			})
+		}
+		return &relationships, &platformclientv2.APIResponse{StatusCode: http.StatusOK}, nil
+	}
+
+	internalProxy = relationshipProxy
+	defer func() { internalProxy = nil }()
+
+	resources, diag := getAllExternalContactsRelationships(context.Background(), &platformclientv2.Configuration{})
+	assert.False(t, diag.HasError())
+	assert.Equal(t, len(tRelationshipIds), len(resources))
+	for _, id := range tRelationshipIds {
+		assert.Equal(t, tOrganizationName+"_"+tRelationship, resources[id].BlockLabel)
+		assert.NotEmpty(t, resources[id].BlockHash)
+	}
+	// Two users holding the same relationship must not collide
+	assert.NotEqual(t, resources[tRelationshipIds[0]].BlockHash, resources[tRelationshipIds[1]].BlockHash)
+}
+
+func buildRelationshipResourceMap(userId string, organizationId string, relationship string) map[string]interface{} {
+	return map[string]interface{}{
+		"user_id":                  userId,
+		"external_organization_id": organizationId,
+		"relationship":             relationship,
+	}
+}
@@ -0,0 +1,26 @@
+package external_contacts_relationship
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mypurecloud/platform-client-sdk-go/v192/platformclientv2"
+)
+
+// getRelationshipFromResourceData maps data from schema ResourceData object to a platformclientv2.Relationship
+func getRelationshipFromResourceData(d *schema.ResourceData) platformclientv2.Relationship {
+	return platformclientv2.Relationship{
+		User:                 &platformclientv2.User{Id: platformclientv2.String(d.Get("user_id").(string))},
+		ExternalOrganization: &platformclientv2.Externalorganization{Id: platformclientv2.String(d.Get("external_organization_id").(string))},
+		Relationship:         platformclientv2.String(d.Get("relationship").(string)),
+	}
+}
+
+func GenerateRelationshipResource(resourceLabel, userId, externalOrganizationId, relationship string) string {
+	return fmt.Sprintf(`resource "%s" "%s" {
+		user_id                  = %s
+		external_organization_id = %s
+		relationship             = "%s"
+	}
+	`, ResourceType, resourceLabel, userId, externalOrganizationId, relationship)
+}
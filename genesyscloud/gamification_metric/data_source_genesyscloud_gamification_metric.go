@@ -0,0 +1,43 @@
+package gamification_metric
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/provider"
+	"github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/util"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+/*
+   The data_source_genesyscloud_gamification_metric.go contains the data source implementation
+   for the resource.
+*/
+
+// dataSourceGamificationMetricRead retrieves by name the id in question
+func dataSourceGamificationMetricRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sdkConfig := meta.(*provider.ProviderMeta).ClientConfig
+	proxy := getGamificationMetricProxy(sdkConfig)
+
+	profileId := d.Get("profile_id").(string)
+	name := d.Get("name").(string)
+
+	return util.WithRetries(ctx, 15*time.Second, func() *retry.RetryError {
+		metricId, retryable, resp, err := proxy.getGamificationMetricIdByName(ctx, profileId, name)
+
+		if err != nil && !retryable {
+			return retry.NonRetryableError(util.BuildWithRetriesApiDiagnosticError(ResourceType, fmt.Sprintf("Error searching metric %s | error: %s", name, err), resp))
+		}
+
+		if retryable {
+			return retry.RetryableError(util.BuildWithRetriesApiDiagnosticError(ResourceType, fmt.Sprintf("No metric found with name %s in performance profile %s", name, profileId), resp))
+		}
+
+		d.SetId(buildMetricId(metricId, profileId))
+		return nil
+	})
+}
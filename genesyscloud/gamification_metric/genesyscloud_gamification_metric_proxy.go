@@ -0,0 +1,199 @@
+package gamification_metric
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/provider"
+
+	"github.com/mypurecloud/platform-client-sdk-go/v192/platformclientv2"
+)
+
+/*
+The genesyscloud_gamification_metric_proxy.go file contains the proxy structures and methods that interact
+with the Genesys Cloud SDK. We use composition here for each function on the proxy so individual functions can be stubbed
+out during testing.
+*/
+
+// internalProxy holds a proxy instance that can be used throughout the package
+var internalProxy *gamificationMetricProxy
+
+// Type definitions for each func on our proxy so we can easily mock them out later
+type createGamificationMetricFunc func(ctx context.Context, p *gamificationMetricProxy, profileId string, metric *platformclientv2.Createmetric) (*platformclientv2.Metric, *platformclientv2.APIResponse, error)
+type getAllGamificationProfilesFunc func(ctx context.Context, p *gamificationMetricProxy) (*[]platformclientv2.Performanceprofile, *platformclientv2.APIResponse, error)
+type getGamificationMetricsFunc func(ctx context.Context, p *gamificationMetricProxy, profileId string) (*[]platformclientv2.Metric, *platformclientv2.APIResponse, error)
+type getGamificationMetricIdByNameFunc func(ctx context.Context, p *gamificationMetricProxy, profileId string, name string) (id string, retryable bool, resp *platformclientv2.APIResponse, err error)
+type getGamificationMetricByIdFunc func(ctx context.Context, p *gamificationMetricProxy, profileId string, metricId string) (metric *platformclientv2.Metric, resp *platformclientv2.APIResponse, err error)
+type updateGamificationMetricFunc func(ctx context.Context, p *gamificationMetricProxy, profileId string, metricId string, metric *platformclientv2.Createmetric) (*platformclientv2.Metric, *platformclientv2.APIResponse, error)
+type deleteGamificationMetricFunc func(ctx context.Context, p *gamificationMetricProxy, profileId string, metricId string) (resp *platformclientv2.APIResponse, err error)
+
+// gamificationMetricProxy contains all of the methods that call genesys cloud APIs.
+type gamificationMetricProxy struct {
+	clientConfig                      *platformclientv2.Configuration
+	gamificationApi                   *platformclientv2.GamificationApi
+	createGamificationMetricAttr      createGamificationMetricFunc
+	getAllGamificationProfilesAttr    getAllGamificationProfilesFunc
+	getGamificationMetricsAttr        getGamificationMetricsFunc
+	getGamificationMetricIdByNameAttr getGamificationMetricIdByNameFunc
+	getGamificationMetricByIdAttr     getGamificationMetricByIdFunc
+	updateGamificationMetricAttr      updateGamificationMetricFunc
+	deleteGamificationMetricAttr      deleteGamificationMetricFunc
+}
+
+// newGamificationMetricProxy initializes the gamification metric proxy with all of the data needed to communicate with Genesys Cloud
+func newGamificationMetricProxy(clientConfig *platformclientv2.Configuration) *gamificationMetricProxy {
+	return &gamificationMetricProxy{
+		clientConfig:                      clientConfig,
+		gamificationApi:                   platformclientv2.NewGamificationApiWithConfig(clientConfig),
+		createGamificationMetricAttr:      createGamificationMetricFn,
+		getAllGamificationProfilesAttr:    getAllGamificationProfilesFn,
+		getGamificationMetricsAttr:        getGamificationMetricsFn,
+		getGamificationMetricIdByNameAttr: getGamificationMetricIdByNameFn,
+		getGamificationMetricByIdAttr:     getGamificationMetricByIdFn,
+		updateGamificationMetricAttr:      updateGamificationMetricFn,
+		deleteGamificationMetricAttr:      deleteGamificationMetricFn,
+	}
+}
+
+// getGamificationMetricProxy acts as a singleton to for the internalProxy.  It also ensures
+// that we can still proxy our tests by directly setting internalProxy package variable
+func getGamificationMetricProxy(clientConfig *platformclientv2.Configuration) *gamificationMetricProxy {
+	if internalProxy == nil {
+		internalProxy = newGamificationMetricProxy(clientConfig)
+	}
+	return internalProxy
+}
+
+// createGamificationMetric creates a metric in a Genesys Cloud performance profile
+func (p *gamificationMetricProxy) createGamificationMetric(ctx context.Context, profileId string, metric *platformclientv2.Createmetric) (*platformclientv2.Metric, *platformclientv2.APIResponse, error) {
+	return p.createGamificationMetricAttr(ctx, p, profileId, metric)
+}
+
+// getAllGamificationProfiles retrieves all Genesys Cloud performance profiles
+func (p *gamificationMetricProxy) getAllGamificationProfiles(ctx context.Context) (*[]platformclientv2.Performanceprofile, *platformclientv2.APIResponse, error) {
+	return p.getAllGamificationProfilesAttr(ctx, p)
+}
+
+// getGamificationMetrics retrieves all metrics of a Genesys Cloud performance profile
+func (p *gamificationMetricProxy) getGamificationMetrics(ctx context.Context, profileId string) (*[]platformclientv2.Metric, *platformclientv2.APIResponse, error) {
+	return p.getGamificationMetricsAttr(ctx, p, profileId)
+}
+
+// getGamificationMetricIdByName returns the ID of a metric of a Genesys Cloud performance profile by name
+func (p *gamificationMetricProxy) getGamificationMetricIdByName(ctx context.Context, profileId string, name string) (id string, retryable bool, resp *platformclientv2.APIResponse, err error) {
+	return p.getGamificationMetricIdByNameAttr(ctx, p, profileId, name)
+}
+
+// getGamificationMetricById returns a single metric of a Genesys Cloud performance profile by Id
+func (p *gamificationMetricProxy) getGamificationMetricById(ctx context.Context, profileId string, metricId string) (metric *platformclientv2.Metric, resp *platformclientv2.APIResponse, err error) {
+	return p.getGamificationMetricByIdAttr(ctx, p, profileId, metricId)
+}
+
+// updateGamificationMetric updates a metric of a Genesys Cloud performance profile
+func (p *gamificationMetricProxy) updateGamificationMetric(ctx context.Context, profileId string, metricId string, metric *platformclientv2.Createmetric) (*platformclientv2.Metric, *platformclientv2.APIResponse, error) {
+	return p.updateGamificationMetricAttr(ctx, p, profileId, metricId, metric)
+}
+
+// deleteGamificationMetric deletes a metric from a Genesys Cloud performance profile
+func (p *gamificationMetricProxy) deleteGamificationMetric(ctx context.Context, profileId string, metricId string) (resp *platformclientv2.APIResponse, err error) {
+	return p.deleteGamificationMetricAttr(ctx, p, profileId, metricId)
+}
+
+// createGamificationMetricFn is an implementation function for creating a metric in a Genesys Cloud performance profile
+func createGamificationMetricFn(ctx context.Context, p *gamificationMetricProxy, profileId string, metric *platformclientv2.Createmetric) (*platformclientv2.Metric, *platformclientv2.APIResponse, error) {
+	// Set resource context for SDK debug logging
+	ctx = provider.EnsureResourceContext(ctx, ResourceType)
+
+	created, resp, err := p.gamificationApi.PostGamificationProfileMetrics(profileId, *metric)
+	if err != nil {
+		return nil, resp, fmt.Errorf("failed to create metric in performance profile %s: %s", profileId, err)
+	}
+	return created, resp, nil
+}
+
+// getAllGamificationProfilesFn is the implementation for retrieving all performance profiles in Genesys Cloud
+func getAllGamificationProfilesFn(ctx context.Context, p *gamificationMetricProxy) (*[]platformclientv2.Performanceprofile, *platformclientv2.APIResponse, error) {
+	// Set resource context for SDK debug logging
+	ctx = provider.EnsureResourceContext(ctx, ResourceType)
+
+	profiles, resp, err := p.gamificationApi.GetGamificationProfiles()
+	if err != nil {
+		return nil, resp, fmt.Errorf("failed to get performance profiles: %s", err)
+	}
+	if profiles.Entities == nil {
+		return &[]platformclientv2.Performanceprofile{}, resp, nil
+	}
+	return profiles.Entities, resp, nil
+}
+
+// getGamificationMetricsFn is the implementation for retrieving all metrics of a performance profile in Genesys Cloud
+func getGamificationMetricsFn(ctx context.Context, p *gamificationMetricProxy, profileId string) (*[]platformclientv2.Metric, *platformclientv2.APIResponse, error) {
+	// Set resource context for SDK debug logging
+	ctx = provider.EnsureResourceContext(ctx, ResourceType)
+
+	metrics, resp, err := p.gamificationApi.GetGamificationProfileMetrics(profileId, []string{"objective"}, time.Now(), []string{})
+	if err != nil {
+		return nil, resp, fmt.Errorf("failed to get metrics of performance profile %s: %s", profileId, err)
+	}
+	if metrics.Entities == nil {
+		return &[]platformclientv2.Metric{}, resp, nil
+	}
+	return metrics.Entities, resp, nil
+}
+
+// getGamificationMetricIdByNameFn is an implementation of the function to get a metric of a Genesys Cloud performance profile by name
+func getGamificationMetricIdByNameFn(ctx context.Context, p *gamificationMetricProxy, profileId string, name string) (id string, retryable bool, resp *platformclientv2.APIResponse, err error) {
+	// Set resource context for SDK debug logging
+	ctx = provider.EnsureResourceContext(ctx, ResourceType)
+
+	metrics, resp, err := getGamificationMetricsFn(ctx, p, profileId)
+	if err != nil {
+		return "", false, resp, err
+	}
+
+	for _, metric := range *metrics {
+		if metric.Name != nil && *metric.Name == name {
+			log.Printf("Retrieved the metric id %s by name %s in performance profile %s", *metric.Id, name, profileId)
+			return *metric.Id, false, resp, nil
+		}
+	}
+	return "", true, resp, fmt.Errorf("unable to find metric with name %s in performance profile %s", name, profileId)
+}
+
+// getGamificationMetricByIdFn is an implementation of the function to get a metric of a Genesys Cloud performance profile by Id
+func getGamificationMetricByIdFn(ctx context.Context, p *gamificationMetricProxy, profileId string, metricId string) (*platformclientv2.Metric, *platformclientv2.APIResponse, error) {
+	// Set resource context for SDK debug logging
+	ctx = provider.EnsureResourceContext(ctx, ResourceType)
+
+	metric, resp, err := p.gamificationApi.GetGamificationProfileMetric(profileId, metricId, time.Now())
+	if err != nil {
+		return nil, resp, fmt.Errorf("failed to retrieve metric %s of performance profile %s: %s", metricId, profileId, err)
+	}
+	return metric, resp, nil
+}
+
+// updateGamificationMetricFn is an implementation of the function to update a metric of a Genesys Cloud performance profile
+func updateGamificationMetricFn(ctx context.Context, p *gamificationMetricProxy, profileId string, metricId string, metric *platformclientv2.Createmetric) (*platformclientv2.Metric, *platformclientv2.APIResponse, error) {
+	// Set resource context for SDK debug logging
+	ctx = provider.EnsureResourceContext(ctx, ResourceType)
+
+	updated, resp, err := p.gamificationApi.PutGamificationProfileMetric(profileId, metricId, *metric)
+	if err != nil {
+		return nil, resp, fmt.Errorf("failed to update metric %s of performance profile %s: %s", metricId, profileId, err)
+	}
+	return updated, resp, nil
+}
+
+// deleteGamificationMetricFn is an implementation function for deleting a metric from a Genesys Cloud performance profile
+func deleteGamificationMetricFn(ctx context.Context, p *gamificationMetricProxy, profileId string, metricId string) (*platformclientv2.APIResponse, error) {
+	// Set resource context for SDK debug logging
+	ctx = provider.EnsureResourceContext(ctx, ResourceType)
+
+	resp, err := p.gamificationApi.DeleteGamificationProfileMetric(profileId, metricId)
+	if err != nil {
+		return resp, fmt.Errorf("failed to delete metric %s of performance profile %s: %s", metricId, profileId, err)
+	}
+	return resp, nil
+}
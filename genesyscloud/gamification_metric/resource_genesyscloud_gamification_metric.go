@@ -0,0 +1,141 @@
+package gamification_metric
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/consistency_checker"
+	"github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/provider"
+	resourceExporter "github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/resource_exporter"
+	"github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/util"
+	"github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/util/constants"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mypurecloud/platform-client-sdk-go/v192/platformclientv2"
+
+	"github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/util/resourcedata"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+)
+
+/*
+The resource_genesyscloud_gamification_metric.go contains all of the methods that perform the core logic for a resource.
+*/
+
+// getAllAuthGamificationMetrics retrieves the metrics of every performance profile in Genesys Cloud and is used for the exporter
+func getAllAuthGamificationMetrics(ctx context.Context, clientConfig *platformclientv2.Configuration) (resourceExporter.ResourceIDMetaMap, diag.Diagnostics) {
+	proxy := getGamificationMetricProxy(clientConfig)
+	resources := make(resourceExporter.ResourceIDMetaMap)
+
+	profiles, resp, err := proxy.getAllGamificationProfiles(ctx)
+	if err != nil {
+		return nil, util.BuildAPIDiagnosticError(ResourceType, fmt.Sprintf("Failed to get performance profiles error: %s", err), resp)
+	}
+
+	for _, profile := range *profiles {
+		metrics, resp, err := proxy.getGamificationMetrics(ctx, *profile.Id)
+		if err != nil {
+			return nil, util.BuildAPIDiagnosticError(ResourceType, fmt.Sprintf("Failed to get metrics of performance profile %s error: %s", *profile.Id, err), resp)
+		}
+		for _, metric := range *metrics {
+			// Linked metrics are managed through the profile they are linked from
+			if metric.LinkedMetric != nil && metric.LinkedMetric.Id != nil {
+				continue
+			}
+			resources[buildMetricId(*metric.Id, *profile.Id)] = &resourceExporter.ResourceMeta{BlockLabel: *profile.Name + "_" + *metric.Name}
+		}
+	}
+	return resources, nil
+}
+
+// createGamificationMetric is used by the gamification_metric resource to create a metric in a Genesys Cloud performance profile
+func createGamificationMetric(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sdkConfig := meta.(*provider.ProviderMeta).ClientConfig
+	proxy := getGamificationMetricProxy(sdkConfig)
+	profileId := d.Get("profile_id").(string)
+	metric := getMetricFromResourceData(d)
+
+	log.Printf("Creating metric %s in performance profile %s", *metric.Name, profileId)
+	metricObj, resp, err := proxy.createGamificationMetric(ctx, profileId, &metric)
+	if err != nil {
+		return util.BuildAPIDiagnosticError(ResourceType, fmt.Sprintf("Failed to create metric %s error: %s", *metric.Name, err), resp)
+	}
+
+	d.SetId(buildMetricId(*metricObj.Id, profileId))
+	log.Printf("Created metric %s in performance profile %s", *metricObj.Id, profileId)
+	return readGamificationMetric(ctx, d, meta)
+}
+
+// readGamificationMetric is used by the gamification_metric resource to read a metric from a Genesys Cloud performance profile
+func readGamificationMetric(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sdkConfig := meta.(*provider.ProviderMeta).ClientConfig
+	proxy := getGamificationMetricProxy(sdkConfig)
+	cc := consistency_checker.NewConsistencyCheck(ctx, d, meta, ResourceGamificationMetric(), constants.ConsistencyChecks(), ResourceType)
+	metricId, profileId := splitMetricId(d.Id())
+
+	log.Printf("Reading metric %s in performance profile %s", metricId, profileId)
+
+	return util.WithRetriesForRead(ctx, d, func() *retry.RetryError {
+		metric, resp, getErr := proxy.getGamificationMetricById(ctx, profileId, metricId)
+		if getErr != nil {
+			if util.IsStatus404(resp) {
+				return retry.RetryableError(util.BuildWithRetriesApiDiagnosticError(ResourceType, fmt.Sprintf("Failed to read metric %s | error: %s", metricId, getErr), resp))
+			}
+			return retry.NonRetryableError(util.BuildWithRetriesApiDiagnosticError(ResourceType, fmt.Sprintf("Failed to read metric %s | error: %s", metricId, getErr), resp))
+		}
+
+		_ = d.Set("profile_id", profileId)
+		resourcedata.SetNillableValue(d, "name", metric.Name)
+		resourcedata.SetNillableValue(d, "metric_definition_id", metric.MetricDefinitionId)
+		resourcedata.SetNillableValue(d, "external_metric_definition_id", metric.ExternalMetricDefinitionId)
+		resourcedata.SetNillableValue(d, "precision", metric.Precision)
+		resourcedata.SetNillableValue(d, "time_display_unit", metric.TimeDisplayUnit)
+		resourcedata.SetNillableValueWithInterfaceArrayWithFunc(d, "objective", metric.Objective, flattenObjective)
+
+		log.Printf("Read metric %s %s", metricId, *metric.Name)
+		return cc.CheckState(d)
+	})
+}
+
+// updateGamificationMetric is used by the gamification_metric resource to update a metric in a Genesys Cloud performance profile
+func updateGamificationMetric(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sdkConfig := meta.(*provider.ProviderMeta).ClientConfig
+	proxy := getGamificationMetricProxy(sdkConfig)
+	metricId, profileId := splitMetricId(d.Id())
+	metric := getMetricFromResourceData(d)
+
+	log.Printf("Updating metric %s in performance profile %s", metricId, profileId)
+	if _, resp, err := proxy.updateGamificationMetric(ctx, profileId, metricId, &metric); err != nil {
+		return util.BuildAPIDiagnosticError(ResourceType, fmt.Sprintf("Failed to update metric %s error: %s", *metric.Name, err), resp)
+	}
+
+	log.Printf("Updated metric %s", metricId)
+	return readGamificationMetric(ctx, d, meta)
+}
+
+// deleteGamificationMetric is used by the gamification_metric resource to delete a metric from a Genesys Cloud performance profile
+func deleteGamificationMetric(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sdkConfig := meta.(*provider.ProviderMeta).ClientConfig
+	proxy := getGamificationMetricProxy(sdkConfig)
+	metricId, profileId := splitMetricId(d.Id())
+
+	resp, err := proxy.deleteGamificationMetric(ctx, profileId, metricId)
+	if err != nil {
+		return util.BuildAPIDiagnosticError(ResourceType, fmt.Sprintf("Failed to delete metric %s error: %s", metricId, err), resp)
+	}
+
+	return util.WithRetries(ctx, 180*time.Second, func() *retry.RetryError {
+		_, resp, err := proxy.getGamificationMetricById(ctx, profileId, metricId)
+		if err != nil {
+			if util.IsStatus404(resp) {
+				log.Printf("Deleted metric %s", metricId)
+				return nil
+			}
+			return retry.NonRetryableError(util.BuildWithRetriesApiDiagnosticError(ResourceType, fmt.Sprintf("Error deleting metric %s | error: %s", metricId, err), resp))
+		}
+		return retry.RetryableError(util.BuildWithRetriesApiDiagnosticError(ResourceType, fmt.Sprintf("Metric %s still exists", metricId), resp))
+	})
+}
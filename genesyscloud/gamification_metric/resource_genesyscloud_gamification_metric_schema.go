@@ -0,0 +1,206 @@
+package gamification_metric
+
+// @team: Workforce Engagement Management
+// @description: Metrics within a gamification performance profile. A metric measures agents against a built-in metric definition or an external metric definition using an objective made up of scoring zones.
+
+import (
+	"github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/provider"
+	resourceExporter "github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/resource_exporter"
+	registrar "github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/resource_register"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+/*
+resource_genesycloud_gamification_metric_schema.go holds four functions within it:
+
+1.  The registration code that registers the Datasource, Resource and Exporter for the package.
+2.  The resource schema definitions for the gamification_metric resource.
+3.  The datasource schema definitions for the gamification_metric datasource.
+4.  The resource exporter configuration for the gamification_metric exporter.
+*/
+const ResourceType = "genesyscloud_gamification_metric"
+
+// SetRegistrar registers all of the resources, datasources and exporters in the package
+func SetRegistrar(regInstance registrar.Registrar) {
+	regInstance.RegisterResource(ResourceType, ResourceGamificationMetric())
+	regInstance.RegisterDataSource(ResourceType, DataSourceGamificationMetric())
+	regInstance.RegisterExporter(ResourceType, GamificationMetricExporter())
+}
+
+var objectiveZoneResource = &schema.Resource{
+	Schema: map[string]*schema.Schema{
+		"label": {
+			Description: "The label of the zone.",
+			Optional:    true,
+			Type:        schema.TypeString,
+		},
+		"direction_type": {
+			Description:  "The direction of the zone.",
+			Required:     true,
+			Type:         schema.TypeString,
+			ValidateFunc: validation.StringInSlice([]string{"Up", "Down", "NoDirection"}, false),
+		},
+		"zone_type": {
+			Description:  "The type of the zone.",
+			Required:     true,
+			Type:         schema.TypeString,
+			ValidateFunc: validation.StringInSlice([]string{"Range", "Fixed", "Dynamic"}, false),
+		},
+		"score": {
+			Description: "The number of points awarded for reaching the zone.",
+			Required:    true,
+			Type:        schema.TypeInt,
+		},
+		"colour": {
+			Description: "The colour of the zone, e.g. #58B0E6.",
+			Optional:    true,
+			Type:        schema.TypeString,
+		},
+		"min_value": {
+			Description: "The lower bound of the zone.",
+			Optional:    true,
+			Type:        schema.TypeFloat,
+		},
+		"max_value": {
+			Description: "The upper bound of the zone.",
+			Optional:    true,
+			Type:        schema.TypeFloat,
+		},
+	},
+}
+
+var objectiveResource = &schema.Resource{
+	Schema: map[string]*schema.Schema{
+		"template_id": {
+			Description: "The ID of the objective template.",
+			Required:    true,
+			Type:        schema.TypeString,
+		},
+		"enabled": {
+			Description: "Whether the objective is enabled.",
+			Optional:    true,
+			Default:     true,
+			Type:        schema.TypeBool,
+		},
+		"media_types": {
+			Description: "The media types the objective applies to.",
+			Optional:    true,
+			Type:        schema.TypeSet,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+		},
+		"queue_ids": {
+			Description: "The IDs of the queues the objective applies to.",
+			Optional:    true,
+			Type:        schema.TypeSet,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+		},
+		"zones": {
+			Description: "The scoring zones of the objective.",
+			Required:    true,
+			Type:        schema.TypeList,
+			Elem:        objectiveZoneResource,
+		},
+	},
+}
+
+// ResourceGamificationMetric registers the genesyscloud_gamification_metric resource with Terraform
+func ResourceGamificationMetric() *schema.Resource {
+	return &schema.Resource{
+		Description: `Genesys Cloud gamification metric within a performance profile`,
+
+		CreateContext: provider.CreateWithPooledClient(createGamificationMetric),
+		ReadContext:   provider.ReadWithPooledClient(readGamificationMetric),
+		UpdateContext: provider.UpdateWithPooledClient(updateGamificationMetric),
+		DeleteContext: provider.DeleteWithPooledClient(deleteGamificationMetric),
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		SchemaVersion: 1,
+		Schema: map[string]*schema.Schema{
+			"profile_id": {
+				Description: "The ID of the performance profile the metric belongs to. Changing the profile_id attribute will cause the metric to be dropped and recreated.",
+				Required:    true,
+				ForceNew:    true,
+				Type:        schema.TypeString,
+			},
+			"name": {
+				Description: "The name of the metric.",
+				Required:    true,
+				Type:        schema.TypeString,
+			},
+			"metric_definition_id": {
+				Description:  "The ID of the built-in metric definition. Exactly one of metric_definition_id and external_metric_definition_id must be set.",
+				Optional:     true,
+				ForceNew:     true,
+				Type:         schema.TypeString,
+				ExactlyOneOf: []string{"metric_definition_id", "external_metric_definition_id"},
+			},
+			"external_metric_definition_id": {
+				Description:  "The ID of the external metric definition. Exactly one of metric_definition_id and external_metric_definition_id must be set.",
+				Optional:     true,
+				ForceNew:     true,
+				Type:         schema.TypeString,
+				ExactlyOneOf: []string{"metric_definition_id", "external_metric_definition_id"},
+			},
+			"precision": {
+				Description:  "The precision of the metric. Must be between 0 and 5.",
+				Optional:     true,
+				Computed:     true,
+				Type:         schema.TypeInt,
+				ValidateFunc: validation.IntBetween(0, 5),
+			},
+			"time_display_unit": {
+				Description:  "The time unit in which the metric should be displayed. Only applies to time based metrics.",
+				Optional:     true,
+				Computed:     true,
+				Type:         schema.TypeString,
+				ValidateFunc: validation.StringInSlice([]string{"None", "Seconds", "Minutes", "Hours"}, false),
+			},
+			"objective": {
+				Description: "The objective the metric is measured against.",
+				Required:    true,
+				Type:        schema.TypeList,
+				MaxItems:    1,
+				Elem:        objectiveResource,
+			},
+		},
+	}
+}
+
+// GamificationMetricExporter returns the resourceExporter object used to hold the genesyscloud_gamification_metric exporter's config
+func GamificationMetricExporter() *resourceExporter.ResourceExporter {
+	return &resourceExporter.ResourceExporter{
+		GetResourcesFunc: provider.GetAllWithPooledClient(getAllAuthGamificationMetrics),
+		RefAttrs: map[string]*resourceExporter.RefAttrSettings{
+			"profile_id":                    {RefType: "genesyscloud_gamification_profile"},
+			"external_metric_definition_id": {RefType: "genesyscloud_employeeperformance_externalmetrics_definitions"},
+			"objective.queue_ids":           {RefType: "genesyscloud_routing_queue"},
+		},
+		AllowZeroValues: []string{"precision", "objective.zones.score", "objective.zones.min_value", "objective.zones.max_value"},
+	}
+}
+
+// DataSourceGamificationMetric registers the genesyscloud_gamification_metric data source
+func DataSourceGamificationMetric() *schema.Resource {
+	return &schema.Resource{
+		Description: `Genesys Cloud gamification metric data source. Select a metric by name within a performance profile`,
+		ReadContext: provider.ReadWithPooledClient(dataSourceGamificationMetricRead),
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"profile_id": {
+				Description: `The ID of the performance profile the metric belongs to`,
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"name": {
+				Description: `Metric name`,
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+		},
+	}
+}
@@ -0,0 +1,126 @@
+package gamification_metric
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	authDivision "github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/auth_division"
+	gamificationProfile "github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/gamification_profile"
+	"github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/provider"
+	"github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/util"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/mypurecloud/platform-client-sdk-go/v192/platformclientv2"
+)
+
+/*
+The resource_genesyscloud_gamification_metric_test.go contains all of the test cases for running the resource
+tests for gamification_metric.
+*/
+
+func TestAccResourceGamificationMetric(t *testing.T) {
+	var (
+		resourceLabel = "metric"
+		name1         = "Terraform Metric " + uuid.NewString()
+		name2         = "Terraform Metric " + uuid.NewString()
+
+		divResourceLabel        = "test-division"
+		divName                 = "terraform-" + uuid.NewString()
+		profileResourceLabel    = "test-profile"
+		profileName             = "Terraform Profile " + uuid.NewString()
+		definitionResourceLabel = "test-definition"
+		definitionName          = "Terraform Definition " + uuid.NewString()
+
+		fullResourcePath = ResourceType + "." + resourceLabel
+		profileRef       = gamificationProfile.ResourceType + "." + profileResourceLabel + ".id"
+		definitionRef    = "genesyscloud_employeeperformance_externalmetrics_definitions." + definitionResourceLabel + ".id"
+	)
+
+	provider.AuthorizeSdk()
+	templateId := getObjectiveTemplateId(t)
+
+	baseConfig := authDivision.GenerateAuthDivisionBasic(divResourceLabel, divName) +
+		gamificationProfile.GenerateGamificationProfileResource(profileResourceLabel, profileName, "genesyscloud_auth_division."+divResourceLabel+".id", "", true) +
+		fmt.Sprintf(`resource "genesyscloud_employeeperformance_externalmetrics_definitions" "%s" {
+		name                   = "%s"
+		unit                   = "Number"
+		precision              = 2
+		default_objective_type = "HigherIsBetter"
+		enabled                = true
+	}
+	`, definitionResourceLabel, definitionName)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { util.TestAccPreCheck(t) },
+		ProviderFactories: provider.GetProviderFactories(providerResources, providerDataSources),
+		Steps: []resource.TestStep{
+			{
+				// Create
+				Config: baseConfig + GenerateGamificationMetricResource(resourceLabel, profileRef, name1, definitionRef, templateId,
+					GenerateObjectiveZone("Up", "Range", 10, 0, 50),
+				),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fullResourcePath, "name", name1),
+					resource.TestCheckResourceAttrPair(fullResourcePath, "profile_id", gamificationProfile.ResourceType+"."+profileResourceLabel, "id"),
+					resource.TestCheckResourceAttrPair(fullResourcePath, "external_metric_definition_id", "genesyscloud_employeeperformance_externalmetrics_definitions."+definitionResourceLabel, "id"),
+					resource.TestCheckResourceAttr(fullResourcePath, "objective.0.template_id", templateId),
+					resource.TestCheckResourceAttr(fullResourcePath, "objective.0.zones.#", "1"),
+					resource.TestCheckResourceAttr(fullResourcePath, "objective.0.zones.0.score", "10"),
+				),
+			},
+			{
+				// Update
+				Config: baseConfig + GenerateGamificationMetricResource(resourceLabel, profileRef, name2, definitionRef, templateId,
+					GenerateObjectiveZone("Up", "Range", 10, 0, 50),
+					GenerateObjectiveZone("Up", "Range", 20, 50, 100),
+				),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fullResourcePath, "name", name2),
+					resource.TestCheckResourceAttr(fullResourcePath, "objective.0.zones.#", "2"),
+					resource.TestCheckResourceAttr(fullResourcePath, "objective.0.zones.1.score", "20"),
+				),
+			},
+			{
+				// Import/Read
+				ResourceName:      fullResourcePath,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+		CheckDestroy: testVerifyGamificationMetricDestroyed,
+	})
+}
+
+// getObjectiveTemplateId returns the ID of the first objective template available in the org
+func getObjectiveTemplateId(t *testing.T) string {
+	gamificationApi := platformclientv2.NewGamificationApi()
+	templates, _, err := gamificationApi.GetGamificationTemplates()
+	if err != nil || templates == nil || templates.Entities == nil || len(*templates.Entities) == 0 {
+		t.Skipf("Skipping test as no gamification objective templates are available: %v", err)
+	}
+	return *(*templates.Entities)[0].Id
+}
+
+func testVerifyGamificationMetricDestroyed(state *terraform.State) error {
+	gamificationApi := platformclientv2.NewGamificationApi()
+
+	for _, rs := range state.RootModule().Resources {
+		if rs.Type != ResourceType {
+			continue
+		}
+
+		metricId, profileId := splitMetricId(rs.Primary.ID)
+		metric, resp, err := gamificationApi.GetGamificationProfileMetric(profileId, metricId, time.Now())
+		if metric != nil {
+			return fmt.Errorf("metric (%s) still exists", metricId)
+		}
+		if util.IsStatus404(resp) {
+			continue
+		}
+		return fmt.Errorf("unexpected error: %s", err)
+	}
+	return nil
+}
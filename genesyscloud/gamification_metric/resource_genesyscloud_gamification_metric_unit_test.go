@@ -0,0 +1,93 @@
+package gamification_metric
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/provider"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mypurecloud/platform-client-sdk-go/v192/platformclientv2"
+	"github.com/stretchr/testify/assert"
+)
+
+/** Unit Test **/
+func TestUnitResourceGamificationMetricCreate(t *testing.T) {
+	tMetricId := uuid.NewString()
+	tProfileId := uuid.NewString()
+	tDefinitionId := uuid.NewString()
+	tTemplateId := uuid.NewString()
+	tName := "Unit Test Metric"
+
+	metricProxy := &gamificationMetricProxy{}
+
+	var createdMetric *platformclientv2.Createmetric
+	metricProxy.createGamificationMetricAttr = func(ctx context.Context, p *gamificationMetricProxy, profileId string, metric *platformclientv2.Createmetric) (*platformclientv2.Metric, *platformclientv2.APIResponse, error) {
+		assert.Equal(t, tProfileId, profileId)
+		createdMetric = metric
+		return &platformclientv2.Metric{Id: &tMetricId, Name: metric.Name}, &platformclientv2.APIResponse{StatusCode: http.StatusOK}, nil
+	}
+	metricProxy.getGamificationMetricByIdAttr = func(ctx context.Context, p *gamificationMetricProxy, profileId string, metricId string) (*platformclientv2.Metric, *platformclientv2.APIResponse, error) {
+		assert.Equal(t, tProfileId, profileId)
+		assert.Equal(t, tMetricId, metricId)
+		return &platformclientv2.Metric{
+			Id:                         &tMetricId,
+			Name:                       &tName,
+			ExternalMetricDefinitionId: &tDefinitionId,
+			Objective:                  createdMetric.Objective,
+		}, &platformclientv2.APIResponse{StatusCode: http.StatusOK}, nil
+	}
+
+	internalProxy = metricProxy
+	defer func() { internalProxy = nil }()
+
+	ctx := context.Background()
+	gc := &provider.ProviderMeta{ClientConfig: &platformclientv2.Configuration{}}
+
+	resourceDataMap := map[string]interface{}{
+		"profile_id":                    tProfileId,
+		"name":                          tName,
+		"external_metric_definition_id": tDefinitionId,
+		"objective": []interface{}{
+			map[string]interface{}{
+				"template_id": tTemplateId,
+				"enabled":     true,
+				"zones": []interface{}{
+					map[string]interface{}{
+						"direction_type": "Up",
+						"zone_type":      "Range",
+						"score":          10,
+						"min_value":      0.0,
+						"max_value":      50.0,
+					},
+				},
+			},
+		},
+	}
+
+	d := schema.TestResourceDataRaw(t, ResourceGamificationMetric().Schema, resourceDataMap)
+
+	diag := createGamificationMetric(ctx, d, gc)
+	assert.Equal(t, false, diag.HasError())
+	assert.Equal(t, buildMetricId(tMetricId, tProfileId), d.Id())
+	assert.Nil(t, createdMetric.MetricDefinitionId)
+	assert.Equal(t, tDefinitionId, *createdMetric.ExternalMetricDefinitionId)
+	assert.Equal(t, tTemplateId, *createdMetric.Objective.TemplateId)
+	assert.Equal(t, 1, len(*createdMetric.Objective.Zones))
+	assert.Equal(t, tName, d.Get("name").(string))
+	assert.Equal(t, tProfileId, d.Get("profile_id").(string))
+	assert.Equal(t, 10, d.Get("objective.0.zones.0.score").(int))
+	assert.Equal(t, 50.0, d.Get("objective.0.zones.0.max_value").(float64))
+}
+
+func TestUnitSplitMetricId(t *testing.T) {
+	metricId, profileId := splitMetricId(buildMetricId("metric", "profile"))
+	assert.Equal(t, "metric", metricId)
+	assert.Equal(t, "profile", profileId)
+
+	metricId, profileId = splitMetricId("metric")
+	assert.Equal(t, "metric", metricId)
+	assert.Equal(t, "", profileId)
+}
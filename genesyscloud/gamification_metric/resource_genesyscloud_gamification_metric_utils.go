@@ -0,0 +1,140 @@
+package gamification_metric
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/util/lists"
+	"github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/util/resourcedata"
+
+	"github.com/mypurecloud/platform-client-sdk-go/v192/platformclientv2"
+)
+
+// buildMetricId builds the composite ID used to identify a metric within its performance profile
+func buildMetricId(metricId string, profileId string) string {
+	return fmt.Sprintf("%s,%s", metricId, profileId)
+}
+
+// splitMetricId returns the metric ID and performance profile ID held in a composite metric ID
+func splitMetricId(id string) (metricId string, profileId string) {
+	parts := strings.SplitN(id, ",", 2)
+	if len(parts) < 2 {
+		return parts[0], ""
+	}
+	return parts[0], parts[1]
+}
+
+// getMetricFromResourceData maps data from schema ResourceData object to a platformclientv2.Createmetric
+func getMetricFromResourceData(d *schema.ResourceData) platformclientv2.Createmetric {
+	metric := platformclientv2.Createmetric{
+		Name:                       platformclientv2.String(d.Get("name").(string)),
+		PerformanceProfileId:       platformclientv2.String(d.Get("profile_id").(string)),
+		MetricDefinitionId:         resourcedata.GetNonZeroPointer[string](d, "metric_definition_id"),
+		ExternalMetricDefinitionId: resourcedata.GetNonZeroPointer[string](d, "external_metric_definition_id"),
+		TimeDisplayUnit:            resourcedata.GetNonZeroPointer[string](d, "time_display_unit"),
+		Objective:                  resourcedata.BuildSdkListFirstElement(d, "objective", buildObjective, true),
+	}
+	if precision, ok := d.GetOk("precision"); ok {
+		metric.Precision = platformclientv2.Int(precision.(int))
+	}
+	return metric
+}
+
+func buildObjective(objectiveMap map[string]interface{}) *platformclientv2.Objective {
+	objective := platformclientv2.Objective{
+		TemplateId: platformclientv2.String(objectiveMap["template_id"].(string)),
+		Enabled:    platformclientv2.Bool(objectiveMap["enabled"].(bool)),
+		MediaTypes: &[]string{},
+		QueueIds:   &[]string{},
+	}
+	if mediaTypes, ok := objectiveMap["media_types"].(*schema.Set); ok {
+		objective.MediaTypes = lists.SetToStringList(mediaTypes)
+	}
+	if queueIds, ok := objectiveMap["queue_ids"].(*schema.Set); ok {
+		objective.QueueIds = lists.SetToStringList(queueIds)
+	}
+
+	zones := make([]platformclientv2.Objectivezone, 0)
+	if zonesList, ok := objectiveMap["zones"].([]interface{}); ok {
+		for _, zone := range zonesList {
+			zones = append(zones, buildObjectiveZone(zone.(map[string]interface{})))
+		}
+	}
+	objective.Zones = &zones
+	return &objective
+}
+
+func buildObjectiveZone(zoneMap map[string]interface{}) platformclientv2.Objectivezone {
+	zone := platformclientv2.Objectivezone{
+		DirectionType: platformclientv2.String(zoneMap["direction_type"].(string)),
+		ZoneType:      platformclientv2.String(zoneMap["zone_type"].(string)),
+		Score:         platformclientv2.Int(zoneMap["score"].(int)),
+		MinValue:      platformclientv2.Float64(zoneMap["min_value"].(float64)),
+		MaxValue:      platformclientv2.Float64(zoneMap["max_value"].(float64)),
+	}
+	resourcedata.BuildSDKStringValueIfNotNil(&zone.Label, zoneMap, "label")
+	resourcedata.BuildSDKStringValueIfNotNil(&zone.Colour, zoneMap, "colour")
+	return zone
+}
+
+func flattenObjective(objective *platformclientv2.Objective) []interface{} {
+	objectiveMap := make(map[string]interface{})
+
+	resourcedata.SetMapValueIfNotNil(objectiveMap, "template_id", objective.TemplateId)
+	resourcedata.SetMapValueIfNotNil(objectiveMap, "enabled", objective.Enabled)
+	if objective.MediaTypes != nil {
+		objectiveMap["media_types"] = lists.StringListToSet(*objective.MediaTypes)
+	}
+	if objective.QueueIds != nil {
+		objectiveMap["queue_ids"] = lists.StringListToSet(*objective.QueueIds)
+	}
+
+	if objective.Zones != nil {
+		zones := make([]interface{}, 0, len(*objective.Zones))
+		for _, zone := range *objective.Zones {
+			zoneMap := make(map[string]interface{})
+			resourcedata.SetMapValueIfNotNil(zoneMap, "label", zone.Label)
+			resourcedata.SetMapValueIfNotNil(zoneMap, "direction_type", zone.DirectionType)
+			resourcedata.SetMapValueIfNotNil(zoneMap, "zone_type", zone.ZoneType)
+			resourcedata.SetMapValueIfNotNil(zoneMap, "score", zone.Score)
+			resourcedata.SetMapValueIfNotNil(zoneMap, "colour", zone.Colour)
+			resourcedata.SetMapValueIfNotNil(zoneMap, "min_value", zone.MinValue)
+			resourcedata.SetMapValueIfNotNil(zoneMap, "max_value", zone.MaxValue)
+			zones = append(zones, zoneMap)
+		}
+		objectiveMap["zones"] = zones
+	}
+
+	return []interface{}{objectiveMap}
+}
+
+func GenerateGamificationMetricResource(
+	resourceLabel string,
+	profileId string,
+	name string,
+	externalMetricDefinitionId string,
+	objectiveTemplateId string,
+	zones ...string) string {
+	return fmt.Sprintf(`resource "%s" "%s" {
+		profile_id                    = %s
+		name                          = "%s"
+		external_metric_definition_id = %s
+		objective {
+			template_id = "%s"
+			%s
+		}
+	}
+	`, ResourceType, resourceLabel, profileId, name, externalMetricDefinitionId, objectiveTemplateId, strings.Join(zones, "\n"))
+}
+
+func GenerateObjectiveZone(directionType string, zoneType string, score int, minValue float64, maxValue float64) string {
+	return fmt.Sprintf(`zones {
+				direction_type = "%s"
+				zone_type      = "%s"
+				score          = %d
+				min_value      = %v
+				max_value      = %v
+			}`, directionType, zoneType, score, minValue, maxValue)
+}
@@ -0,0 +1,308 @@
+package gamification_profile
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/provider"
+	"github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/util"
+
+	"github.com/mypurecloud/platform-client-sdk-go/v192/platformclientv2"
+)
+
+/*
+The genesyscloud_gamification_profile_proxy.go file contains the proxy structures and methods that interact
+with the Genesys Cloud SDK. We use composition here for each function on the proxy so individual functions can be stubbed
+out during testing.
+*/
+
+// internalProxy holds a proxy instance that can be used throughout the package
+var internalProxy *gamificationProfileProxy
+
+// Type definitions for each func on our proxy so we can easily mock them out later
+type createGamificationProfileFunc func(ctx context.Context, p *gamificationProfileProxy, profile *platformclientv2.Createperformanceprofile) (*platformclientv2.Performanceprofile, *platformclientv2.APIResponse, error)
+type getAllGamificationProfilesFunc func(ctx context.Context, p *gamificationProfileProxy) (*[]platformclientv2.Performanceprofile, *platformclientv2.APIResponse, error)
+type getGamificationProfileIdByNameFunc func(ctx context.Context, p *gamificationProfileProxy, name string) (id string, retryable bool, resp *platformclientv2.APIResponse, err error)
+type getGamificationProfileByIdFunc func(ctx context.Context, p *gamificationProfileProxy, id string) (profile *platformclientv2.Performanceprofile, resp *platformclientv2.APIResponse, err error)
+type updateGamificationProfileFunc func(ctx context.Context, p *gamificationProfileProxy, id string, profile *platformclientv2.Performanceprofile) (*platformclientv2.Performanceprofile, *platformclientv2.APIResponse, error)
+type setGamificationProfileActiveFunc func(ctx context.Context, p *gamificationProfileProxy, id string, active bool) (*platformclientv2.Performanceprofile, *platformclientv2.APIResponse, error)
+type deleteGamificationProfileFunc func(ctx context.Context, p *gamificationProfileProxy, id string) (resp *platformclientv2.APIResponse, err error)
+type getGamificationProfileMembersFunc func(ctx context.Context, p *gamificationProfileProxy, id string) (memberIds []string, resp *platformclientv2.APIResponse, err error)
+type updateGamificationProfileMembersFunc func(ctx context.Context, p *gamificationProfileProxy, id string, assign platformclientv2.Assignusers) (*platformclientv2.Assignment, *platformclientv2.APIResponse, error)
+type getTeamMemberIdsFunc func(ctx context.Context, p *gamificationProfileProxy, teamId string) (memberIds []string, resp *platformclientv2.APIResponse, err error)
+
+// gamificationProfileProxy contains all of the methods that call genesys cloud APIs.
+type gamificationProfileProxy struct {
+	clientConfig                         *platformclientv2.Configuration
+	gamificationApi                      *platformclientv2.GamificationApi
+	teamsApi                             *platformclientv2.TeamsApi
+	createGamificationProfileAttr        createGamificationProfileFunc
+	getAllGamificationProfilesAttr       getAllGamificationProfilesFunc
+	getGamificationProfileIdByNameAttr   getGamificationProfileIdByNameFunc
+	getGamificationProfileByIdAttr       getGamificationProfileByIdFunc
+	updateGamificationProfileAttr        updateGamificationProfileFunc
+	setGamificationProfileActiveAttr     setGamificationProfileActiveFunc
+	deleteGamificationProfileAttr        deleteGamificationProfileFunc
+	getGamificationProfileMembersAttr    getGamificationProfileMembersFunc
+	updateGamificationProfileMembersAttr updateGamificationProfileMembersFunc
+	getTeamMemberIdsAttr                 getTeamMemberIdsFunc
+}
+
+// newGamificationProfileProxy initializes the gamification profile proxy with all of the data needed to communicate with Genesys Cloud
+func newGamificationProfileProxy(clientConfig *platformclientv2.Configuration) *gamificationProfileProxy {
+	return &gamificationProfileProxy{
+		clientConfig:                         clientConfig,
+		gamificationApi:                      platformclientv2.NewGamificationApiWithConfig(clientConfig),
+		teamsApi:                             platformclientv2.NewTeamsApiWithConfig(clientConfig),
+		createGamificationProfileAttr:        createGamificationProfileFn,
+		getAllGamificationProfilesAttr:       getAllGamificationProfilesFn,
+		getGamificationProfileIdByNameAttr:   getGamificationProfileIdByNameFn,
+		getGamificationProfileByIdAttr:       getGamificationProfileByIdFn,
+		updateGamificationProfileAttr:        updateGamificationProfileFn,
+		setGamificationProfileActiveAttr:     setGamificationProfileActiveFn,
+		deleteGamificationProfileAttr:        deleteGamificationProfileFn,
+		getGamificationProfileMembersAttr:    getGamificationProfileMembersFn,
+		updateGamificationProfileMembersAttr: updateGamificationProfileMembersFn,
+		getTeamMemberIdsAttr:                 getTeamMemberIdsFn,
+	}
+}
+
+// getGamificationProfileProxy acts as a singleton to for the internalProxy.  It also ensures
+// that we can still proxy our tests by directly setting internalProxy package variable
+func getGamificationProfileProxy(clientConfig *platformclientv2.Configuration) *gamificationProfileProxy {
+	if internalProxy == nil {
+		internalProxy = newGamificationProfileProxy(clientConfig)
+	}
+	return internalProxy
+}
+
+// createGamificationProfile creates a Genesys Cloud performance profile
+func (p *gamificationProfileProxy) createGamificationProfile(ctx context.Context, profile *platformclientv2.Createperformanceprofile) (*platformclientv2.Performanceprofile, *platformclientv2.APIResponse, error) {
+	return p.createGamificationProfileAttr(ctx, p, profile)
+}
+
+// getAllGamificationProfiles retrieves all Genesys Cloud performance profiles
+func (p *gamificationProfileProxy) getAllGamificationProfiles(ctx context.Context) (*[]platformclientv2.Performanceprofile, *platformclientv2.APIResponse, error) {
+	return p.getAllGamificationProfilesAttr(ctx, p)
+}
+
+// getGamificationProfileIdByName returns a single Genesys Cloud performance profile by a name
+func (p *gamificationProfileProxy) getGamificationProfileIdByName(ctx context.Context, name string) (id string, retryable bool, resp *platformclientv2.APIResponse, err error) {
+	return p.getGamificationProfileIdByNameAttr(ctx, p, name)
+}
+
+// getGamificationProfileById returns a single Genesys Cloud performance profile by Id
+func (p *gamificationProfileProxy) getGamificationProfileById(ctx context.Context, id string) (profile *platformclientv2.Performanceprofile, resp *platformclientv2.APIResponse, err error) {
+	return p.getGamificationProfileByIdAttr(ctx, p, id)
+}
+
+// updateGamificationProfile updates a Genesys Cloud performance profile
+func (p *gamificationProfileProxy) updateGamificationProfile(ctx context.Context, id string, profile *platformclientv2.Performanceprofile) (*platformclientv2.Performanceprofile, *platformclientv2.APIResponse, error) {
+	return p.updateGamificationProfileAttr(ctx, p, id, profile)
+}
+
+// setGamificationProfileActive activates or deactivates a Genesys Cloud performance profile
+func (p *gamificationProfileProxy) setGamificationProfileActive(ctx context.Context, id string, active bool) (*platformclientv2.Performanceprofile, *platformclientv2.APIResponse, error) {
+	return p.setGamificationProfileActiveAttr(ctx, p, id, active)
+}
+
+// deleteGamificationProfile deletes a Genesys Cloud performance profile by Id
+func (p *gamificationProfileProxy) deleteGamificationProfile(ctx context.Context, id string) (resp *platformclientv2.APIResponse, err error) {
+	return p.deleteGamificationProfileAttr(ctx, p, id)
+}
+
+// getGamificationProfileMembers returns the IDs of the users assigned to a performance profile
+func (p *gamificationProfileProxy) getGamificationProfileMembers(ctx context.Context, id string) (memberIds []string, resp *platformclientv2.APIResponse, err error) {
+	return p.getGamificationProfileMembersAttr(ctx, p, id)
+}
+
+// updateGamificationProfileMembers assigns and removes users from a performance profile
+func (p *gamificationProfileProxy) updateGamificationProfileMembers(ctx context.Context, id string, assign platformclientv2.Assignusers) (*platformclientv2.Assignment, *platformclientv2.APIResponse, error) {
+	return p.updateGamificationProfileMembersAttr(ctx, p, id, assign)
+}
+
+// getTeamMemberIds returns the IDs of the users that belong to a team
+func (p *gamificationProfileProxy) getTeamMemberIds(ctx context.Context, teamId string) (memberIds []string, resp *platformclientv2.APIResponse, err error) {
+	return p.getTeamMemberIdsAttr(ctx, p, teamId)
+}
+
+// createGamificationProfileFn is an implementation function for creating a Genesys Cloud performance profile
+func createGamificationProfileFn(ctx context.Context, p *gamificationProfileProxy, profile *platformclientv2.Createperformanceprofile) (*platformclientv2.Performanceprofile, *platformclientv2.APIResponse, error) {
+	// Set resource context for SDK debug logging
+	ctx = provider.EnsureResourceContext(ctx, ResourceType)
+
+	created, resp, err := p.gamificationApi.PostGamificationProfiles(*profile, false)
+	if err != nil {
+		return nil, resp, fmt.Errorf("failed to create performance profile: %s", err)
+	}
+	return created, resp, nil
+}
+
+// getAllGamificationProfilesFn is the implementation for retrieving all performance profiles in Genesys Cloud
+func getAllGamificationProfilesFn(ctx context.Context, p *gamificationProfileProxy) (*[]platformclientv2.Performanceprofile, *platformclientv2.APIResponse, error) {
+	// Set resource context for SDK debug logging
+	ctx = provider.EnsureResourceContext(ctx, ResourceType)
+
+	var allProfiles []platformclientv2.Performanceprofile
+
+	profiles, resp, err := p.gamificationApi.GetGamificationProfiles()
+	if err != nil {
+		return nil, resp, fmt.Errorf("failed to get performance profiles: %v", err)
+	}
+	if profiles.Entities == nil || len(*profiles.Entities) == 0 {
+		return &allProfiles, resp, nil
+	}
+	allProfiles = append(allProfiles, *profiles.Entities...)
+
+	return &allProfiles, resp, nil
+}
+
+// getGamificationProfileIdByNameFn is an implementation of the function to get a Genesys Cloud performance profile by name
+func getGamificationProfileIdByNameFn(ctx context.Context, p *gamificationProfileProxy, name string) (id string, retryable bool, resp *platformclientv2.APIResponse, err error) {
+	// Set resource context for SDK debug logging
+	ctx = provider.EnsureResourceContext(ctx, ResourceType)
+
+	profiles, resp, err := getAllGamificationProfilesFn(ctx, p)
+	if err != nil {
+		return "", false, resp, err
+	}
+
+	for _, profile := range *profiles {
+		if profile.Name != nil && *profile.Name == name {
+			log.Printf("Retrieved the performance profile id %s by name %s", *profile.Id, name)
+			return *profile.Id, false, resp, nil
+		}
+	}
+	return "", true, resp, fmt.Errorf("unable to find performance profile with name %s", name)
+}
+
+// getGamificationProfileByIdFn is an implementation of the function to get a Genesys Cloud performance profile by Id
+func getGamificationProfileByIdFn(ctx context.Context, p *gamificationProfileProxy, id string) (profile *platformclientv2.Performanceprofile, resp *platformclientv2.APIResponse, err error) {
+	// Set resource context for SDK debug logging
+	ctx = provider.EnsureResourceContext(ctx, ResourceType)
+
+	profile, resp, err = p.gamificationApi.GetGamificationProfile(id)
+	if err != nil {
+		return nil, resp, fmt.Errorf("failed to retrieve performance profile by id %s: %s", id, err)
+	}
+	return profile, resp, nil
+}
+
+// updateGamificationProfileFn is an implementation of the function to update a Genesys Cloud performance profile
+func updateGamificationProfileFn(ctx context.Context, p *gamificationProfileProxy, id string, profile *platformclientv2.Performanceprofile) (*platformclientv2.Performanceprofile, *platformclientv2.APIResponse, error) {
+	// Set resource context for SDK debug logging
+	ctx = provider.EnsureResourceContext(ctx, ResourceType)
+
+	updated, resp, err := p.gamificationApi.PutGamificationProfile(id, *profile)
+	if err != nil {
+		return nil, resp, fmt.Errorf("failed to update performance profile: %s", err)
+	}
+	return updated, resp, nil
+}
+
+// setGamificationProfileActiveFn is an implementation of the function to activate or deactivate a Genesys Cloud performance profile
+func setGamificationProfileActiveFn(ctx context.Context, p *gamificationProfileProxy, id string, active bool) (*platformclientv2.Performanceprofile, *platformclientv2.APIResponse, error) {
+	// Set resource context for SDK debug logging
+	ctx = provider.EnsureResourceContext(ctx, ResourceType)
+
+	if active {
+		profile, resp, err := p.gamificationApi.PostGamificationProfileActivate(id)
+		if err != nil {
+			return nil, resp, fmt.Errorf("failed to activate performance profile %s: %s", id, err)
+		}
+		return profile, resp, nil
+	}
+
+	profile, resp, err := p.gamificationApi.PostGamificationProfileDeactivate(id)
+	if err != nil {
+		return nil, resp, fmt.Errorf("failed to deactivate performance profile %s: %s", id, err)
+	}
+	return profile, resp, nil
+}
+
+// deleteGamificationProfileFn is an implementation function for deleting a Genesys Cloud performance profile
+func deleteGamificationProfileFn(ctx context.Context, p *gamificationProfileProxy, id string) (resp *platformclientv2.APIResponse, err error) {
+	// Set resource context for SDK debug logging
+	ctx = provider.EnsureResourceContext(ctx, ResourceType)
+
+	resp, err = p.gamificationApi.DeleteGamificationProfile(id)
+	if err != nil {
+		return resp, fmt.Errorf("failed to delete performance profile: %s", err)
+	}
+	return resp, nil
+}
+
+// getGamificationProfileMembersFn is an implementation function for retrieving the members of a Genesys Cloud performance profile
+func getGamificationProfileMembersFn(ctx context.Context, p *gamificationProfileProxy, id string) ([]string, *platformclientv2.APIResponse, error) {
+	// Set resource context for SDK debug logging
+	ctx = provider.EnsureResourceContext(ctx, ResourceType)
+
+	members, resp, err := p.gamificationApi.GetGamificationProfileMembers(id)
+	if err != nil {
+		return nil, resp, fmt.Errorf("failed to get members of performance profile %s: %s", id, err)
+	}
+
+	memberIds := make([]string, 0)
+	if members.Entities == nil {
+		return memberIds, resp, nil
+	}
+	for _, member := range *members.Entities {
+		if member.Id != nil {
+			memberIds = append(memberIds, *member.Id)
+		}
+	}
+	return memberIds, resp, nil
+}
+
+// updateGamificationProfileMembersFn is an implementation function for assigning users to a Genesys Cloud performance profile
+func updateGamificationProfileMembersFn(ctx context.Context, p *gamificationProfileProxy, id string, assign platformclientv2.Assignusers) (*platformclientv2.Assignment, *platformclientv2.APIResponse, error) {
+	// Set resource context for SDK debug logging
+	ctx = provider.EnsureResourceContext(ctx, ResourceType)
+
+	assignment, resp, err := p.gamificationApi.PostGamificationProfileMembers(id, assign)
+	if err != nil {
+		return nil, resp, fmt.Errorf("failed to update members of performance profile %s: %s", id, err)
+	}
+	return assignment, resp, nil
+}
+
+// getTeamMemberIdsFn is an implementation function for retrieving the members of a Genesys Cloud team
+func getTeamMemberIdsFn(ctx context.Context, p *gamificationProfileProxy, teamId string) ([]string, *platformclientv2.APIResponse, error) {
+	// Set resource context for SDK debug logging
+	ctx = provider.EnsureResourceContext(ctx, ResourceType)
+
+	var (
+		after     string
+		err       error
+		memberIds []string
+		response  *platformclientv2.APIResponse
+	)
+	const pageSize = 100
+
+	for {
+		members, resp, getErr := p.teamsApi.GetTeamMembers(teamId, pageSize, "", after, "")
+		response = resp
+		if getErr != nil {
+			return nil, resp, fmt.Errorf("unable to find members of team %s: %s", teamId, getErr)
+		}
+		if members.Entities == nil || len(*members.Entities) == 0 {
+			break
+		}
+		for _, member := range *members.Entities {
+			memberIds = append(memberIds, *member.Id)
+		}
+		if members.NextUri == nil || *members.NextUri == "" {
+			break
+		}
+
+		after, err = util.GetQueryParamValueFromUri(*members.NextUri, "after")
+		if err != nil {
+			return nil, resp, fmt.Errorf("unable to parse after cursor from team members next uri: %v", err)
+		}
+		if after == "" {
+			break
+		}
+	}
+	return memberIds, response, nil
+}
@@ -0,0 +1,165 @@
+package gamification_profile
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/consistency_checker"
+	"github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/provider"
+	resourceExporter "github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/resource_exporter"
+	"github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/util"
+	"github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/util/constants"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mypurecloud/platform-client-sdk-go/v192/platformclientv2"
+
+	"github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/util/resourcedata"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+)
+
+/*
+The resource_genesyscloud_gamification_profile.go contains all of the methods that perform the core logic for a resource.
+*/
+
+// getAllAuthGamificationProfiles retrieves all of the performance profiles via Terraform in the Genesys Cloud and is used for the exporter
+func getAllAuthGamificationProfiles(ctx context.Context, clientConfig *platformclientv2.Configuration) (resourceExporter.ResourceIDMetaMap, diag.Diagnostics) {
+	proxy := getGamificationProfileProxy(clientConfig)
+	resources := make(resourceExporter.ResourceIDMetaMap)
+
+	profiles, resp, err := proxy.getAllGamificationProfiles(ctx)
+	if err != nil {
+		return nil, util.BuildAPIDiagnosticError(ResourceType, fmt.Sprintf("Failed to get performance profiles error: %s", err), resp)
+	}
+
+	for _, profile := range *profiles {
+		resources[*profile.Id] = &resourceExporter.ResourceMeta{BlockLabel: *profile.Name}
+	}
+	return resources, nil
+}
+
+// createGamificationProfile is used by the gamification_profile resource to create a Genesys cloud performance profile
+func createGamificationProfile(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sdkConfig := meta.(*provider.ProviderMeta).ClientConfig
+	proxy := getGamificationProfileProxy(sdkConfig)
+	profile := getCreateProfileFromResourceData(d)
+
+	log.Printf("Creating performance profile %s", *profile.Name)
+	profileObj, resp, err := proxy.createGamificationProfile(ctx, &profile)
+	if err != nil {
+		return util.BuildAPIDiagnosticError(ResourceType, fmt.Sprintf("Failed to create performance profile %s error: %s", *profile.Name, err), resp)
+	}
+	d.SetId(*profileObj.Id)
+
+	if diagErr := updateProfileMembers(ctx, d, proxy); diagErr != nil {
+		consistency_checker.DeleteConsistencyCheck(d.Id())
+		if readDiags := readGamificationProfile(ctx, d, meta); readDiags != nil {
+			diagErr = append(diagErr, readDiags...)
+		}
+		return diagErr
+	}
+
+	log.Printf("Created performance profile %s", *profileObj.Id)
+	return readGamificationProfile(ctx, d, meta)
+}
+
+// readGamificationProfile is used by the gamification_profile resource to read a performance profile from genesys cloud
+func readGamificationProfile(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sdkConfig := meta.(*provider.ProviderMeta).ClientConfig
+	proxy := getGamificationProfileProxy(sdkConfig)
+	cc := consistency_checker.NewConsistencyCheck(ctx, d, meta, ResourceGamificationProfile(), constants.ConsistencyChecks(), ResourceType)
+
+	log.Printf("Reading performance profile %s", d.Id())
+
+	return util.WithRetriesForRead(ctx, d, func() *retry.RetryError {
+		profile, resp, getErr := proxy.getGamificationProfileById(ctx, d.Id())
+		if getErr != nil {
+			if util.IsStatus404(resp) {
+				return retry.RetryableError(util.BuildWithRetriesApiDiagnosticError(ResourceType, fmt.Sprintf("Failed to read performance profile %s | error: %s", d.Id(), getErr), resp))
+			}
+			return retry.NonRetryableError(util.BuildWithRetriesApiDiagnosticError(ResourceType, fmt.Sprintf("Failed to read performance profile %s | error: %s", d.Id(), getErr), resp))
+		}
+
+		resourcedata.SetNillableValue(d, "name", profile.Name)
+		resourcedata.SetNillableReferenceDivision(d, "division_id", profile.Division)
+		resourcedata.SetNillableValue(d, "description", profile.Description)
+		resourcedata.SetNillableValue(d, "active", profile.Active)
+		resourcedata.SetNillableValue(d, "max_leaderboard_rank_size", profile.MaxLeaderboardRankSize)
+
+		members, teams, diagErr := readProfileMembers(ctx, d, proxy)
+		if diagErr != nil {
+			return retry.NonRetryableError(fmt.Errorf("%v", diagErr))
+		}
+		_ = d.Set("member_ids", members)
+		_ = d.Set("team_ids", teams)
+
+		log.Printf("Read performance profile %s %s", d.Id(), *profile.Name)
+		return cc.CheckState(d)
+	})
+}
+
+// updateGamificationProfile is used by the gamification_profile resource to update a performance profile in Genesys Cloud
+func updateGamificationProfile(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sdkConfig := meta.(*provider.ProviderMeta).ClientConfig
+	proxy := getGamificationProfileProxy(sdkConfig)
+	profile := getProfileFromResourceData(d)
+
+	log.Printf("Updating performance profile %s", *profile.Name)
+	if _, resp, err := proxy.updateGamificationProfile(ctx, d.Id(), &profile); err != nil {
+		return util.BuildAPIDiagnosticError(ResourceType, fmt.Sprintf("Failed to update performance profile %s error: %s", *profile.Name, err), resp)
+	}
+
+	// The profile update does not change its activation state, so that is done separately
+	if d.HasChange("active") {
+		active := d.Get("active").(bool)
+		if _, resp, err := proxy.setGamificationProfileActive(ctx, d.Id(), active); err != nil {
+			return util.BuildAPIDiagnosticError(ResourceType, fmt.Sprintf("Failed to set active=%v on performance profile %s error: %s", active, d.Id(), err), resp)
+		}
+	}
+
+	if diagErr := updateProfileMembers(ctx, d, proxy); diagErr != nil {
+		consistency_checker.DeleteConsistencyCheck(d.Id())
+		if readDiags := readGamificationProfile(ctx, d, meta); readDiags != nil {
+			diagErr = append(diagErr, readDiags...)
+		}
+		return diagErr
+	}
+
+	log.Printf("Updated performance profile %s", d.Id())
+	return readGamificationProfile(ctx, d, meta)
+}
+
+// deleteGamificationProfile is used by the gamification_profile resource to delete a performance profile from Genesys cloud
+func deleteGamificationProfile(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sdkConfig := meta.(*provider.ProviderMeta).ClientConfig
+	proxy := getGamificationProfileProxy(sdkConfig)
+
+	// Active profiles must be deactivated before they can be deleted
+	if d.Get("active").(bool) {
+		log.Printf("Deactivating performance profile %s before deletion", d.Id())
+		if _, resp, err := proxy.setGamificationProfileActive(ctx, d.Id(), false); err != nil && !util.IsStatus404(resp) {
+			return util.BuildAPIDiagnosticError(ResourceType, fmt.Sprintf("Failed to deactivate performance profile %s error: %s", d.Id(), err), resp)
+		}
+	}
+
+	log.Printf("Deleting performance profile %s", d.Id())
+	resp, err := proxy.deleteGamificationProfile(ctx, d.Id())
+	if err != nil {
+		return util.BuildAPIDiagnosticError(ResourceType, fmt.Sprintf("Failed to delete performance profile %s error: %s", d.Id(), err), resp)
+	}
+
+	return util.WithRetries(ctx, 180*time.Second, func() *retry.RetryError {
+		_, resp, err := proxy.getGamificationProfileById(ctx, d.Id())
+		if err != nil {
+			if util.IsStatus404(resp) {
+				log.Printf("Deleted performance profile %s", d.Id())
+				return nil
+			}
+			return retry.NonRetryableError(util.BuildWithRetriesApiDiagnosticError(ResourceType, fmt.Sprintf("Error deleting performance profile %s | error: %s", d.Id(), err), resp))
+		}
+		return retry.RetryableError(util.BuildWithRetriesApiDiagnosticError(ResourceType, fmt.Sprintf("Performance profile %s still exists", d.Id()), resp))
+	})
+}
@@ -0,0 +1,119 @@
+package gamification_profile
+
+// @team: Workforce Engagement Management
+// @description: Gamification performance profiles. Profiles group the metrics agents are measured against and are assigned to users directly or through teams.
+
+import (
+	"github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/provider"
+	resourceExporter "github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/resource_exporter"
+	registrar "github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/resource_register"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+/*
+resource_genesycloud_gamification_profile_schema.go holds four functions within it:
+
+1.  The registration code that registers the Datasource, Resource and Exporter for the package.
+2.  The resource schema definitions for the gamification_profile resource.
+3.  The datasource schema definitions for the gamification_profile datasource.
+4.  The resource exporter configuration for the gamification_profile exporter.
+*/
+const ResourceType = "genesyscloud_gamification_profile"
+
+// SetRegistrar registers all of the resources, datasources and exporters in the package
+func SetRegistrar(regInstance registrar.Registrar) {
+	regInstance.RegisterResource(ResourceType, ResourceGamificationProfile())
+	regInstance.RegisterDataSource(ResourceType, DataSourceGamificationProfile())
+	regInstance.RegisterExporter(ResourceType, GamificationProfileExporter())
+}
+
+// ResourceGamificationProfile registers the genesyscloud_gamification_profile resource with Terraform
+func ResourceGamificationProfile() *schema.Resource {
+	return &schema.Resource{
+		Description: `Genesys Cloud gamification performance profile`,
+
+		CreateContext: provider.CreateWithPooledClient(createGamificationProfile),
+		ReadContext:   provider.ReadWithPooledClient(readGamificationProfile),
+		UpdateContext: provider.UpdateWithPooledClient(updateGamificationProfile),
+		DeleteContext: provider.DeleteWithPooledClient(deleteGamificationProfile),
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		SchemaVersion: 1,
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Description: "The name of the performance profile.",
+				Required:    true,
+				Type:        schema.TypeString,
+			},
+			"division_id": {
+				Description: "The division to which this performance profile belongs.",
+				Required:    true,
+				Type:        schema.TypeString,
+			},
+			"description": {
+				Description: "A description of the performance profile.",
+				Optional:    true,
+				Type:        schema.TypeString,
+			},
+			"active": {
+				Description: "Whether the performance profile is active. Defaults to true.",
+				Optional:    true,
+				Default:     true,
+				Type:        schema.TypeBool,
+			},
+			"max_leaderboard_rank_size": {
+				Description:  "The maximum number of ranks displayed on the leaderboard.",
+				Optional:     true,
+				Computed:     true,
+				Type:         schema.TypeInt,
+				ValidateFunc: validation.IntBetween(0, 100),
+			},
+			"member_ids": {
+				Description: "IDs of users assigned to the performance profile. Users that are members of a team in team_ids should not be listed here. If not set, this resource will not manage individual profile members.",
+				Optional:    true,
+				Computed:    true,
+				Type:        schema.TypeSet,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"team_ids": {
+				Description: "IDs of teams whose members are assigned to the performance profile. Team membership is resolved when the profile is created or updated. A team is removed from state when any of its members is no longer assigned to the profile, so the next apply reassigns them. Profiles do not record which teams they were assigned from, so exported profiles list every member in member_ids.",
+				Optional:    true,
+				Type:        schema.TypeSet,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+// GamificationProfileExporter returns the resourceExporter object used to hold the genesyscloud_gamification_profile exporter's config
+func GamificationProfileExporter() *resourceExporter.ResourceExporter {
+	return &resourceExporter.ResourceExporter{
+		GetResourcesFunc: provider.GetAllWithPooledClient(getAllAuthGamificationProfiles),
+		RefAttrs: map[string]*resourceExporter.RefAttrSettings{
+			"division_id": {RefType: "genesyscloud_auth_division"},
+			"member_ids":  {RefType: "genesyscloud_user"},
+		},
+		AllowZeroValues: []string{"active"},
+	}
+}
+
+// DataSourceGamificationProfile registers the genesyscloud_gamification_profile data source
+func DataSourceGamificationProfile() *schema.Resource {
+	return &schema.Resource{
+		Description: `Genesys Cloud gamification performance profile data source. Select a performance profile by name`,
+		ReadContext: provider.ReadWithPooledClient(dataSourceGamificationProfileRead),
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Description: `Performance profile name`,
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+		},
+	}
+}
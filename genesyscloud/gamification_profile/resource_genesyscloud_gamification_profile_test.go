@@ -0,0 +1,100 @@
+package gamification_profile
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/provider"
+	"github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/team"
+	"github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/util"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/mypurecloud/platform-client-sdk-go/v192/platformclientv2"
+
+	authDivision "github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/auth_division"
+)
+
+/*
+The resource_genesyscloud_gamification_profile_test.go contains all of the test cases for running the resource
+tests for gamification_profile.
+*/
+
+func TestAccResourceGamificationProfile(t *testing.T) {
+	var (
+		resourceLabel = "profile" + uuid.NewString()
+		name1         = "Terraform Profile " + uuid.NewString()
+		description1  = "Test description"
+		name2         = "Terraform Profile " + uuid.NewString()
+		description2  = "A new description"
+
+		divResourceLabel = "test-division"
+		divName          = "terraform-" + uuid.NewString()
+
+		teamResourceLabel = "test-team"
+		teamName          = "terraform-team-" + uuid.NewString()
+
+		fullResourcePath = ResourceType + "." + resourceLabel
+		divisionRef      = "genesyscloud_auth_division." + divResourceLabel + ".id"
+	)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { util.TestAccPreCheck(t) },
+		ProviderFactories: provider.GetProviderFactories(providerResources, providerDataSources),
+		Steps: []resource.TestStep{
+			{
+				// Create
+				Config: authDivision.GenerateAuthDivisionBasic(divResourceLabel, divName) +
+					GenerateGamificationProfileResource(resourceLabel, name1, divisionRef, description1, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fullResourcePath, "name", name1),
+					resource.TestCheckResourceAttr(fullResourcePath, "description", description1),
+					resource.TestCheckResourceAttr(fullResourcePath, "active", util.TrueValue),
+					resource.TestCheckResourceAttrPair(fullResourcePath, "division_id", "genesyscloud_auth_division."+divResourceLabel, "id"),
+				),
+			},
+			{
+				// Update and assign a team
+				Config: authDivision.GenerateAuthDivisionBasic(divResourceLabel, divName) +
+					team.GenerateTeamResource(teamResourceLabel, teamName, divisionRef, "Terraform team") +
+					GenerateGamificationProfileResource(resourceLabel, name2, divisionRef, description2, false,
+						fmt.Sprintf("team_ids = [%s.%s.id]", team.ResourceType, teamResourceLabel)),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fullResourcePath, "name", name2),
+					resource.TestCheckResourceAttr(fullResourcePath, "description", description2),
+					resource.TestCheckResourceAttr(fullResourcePath, "active", util.FalseValue),
+					resource.TestCheckResourceAttrPair(fullResourcePath, "team_ids.0", team.ResourceType+"."+teamResourceLabel, "id"),
+				),
+			},
+			{
+				// Import/Read
+				ResourceName:            fullResourcePath,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"team_ids"},
+			},
+		},
+		CheckDestroy: testVerifyGamificationProfileDestroyed,
+	})
+}
+
+func testVerifyGamificationProfileDestroyed(state *terraform.State) error {
+	gamificationApi := platformclientv2.NewGamificationApi()
+
+	for _, rs := range state.RootModule().Resources {
+		if rs.Type != ResourceType {
+			continue
+		}
+
+		profile, resp, err := gamificationApi.GetGamificationProfile(rs.Primary.ID)
+		if profile != nil {
+			return fmt.Errorf("performance profile (%s) still exists", rs.Primary.ID)
+		}
+		if util.IsStatus404(resp) {
+			continue
+		}
+		return fmt.Errorf("unexpected error: %s", err)
+	}
+	return nil
+}
@@ -0,0 +1,224 @@
+package gamification_profile
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/provider"
+	"github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/util/lists"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mypurecloud/platform-client-sdk-go/v192/platformclientv2"
+	"github.com/stretchr/testify/assert"
+)
+
+/** Unit Test **/
+func TestUnitResourceGamificationProfileRead(t *testing.T) {
+	tId := uuid.NewString()
+	tName := "Unit Test Profile"
+	tDescription := "Unit test performance profile"
+	tDivisionId := uuid.NewString()
+	tTeamId := uuid.NewString()
+	tUserId := uuid.NewString()
+	tTeamUserId := uuid.NewString()
+
+	profileProxy := &gamificationProfileProxy{}
+
+	profileProxy.getGamificationProfileByIdAttr = func(ctx context.Context, p *gamificationProfileProxy, id string) (*platformclientv2.Performanceprofile, *platformclientv2.APIResponse, error) {
+		assert.Equal(t, tId, id)
+		profile := &platformclientv2.Performanceprofile{
+			Id:          &tId,
+			Name:        &tName,
+			Description: &tDescription,
+			Division:    &platformclientv2.Division{Id: &tDivisionId},
+			Active:      platformclientv2.Bool(true),
+		}
+		return profile, &platformclientv2.APIResponse{StatusCode: http.StatusOK}, nil
+	}
+	profileProxy.getGamificationProfileMembersAttr = func(ctx context.Context, p *gamificationProfileProxy, id string) ([]string, *platformclientv2.APIResponse, error) {
+		return []string{tUserId, tTeamUserId}, &platformclientv2.APIResponse{StatusCode: http.StatusOK}, nil
+	}
+	profileProxy.getTeamMemberIdsAttr = func(ctx context.Context, p *gamificationProfileProxy, teamId string) ([]string, *platformclientv2.APIResponse, error) {
+		assert.Equal(t, tTeamId, teamId)
+		return []string{tTeamUserId}, &platformclientv2.APIResponse{StatusCode: http.StatusOK}, nil
+	}
+
+	internalProxy = profileProxy
+	defer func() { internalProxy = nil }()
+
+	ctx := context.Background()
+	gc := &provider.ProviderMeta{ClientConfig: &platformclientv2.Configuration{}}
+
+	resourceDataMap := buildGamificationProfileResourceMap(tId, tName, tDescription, tDivisionId)
+	resourceDataMap["team_ids"] = []interface{}{tTeamId}
+
+	d := schema.TestResourceDataRaw(t, ResourceGamificationProfile().Schema, resourceDataMap)
+	d.SetId(tId)
+
+	diag := readGamificationProfile(ctx, d, gc)
+	assert.Equal(t, false, diag.HasError())
+	assert.Equal(t, tId, d.Id())
+	assert.Equal(t, tName, d.Get("name").(string))
+	assert.Equal(t, tDescription, d.Get("description").(string))
+	assert.Equal(t, tDivisionId, d.Get("division_id").(string))
+	assert.Equal(t, true, d.Get("active").(bool))
+	// Members assigned through a team are not reported in member_ids
+	assert.Equal(t, []string{tUserId}, *lists.SetToStringList(d.Get("member_ids").(*schema.Set)))
+	assert.Equal(t, []string{tTeamId}, *lists.SetToStringList(d.Get("team_ids").(*schema.Set)))
+}
+
+func TestUnitResourceGamificationProfileReadTeamDrift(t *testing.T) {
+	tId := uuid.NewString()
+	tName := "Unit Test Profile"
+	tDescription := "Unit test performance profile"
+	tDivisionId := uuid.NewString()
+	tTeamId := uuid.NewString()
+	tTeamUserId := uuid.NewString()
+	tUnassignedTeamUserId := uuid.NewString()
+
+	profileProxy := &gamificationProfileProxy{}
+
+	profileProxy.getGamificationProfileByIdAttr = func(ctx context.Context, p *gamificationProfileProxy, id string) (*platformclientv2.Performanceprofile, *platformclientv2.APIResponse, error) {
+		profile := &platformclientv2.Performanceprofile{
+			Id:          &tId,
+			Name:        &tName,
+			Description: &tDescription,
+			Division:    &platformclientv2.Division{Id: &tDivisionId},
+			Active:      platformclientv2.Bool(true),
+		}
+		return profile, &platformclientv2.APIResponse{StatusCode: http.StatusOK}, nil
+	}
+	profileProxy.getGamificationProfileMembersAttr = func(ctx context.Context, p *gamificationProfileProxy, id string) ([]string, *platformclientv2.APIResponse, error) {
+		return []string{tTeamUserId}, &platformclientv2.APIResponse{StatusCode: http.StatusOK}, nil
+	}
+	profileProxy.getTeamMemberIdsAttr = func(ctx context.Context, p *gamificationProfileProxy, teamId string) ([]string, *platformclientv2.APIResponse, error) {
+		return []string{tTeamUserId, tUnassignedTeamUserId}, &platformclientv2.APIResponse{StatusCode: http.StatusOK}, nil
+	}
+
+	internalProxy = profileProxy
+	defer func() { internalProxy = nil }()
+
+	ctx := context.Background()
+	gc := &provider.ProviderMeta{ClientConfig: &platformclientv2.Configuration{}}
+
+	resourceDataMap := buildGamificationProfileResourceMap(tId, tName, tDescription, tDivisionId)
+	resourceDataMap["team_ids"] = []interface{}{tTeamId}
+
+	d := schema.TestResourceDataRaw(t, ResourceGamificationProfile().Schema, resourceDataMap)
+	d.SetId(tId)
+
+	diag := readGamificationProfile(ctx, d, gc)
+	assert.Equal(t, false, diag.HasError())
+	// A team with a member that is no longer assigned is dropped so the next plan reassigns it
+	assert.Empty(t, *lists.SetToStringList(d.Get("team_ids").(*schema.Set)))
+	assert.Equal(t, []string{tTeamUserId}, *lists.SetToStringList(d.Get("member_ids").(*schema.Set)))
+}
+
+func TestUnitResourceGamificationProfileCreateAssignsTeamMembers(t *testing.T) {
+	tId := uuid.NewString()
+	tName := "Unit Test Profile"
+	tDescription := "Unit test performance profile"
+	tDivisionId := uuid.NewString()
+	tTeamId := uuid.NewString()
+	tUserId := uuid.NewString()
+	tTeamUserId := uuid.NewString()
+
+	var assignedMembers []string
+	profileProxy := &gamificationProfileProxy{}
+
+	profileProxy.createGamificationProfileAttr = func(ctx context.Context, p *gamificationProfileProxy, profile *platformclientv2.Createperformanceprofile) (*platformclientv2.Performanceprofile, *platformclientv2.APIResponse, error) {
+		assert.Equal(t, tName, *profile.Name)
+		assert.Equal(t, tDivisionId, *profile.Division.Id)
+		return &platformclientv2.Performanceprofile{Id: &tId, Name: &tName}, &platformclientv2.APIResponse{StatusCode: http.StatusOK}, nil
+	}
+	profileProxy.getGamificationProfileByIdAttr = func(ctx context.Context, p *gamificationProfileProxy, id string) (*platformclientv2.Performanceprofile, *platformclientv2.APIResponse, error) {
+		profile := &platformclientv2.Performanceprofile{
+			Id:          &tId,
+			Name:        &tName,
+			Description: &tDescription,
+			Division:    &platformclientv2.Division{Id: &tDivisionId},
+			Active:      platformclientv2.Bool(true),
+		}
+		return profile, &platformclientv2.APIResponse{StatusCode: http.StatusOK}, nil
+	}
+	profileProxy.getGamificationProfileMembersAttr = func(ctx context.Context, p *gamificationProfileProxy, id string) ([]string, *platformclientv2.APIResponse, error) {
+		return assignedMembers, &platformclientv2.APIResponse{StatusCode: http.StatusOK}, nil
+	}
+	profileProxy.updateGamificationProfileMembersAttr = func(ctx context.Context, p *gamificationProfileProxy, id string, assign platformclientv2.Assignusers) (*platformclientv2.Assignment, *platformclientv2.APIResponse, error) {
+		assert.Equal(t, tId, id)
+		assignedMembers = append(assignedMembers, *assign.MembersToAssign...)
+		return &platformclientv2.Assignment{}, &platformclientv2.APIResponse{StatusCode: http.StatusOK}, nil
+	}
+	profileProxy.getTeamMemberIdsAttr = func(ctx context.Context, p *gamificationProfileProxy, teamId string) ([]string, *platformclientv2.APIResponse, error) {
+		return []string{tTeamUserId}, &platformclientv2.APIResponse{StatusCode: http.StatusOK}, nil
+	}
+
+	internalProxy = profileProxy
+	defer func() { internalProxy = nil }()
+
+	ctx := context.Background()
+	gc := &provider.ProviderMeta{ClientConfig: &platformclientv2.Configuration{}}
+
+	resourceDataMap := buildGamificationProfileResourceMap(tId, tName, tDescription, tDivisionId)
+	resourceDataMap["member_ids"] = []interface{}{tUserId}
+	resourceDataMap["team_ids"] = []interface{}{tTeamId}
+
+	d := schema.TestResourceDataRaw(t, ResourceGamificationProfile().Schema, resourceDataMap)
+
+	diag := createGamificationProfile(ctx, d, gc)
+	assert.Equal(t, false, diag.HasError())
+	assert.Equal(t, tId, d.Id())
+	assert.ElementsMatch(t, []string{tUserId, tTeamUserId}, assignedMembers)
+	assert.Equal(t, []string{tUserId}, *lists.SetToStringList(d.Get("member_ids").(*schema.Set)))
+}
+
+func TestUnitResourceGamificationProfileDelete(t *testing.T) {
+	tId := uuid.NewString()
+	tName := "Unit Test Profile"
+	tDescription := "Unit test performance profile"
+	tDivisionId := uuid.NewString()
+
+	deactivated := false
+	profileProxy := &gamificationProfileProxy{}
+
+	profileProxy.setGamificationProfileActiveAttr = func(ctx context.Context, p *gamificationProfileProxy, id string, active bool) (*platformclientv2.Performanceprofile, *platformclientv2.APIResponse, error) {
+		assert.Equal(t, tId, id)
+		assert.Equal(t, false, active)
+		deactivated = true
+		return &platformclientv2.Performanceprofile{Id: &tId}, &platformclientv2.APIResponse{StatusCode: http.StatusOK}, nil
+	}
+	profileProxy.deleteGamificationProfileAttr = func(ctx context.Context, p *gamificationProfileProxy, id string) (*platformclientv2.APIResponse, error) {
+		assert.Equal(t, tId, id)
+		assert.Equal(t, true, deactivated, "profile should be deactivated before it is deleted")
+		return &platformclientv2.APIResponse{StatusCode: http.StatusNoContent}, nil
+	}
+	profileProxy.getGamificationProfileByIdAttr = func(ctx context.Context, p *gamificationProfileProxy, id string) (*platformclientv2.Performanceprofile, *platformclientv2.APIResponse, error) {
+		return nil, &platformclientv2.APIResponse{StatusCode: http.StatusNotFound}, fmt.Errorf("not found")
+	}
+
+	internalProxy = profileProxy
+	defer func() { internalProxy = nil }()
+
+	ctx := context.Background()
+	gc := &provider.ProviderMeta{ClientConfig: &platformclientv2.Configuration{}}
+
+	d := schema.TestResourceDataRaw(t, ResourceGamificationProfile().Schema, buildGamificationProfileResourceMap(tId, tName, tDescription, tDivisionId))
+	d.SetId(tId)
+
+	diag := deleteGamificationProfile(ctx, d, gc)
+	assert.Equal(t, false, diag.HasError())
+	assert.Equal(t, true, deactivated)
+}
+
+func buildGamificationProfileResourceMap(tId string, tName string, tDescription string, tDivisionId string) map[string]interface{} {
+	return map[string]interface{}{
+		"id":          tId,
+		"name":        tName,
+		"description": tDescription,
+		"division_id": tDivisionId,
+		"active":      true,
+	}
+}
@@ -0,0 +1,165 @@
+package gamification_profile
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/util"
+	"github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/util/chunks"
+	"github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/util/lists"
+
+	"github.com/mypurecloud/platform-client-sdk-go/v192/platformclientv2"
+)
+
+// maxMembersPerRequest is the number of users assigned or removed in a single profile membership request
+const maxMembersPerRequest = 50
+
+// getCreateProfileFromResourceData maps data from schema ResourceData object to a platformclientv2.Createperformanceprofile
+func getCreateProfileFromResourceData(d *schema.ResourceData) platformclientv2.Createperformanceprofile {
+	profile := platformclientv2.Createperformanceprofile{
+		Name:        platformclientv2.String(d.Get("name").(string)),
+		Division:    &platformclientv2.Division{Id: platformclientv2.String(d.Get("division_id").(string))},
+		Description: platformclientv2.String(d.Get("description").(string)),
+		Active:      platformclientv2.Bool(d.Get("active").(bool)),
+	}
+	if rankSize, ok := d.GetOk("max_leaderboard_rank_size"); ok {
+		profile.MaxLeaderboardRankSize = platformclientv2.Int(rankSize.(int))
+	}
+	return profile
+}
+
+// getProfileFromResourceData maps data from schema ResourceData object to a platformclientv2.Performanceprofile
+func getProfileFromResourceData(d *schema.ResourceData) platformclientv2.Performanceprofile {
+	profile := platformclientv2.Performanceprofile{
+		Name:        platformclientv2.String(d.Get("name").(string)),
+		Division:    &platformclientv2.Division{Id: platformclientv2.String(d.Get("division_id").(string))},
+		Description: platformclientv2.String(d.Get("description").(string)),
+		Active:      platformclientv2.Bool(d.Get("active").(bool)),
+	}
+	if rankSize, ok := d.GetOk("max_leaderboard_rank_size"); ok {
+		profile.MaxLeaderboardRankSize = platformclientv2.Int(rankSize.(int))
+	}
+	return profile
+}
+
+// getTeamMemberIdsForProfile resolves the users belonging to every team in team_ids
+func getTeamMemberIdsForProfile(ctx context.Context, proxy *gamificationProfileProxy, teamIds []string) ([]string, diag.Diagnostics) {
+	var memberIds []string
+	for _, teamId := range teamIds {
+		ids, resp, err := proxy.getTeamMemberIds(ctx, teamId)
+		if err != nil {
+			return nil, util.BuildAPIDiagnosticError(ResourceType, fmt.Sprintf("Failed to read members of team %s: %s", teamId, err), resp)
+		}
+		for _, id := range ids {
+			if !lists.ItemInSlice(id, memberIds) {
+				memberIds = append(memberIds, id)
+			}
+		}
+	}
+	return memberIds, nil
+}
+
+// updateProfileMembers reconciles the profile membership with the users in member_ids and the members of the teams in team_ids
+func updateProfileMembers(ctx context.Context, d *schema.ResourceData, proxy *gamificationProfileProxy) diag.Diagnostics {
+	if !d.IsNewResource() && !d.HasChanges("member_ids", "team_ids") {
+		return nil
+	}
+
+	configMemberIds := *lists.SetToStringList(d.Get("member_ids").(*schema.Set))
+	teamMemberIds, diagErr := getTeamMemberIdsForProfile(ctx, proxy, *lists.SetToStringList(d.Get("team_ids").(*schema.Set)))
+	if diagErr != nil {
+		return diagErr
+	}
+
+	desiredMemberIds := configMemberIds
+	for _, id := range teamMemberIds {
+		if !lists.ItemInSlice(id, desiredMemberIds) {
+			desiredMemberIds = append(desiredMemberIds, id)
+		}
+	}
+
+	existingMemberIds, resp, err := proxy.getGamificationProfileMembers(ctx, d.Id())
+	if err != nil {
+		return util.BuildAPIDiagnosticError(ResourceType, fmt.Sprintf("Failed to read members of performance profile %s: %s", d.Id(), err), resp)
+	}
+
+	membersToAdd := lists.SliceDifference(desiredMemberIds, existingMemberIds)
+	membersToRemove := lists.SliceDifference(existingMemberIds, desiredMemberIds)
+	log.Printf("Updating performance profile %s members: %d to assign, %d to remove", d.Id(), len(membersToAdd), len(membersToRemove))
+
+	chunkProcessor := func(assign platformclientv2.Assignusers) diag.Diagnostics {
+		assignment, resp, err := proxy.updateGamificationProfileMembers(ctx, d.Id(), assign)
+		if err != nil {
+			return util.BuildAPIDiagnosticError(ResourceType, fmt.Sprintf("Failed to update members of performance profile %s: %s", d.Id(), err), resp)
+		}
+		if assignment != nil && assignment.AssignmentErrors != nil && len(*assignment.AssignmentErrors) > 0 {
+			failureReasons := make([]string, 0, len(*assignment.AssignmentErrors))
+			for _, assignmentError := range *assignment.AssignmentErrors {
+				if assignmentError.User != nil && assignmentError.User.Id != nil && assignmentError.Message != nil {
+					failureReasons = append(failureReasons, fmt.Sprintf("Member %s: %s", *assignmentError.User.Id, *assignmentError.Message))
+				}
+			}
+			return util.BuildDiagnosticError(ResourceType,
+				fmt.Sprintf("Failed to update some members of performance profile %s", d.Id()),
+				fmt.Errorf("failed to assign the following members: %v", failureReasons))
+		}
+		return nil
+	}
+
+	var assignments []platformclientv2.Assignusers
+	for _, chunk := range chunks.ChunkBy(membersToRemove, maxMembersPerRequest) {
+		assignments = append(assignments, platformclientv2.Assignusers{MembersToAssign: &[]string{}, MembersToRemove: &chunk})
+	}
+	for _, chunk := range chunks.ChunkBy(membersToAdd, maxMembersPerRequest) {
+		assignments = append(assignments, platformclientv2.Assignusers{MembersToAssign: &chunk, MembersToRemove: &[]string{}})
+	}
+	return chunks.ProcessChunks(assignments, chunkProcessor)
+}
+
+// readProfileMembers reads the profile members and splits them into the teams in team_ids whose members are all assigned
+// to the profile and the remaining individual members. A team with unassigned members is left out of the returned team IDs
+// so the next plan shows the drift and the update reassigns its members.
+func readProfileMembers(ctx context.Context, d *schema.ResourceData, proxy *gamificationProfileProxy) (memberIds *schema.Set, teamIds *schema.Set, diags diag.Diagnostics) {
+	profileMemberIds, resp, err := proxy.getGamificationProfileMembers(ctx, d.Id())
+	if err != nil {
+		return nil, nil, util.BuildAPIDiagnosticError(ResourceType, fmt.Sprintf("Failed to read members of performance profile %s: %s", d.Id(), err), resp)
+	}
+
+	var assignedTeamIds, teamMemberIds []string
+	for _, teamId := range *lists.SetToStringList(d.Get("team_ids").(*schema.Set)) {
+		ids, resp, err := proxy.getTeamMemberIds(ctx, teamId)
+		if err != nil {
+			return nil, nil, util.BuildAPIDiagnosticError(ResourceType, fmt.Sprintf("Failed to read members of team %s: %s", teamId, err), resp)
+		}
+		if unassigned := lists.SliceDifference(ids, profileMemberIds); len(unassigned) > 0 {
+			log.Printf("%d members of team %s are not assigned to performance profile %s", len(unassigned), teamId, d.Id())
+			continue
+		}
+		assignedTeamIds = append(assignedTeamIds, teamId)
+		teamMemberIds = append(teamMemberIds, ids...)
+	}
+
+	return lists.StringListToSet(lists.SliceDifference(profileMemberIds, teamMemberIds)), lists.StringListToSet(assignedTeamIds), nil
+}
+
+func GenerateGamificationProfileResource(
+	resourceLabel string,
+	name string,
+	divisionId string,
+	description string,
+	active bool,
+	extraAttrs ...string) string {
+	return fmt.Sprintf(`resource "%s" "%s" {
+		name        = "%s"
+		division_id = %s
+		description = "%s"
+		active      = %v
+		%s
+	}
+	`, ResourceType, resourceLabel, name, divisionId, description, active, strings.Join(extraAttrs, "\n"))
+}
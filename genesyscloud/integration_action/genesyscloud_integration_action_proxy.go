@@ -69,6 +69,7 @@ type getIntegrationActionFunctionFunc func(ctx context.Context, p *integrationAc
 type updateIntegrationActionDraftWithFunctionFunc func(ctx context.Context, p *integrationActionsProxy, actionId string, updateData *platformclientv2.Function) (*platformclientv2.Functionconfig, *platformclientv2.APIResponse, error)
 
 type publishIntegrationActionDraftFunc func(ctx context.Context, p *integrationActionsProxy, actionId string, version int) (*platformclientv2.APIResponse, error)
+type testIntegrationActionFunc func(ctx context.Context, p *integrationActionsProxy, actionId string, input map[string]any) (*actionTestResult, *platformclientv2.APIResponse, error)
 
 // integrationActionsProxy contains all of the methods that call genesys cloud APIs.
 type integrationActionsProxy struct {
@@ -90,6 +91,7 @@ type integrationActionsProxy struct {
 	getIntegrationActionFunctionAttr             getIntegrationActionFunctionFunc
 	updateIntegrationActionDraftWithFunctionAttr updateIntegrationActionDraftWithFunctionFunc
 	publishIntegrationActionDraftAttr            publishIntegrationActionDraftFunc
+	testIntegrationActionAttr                    testIntegrationActionFunc
 }
 
 // newIntegrationActionsProxy initializes the integrationActionsProxy with all of the data needed to communicate with Genesys Cloud
@@ -114,6 +116,7 @@ func newIntegrationActionsProxy(clientConfig *platformclientv2.Configuration) *i
 		updateIntegrationActionAttr:                  updateIntegrationActionFn,
 		deleteIntegrationActionAttr:                  deleteIntegrationActionFn,
 		getIntegrationActionTemplateAttr:             getIntegrationActionTemplateFn,
+		testIntegrationActionAttr:                    testIntegrationActionFn,
 	}
 }
 
@@ -203,6 +206,11 @@ func (p *integrationActionsProxy) getIntegrationActionTemplate(ctx context.Conte
 	return p.getIntegrationActionTemplateAttr(ctx, p, actionId, fileName)
 }
 
+// testIntegrationAction executes a Genesys Cloud Integration Action with sample input through the test endpoint
+func (p *integrationActionsProxy) testIntegrationAction(ctx context.Context, actionId string, input map[string]any) (*actionTestResult, *platformclientv2.APIResponse, error) {
+	return p.testIntegrationActionAttr(ctx, p, actionId, input)
+}
+
 // getAllIntegrationActionsFn is the implementation for retrieving all integration actions in Genesys Cloud
 func getAllIntegrationActionsFn(ctx context.Context, p *integrationActionsProxy) (*[]platformclientv2.Action, *platformclientv2.APIResponse, error) {
 	// Set resource context for SDK debug logging
@@ -604,6 +612,14 @@ func getIntegrationActionTemplateFn(ctx context.Context, p *integrationActionsPr
 	return template, resp, nil
 }
 
+// testIntegrationActionFn is the implementation for testing an integration action in Genesys Cloud
+func testIntegrationActionFn(ctx context.Context, p *integrationActionsProxy, actionId string, input map[string]any) (*actionTestResult, *platformclientv2.APIResponse, error) {
+	// Set resource context for SDK debug logging
+	ctx = provider.EnsureResourceContext(ctx, ResourceType)
+
+	return customapi.Do[actionTestResult](ctx, p.customApiClient, customapi.MethodPost, "/api/v2/integrations/actions/"+actionId+"/test", input, nil)
+}
+
 // sdkPostIntegrationAction is the non-sdk helper method for creating an Integration Action
 func sdkPostIntegrationAction(ctx context.Context, body *IntegrationAction, api *platformclientv2.IntegrationsApi) (*IntegrationAction, *platformclientv2.APIResponse, error) {
 	ctx = provider.EnsureResourceContext(ctx, ResourceType)
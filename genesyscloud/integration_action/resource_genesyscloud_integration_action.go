@@ -184,6 +184,9 @@ func createIntegrationAction(ctx context.Context, d *schema.ResourceData, meta i
 		return diagErr
 	}
 
+	if diagErr := runIntegrationActionTest(ctx, d, iap); diagErr != nil {
+		return diagErr
+	}
 	return readIntegrationAction(ctx, d, meta)
 }
 
@@ -205,17 +208,12 @@ func updateFunctionDataActionDraft(ctx context.Context, d *schema.ResourceData,
 	version := 1
 	zipid := ""
 
-	// Get file_path from function_config
-	var filePath string
-	if functionConfig := d.Get("function_config"); functionConfig != nil {
-		if configList := functionConfig.([]interface{}); len(configList) > 0 {
-			if configMap := configList[0].(map[string]interface{}); configMap != nil {
-				if filePathVal, exists := configMap["file_path"]; exists {
-					filePath = filePathVal.(string)
-				}
-			}
-		}
+	// Get the function zip from function_config
+	filePath, cleanup, err := getFunctionCodeFile(d)
+	if err != nil {
+		return diag.FromErr(err)
 	}
+	defer cleanup()
 
 	log.Printf("Updating integration action Function%s", name)
 
@@ -333,6 +331,12 @@ func updateFunctionDataActionDraft(ctx context.Context, d *schema.ResourceData,
 		return diagErr
 	}
 
+	if err := setFunctionSourceHash(d); err != nil {
+		return diag.FromErr(err)
+	}
+	if diagErr := runIntegrationActionTest(ctx, d, iap); diagErr != nil {
+		return diagErr
+	}
 	return readIntegrationActionFunction(ctx, d, meta)
 }
 
@@ -345,23 +349,12 @@ func createFunctionDataActionDraft(ctx context.Context, d *schema.ResourceData,
 	integrationId := d.Get("integration_id").(string)
 	secure := d.Get("secure").(bool)
 
-	// Get file_path from function_config
-	var filePath string
-	if functionConfig := d.Get("function_config"); functionConfig != nil {
-		if configList := functionConfig.([]interface{}); len(configList) > 0 {
-			if configMap := configList[0].(map[string]interface{}); configMap != nil {
-				if filePathVal, exists := configMap["file_path"]; exists {
-					filePath = filePathVal.(string)
-					log.Printf("DEBUG: file_path extracted from function_config: %s", filePath)
-				}
-			}
-		}
-	}
-
-	if filePath == "" {
-		log.Printf("DEBUG: file_path is empty, skipping function upload")
-		return diag.Errorf("file_path is required in function_config for function data actions")
+	// Get the function zip from function_config
+	filePath, cleanup, err := getFunctionCodeFile(d)
+	if err != nil {
+		return diag.FromErr(err)
 	}
+	defer cleanup()
 
 	actionContract, diagErr := BuildSdkActionContract(d)
 	if diagErr != nil {
@@ -483,6 +476,12 @@ func createFunctionDataActionDraft(ctx context.Context, d *schema.ResourceData,
 		return diagErr
 	}
 
+	if err := setFunctionSourceHash(d); err != nil {
+		return diag.FromErr(err)
+	}
+	if diagErr := runIntegrationActionTest(ctx, d, iap); diagErr != nil {
+		return diagErr
+	}
 	return readIntegrationAction(ctx, d, meta)
 }
 
@@ -604,7 +603,7 @@ func readIntegrationActionFunction(ctx context.Context, d *schema.ResourceData,
 
 		if functionData != nil {
 			action.Config.Request.RequestTemplate = reqTemp
-			_ = d.Set("function_config", FlattenFunctionConfigRequest(*functionData))
+			_ = d.Set("function_config", flattenFunctionConfig(d, *functionData))
 		} else {
 			_ = d.Set("function_config", nil)
 		}
@@ -709,7 +708,7 @@ func readIntegrationAction(ctx context.Context, d *schema.ResourceData, meta int
 
 			if functionData != nil {
 				action.Config.Request.RequestTemplate = reqTemp
-				_ = d.Set("function_config", FlattenFunctionConfigRequest(*functionData))
+				_ = d.Set("function_config", flattenFunctionConfig(d, *functionData))
 			} else {
 				_ = d.Set("function_config", nil)
 			}
@@ -761,6 +760,9 @@ func updateIntegrationAction(ctx context.Context, d *schema.ResourceData, meta i
 	}
 
 	log.Printf("Updated integration action %s", name)
+	if diagErr := runIntegrationActionTest(ctx, d, iap); diagErr != nil {
+		return diagErr
+	}
 	return readIntegrationAction(ctx, d, meta)
 }
 
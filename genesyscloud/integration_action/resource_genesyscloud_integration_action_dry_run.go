@@ -0,0 +1,218 @@
+package integration_action
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/util"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+/*
+The resource_genesyscloud_integration_action_dry_run.go file runs the optional test block of an integration action.
+After create and update the action is executed through the Genesys Cloud test endpoint with the sample input, and the
+apply fails with the action's execution trace if the outcome does not match the expected output.
+*/
+
+// actionTestResult is the response of the integration action test endpoint
+type actionTestResult struct {
+	Operations  []actionTestOperation `json:"operations"`
+	Error       *actionTestError      `json:"error,omitempty"`
+	FinalResult any                   `json:"finalResult,omitempty"`
+	Success     bool                  `json:"success"`
+}
+
+// actionTestOperation is a single step of an action test execution, e.g. resolving the request URL or applying the
+// translation map
+type actionTestOperation struct {
+	Step    int              `json:"step"`
+	Name    string           `json:"name"`
+	Success bool             `json:"success"`
+	Result  any              `json:"result,omitempty"`
+	Error   *actionTestError `json:"error,omitempty"`
+}
+
+type actionTestError struct {
+	Message string `json:"message"`
+	Code    string `json:"code"`
+	Status  int    `json:"status"`
+}
+
+// actionTestConfig is the test block of the resource
+type actionTestConfig struct {
+	Input          map[string]any
+	ExpectedOutput any
+	ExpectSuccess  bool
+}
+
+// getActionTestConfig reads the test block of the resource. It returns nil if the block is not set.
+func getActionTestConfig(d *schema.ResourceData) (*actionTestConfig, error) {
+	testList, _ := d.Get("test").([]interface{})
+	if len(testList) == 0 || testList[0] == nil {
+		return nil, nil
+	}
+	testMap := testList[0].(map[string]interface{})
+
+	config := &actionTestConfig{
+		Input:         make(map[string]any),
+		ExpectSuccess: testMap["expect_success"].(bool),
+	}
+	if input, _ := testMap["input"].(string); input != "" {
+		if err := json.Unmarshal([]byte(input), &config.Input); err != nil {
+			return nil, fmt.Errorf("test input must be a JSON object: %w", err)
+		}
+	}
+	if expectedOutput, _ := testMap["expected_output"].(string); expectedOutput != "" {
+		if err := json.Unmarshal([]byte(expectedOutput), &config.ExpectedOutput); err != nil {
+			return nil, fmt.Errorf("test expected_output must be valid JSON: %w", err)
+		}
+	}
+	return config, nil
+}
+
+// runIntegrationActionTest executes the test block of the resource, if any, against the action
+func runIntegrationActionTest(ctx context.Context, d *schema.ResourceData, iap *integrationActionsProxy) diag.Diagnostics {
+	config, err := getActionTestConfig(d)
+	if err != nil {
+		return util.BuildDiagnosticError(ResourceType, fmt.Sprintf("Invalid test block for integration action %s", d.Id()), err)
+	}
+	if config == nil {
+		return nil
+	}
+
+	log.Printf("Testing integration action %s", d.Id())
+	result, resp, err := iap.testIntegrationAction(ctx, d.Id(), config.Input)
+	if err != nil {
+		return util.BuildAPIDiagnosticError(ResourceType, fmt.Sprintf("Failed to test integration action %s error: %s", d.Id(), err), resp)
+	}
+
+	if failures := checkActionTestResult(config, result); len(failures) > 0 {
+		// The trace is kept as plain text rather than the usual JSON detail so that it stays readable in the output
+		return diag.Diagnostics{{
+			Severity: diag.Error,
+			Summary:  fmt.Sprintf("Test of integration action %s failed", d.Id()),
+			Detail:   fmt.Sprintf("%s\n\nExecution trace:\n%s", strings.Join(failures, "\n"), formatActionTestTrace(result)),
+		}}
+	}
+	log.Printf("Test of integration action %s passed", d.Id())
+	return nil
+}
+
+// checkActionTestResult returns a description of every assertion of the test block that the result does not meet
+func checkActionTestResult(config *actionTestConfig, result *actionTestResult) []string {
+	if result.Success != config.ExpectSuccess {
+		if config.ExpectSuccess {
+			message := "the action failed"
+			if result.Error != nil && result.Error.Message != "" {
+				message += ": " + result.Error.Message
+			}
+			return []string{message}
+		}
+		return []string{"the action succeeded but expect_success is false"}
+	}
+	if !result.Success || config.ExpectedOutput == nil {
+		return nil
+	}
+	return matchExpectedOutput(config.ExpectedOutput, result.FinalResult, "")
+}
+
+// matchExpectedOutput compares the output of an action to the expected output. Objects are matched partially: only
+// the keys of the expected object are compared, so that assertions can ignore fields they do not care about. Arrays
+// must have the same length and match element by element.
+func matchExpectedOutput(expected any, actual any, path string) []string {
+	switch expectedValue := expected.(type) {
+	case map[string]any:
+		actualMap, ok := actual.(map[string]any)
+		if !ok {
+			return []string{fmt.Sprintf("%s: expected an object, got %s", outputPath(path), formatOutputValue(actual))}
+		}
+		keys := make([]string, 0, len(expectedValue))
+		for key := range expectedValue {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+
+		var failures []string
+		for _, key := range keys {
+			childPath := joinOutputPath(path, key)
+			actualChild, exists := actualMap[key]
+			if !exists {
+				failures = append(failures, fmt.Sprintf("%s: expected %s, but the field is missing", childPath, formatOutputValue(expectedValue[key])))
+				continue
+			}
+			failures = append(failures, matchExpectedOutput(expectedValue[key], actualChild, childPath)...)
+		}
+		return failures
+	case []any:
+		actualList, ok := actual.([]any)
+		if !ok {
+			return []string{fmt.Sprintf("%s: expected an array, got %s", outputPath(path), formatOutputValue(actual))}
+		}
+		if len(actualList) != len(expectedValue) {
+			return []string{fmt.Sprintf("%s: expected %d items, got %d", outputPath(path), len(expectedValue), len(actualList))}
+		}
+		var failures []string
+		for i := range expectedValue {
+			failures = append(failures, matchExpectedOutput(expectedValue[i], actualList[i], fmt.Sprintf("%s[%d]", path, i))...)
+		}
+		return failures
+	default:
+		if !reflect.DeepEqual(expected, actual) {
+			return []string{fmt.Sprintf("%s: expected %s, got %s", outputPath(path), formatOutputValue(expected), formatOutputValue(actual))}
+		}
+		return nil
+	}
+}
+
+func joinOutputPath(path string, key string) string {
+	if path == "" {
+		return key
+	}
+	return path + "." + key
+}
+
+func outputPath(path string) string {
+	if path == "" {
+		return "output"
+	}
+	return path
+}
+
+func formatOutputValue(value any) string {
+	encoded, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprintf("%v", value)
+	}
+	return string(encoded)
+}
+
+// formatActionTestTrace describes each step of a test execution so that a failing translation map or success template
+// can be found without running the test again in the UI
+func formatActionTestTrace(result *actionTestResult) string {
+	var trace strings.Builder
+	for _, operation := range result.Operations {
+		status := "ok"
+		if !operation.Success {
+			status = "failed"
+		}
+		fmt.Fprintf(&trace, "  %d. %s: %s", operation.Step, operation.Name, status)
+		if operation.Error != nil && operation.Error.Message != "" {
+			fmt.Fprintf(&trace, " - %s", operation.Error.Message)
+		}
+		trace.WriteString("\n")
+	}
+	if result.Error != nil && result.Error.Message != "" {
+		fmt.Fprintf(&trace, "  error: %s\n", result.Error.Message)
+	}
+	if result.FinalResult != nil {
+		fmt.Fprintf(&trace, "  final result: %s\n", formatOutputValue(result.FinalResult))
+	}
+	return trace.String()
+}
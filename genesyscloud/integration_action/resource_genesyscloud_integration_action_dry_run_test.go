@@ -0,0 +1,93 @@
+package integration_action
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mypurecloud/platform-client-sdk-go/v192/platformclientv2"
+)
+
+// TestMatchExpectedOutput asserts that objects are matched partially and that every mismatch is reported with its path
+func TestMatchExpectedOutput(t *testing.T) {
+	actual := map[string]any{
+		"name":   "Jane",
+		"tier":   "gold",
+		"orders": []any{map[string]any{"id": "1", "total": 10.5}},
+	}
+
+	if failures := matchExpectedOutput(map[string]any{"name": "Jane", "orders": []any{map[string]any{"id": "1"}}}, actual, ""); len(failures) != 0 {
+		t.Errorf("expected a partial match to pass, got %v", failures)
+	}
+
+	failures := matchExpectedOutput(map[string]any{
+		"name":    "John",
+		"missing": true,
+		"orders":  []any{map[string]any{"total": 11.0}},
+	}, actual, "")
+	expected := []string{
+		"missing: expected true, but the field is missing",
+		"name: expected \"John\", got \"Jane\"",
+		"orders[0].total: expected 11, got 10.5",
+	}
+	if strings.Join(failures, "\n") != strings.Join(expected, "\n") {
+		t.Errorf("expected failures %v, got %v", expected, failures)
+	}
+
+	if failures := matchExpectedOutput([]any{"a"}, []any{"a", "b"}, ""); len(failures) != 1 {
+		t.Errorf("expected arrays of different length to fail, got %v", failures)
+	}
+}
+
+// TestRunIntegrationActionTest asserts that a failing test returns the execution trace and a passing test returns nothing
+func TestRunIntegrationActionTest(t *testing.T) {
+	resourceSchema := ResourceIntegrationAction().Schema
+	d := schema.TestResourceDataRaw(t, resourceSchema, map[string]interface{}{
+		"test": []interface{}{map[string]interface{}{
+			"input":           `{"customerId": "42"}`,
+			"expected_output": `{"name": "Jane"}`,
+			"expect_success":  true,
+		}},
+	})
+	d.SetId("action-1")
+
+	var receivedInput map[string]any
+	result := &actionTestResult{
+		Success:     true,
+		FinalResult: map[string]any{"name": "John"},
+		Operations: []actionTestOperation{
+			{Step: 1, Name: "Execute", Success: true},
+			{Step: 2, Name: "Apply output transformation", Success: true},
+		},
+	}
+	iap := &integrationActionsProxy{
+		testIntegrationActionAttr: func(ctx context.Context, p *integrationActionsProxy, actionId string, input map[string]any) (*actionTestResult, *platformclientv2.APIResponse, error) {
+			receivedInput = input
+			return result, &platformclientv2.APIResponse{StatusCode: 200}, nil
+		},
+	}
+
+	diags := runIntegrationActionTest(context.Background(), d, iap)
+	if !diags.HasError() {
+		t.Fatal("expected the test to fail")
+	}
+	if receivedInput["customerId"] != "42" {
+		t.Errorf("expected the input to be sent to the test endpoint, got %v", receivedInput)
+	}
+	if detail := diags[0].Detail; !strings.Contains(detail, `name: expected "Jane", got "John"`) || !strings.Contains(detail, "2. Apply output transformation: ok") {
+		t.Errorf("expected the failure and the execution trace in the error, got %s", detail)
+	}
+
+	result.FinalResult = map[string]any{"name": "Jane", "tier": "gold"}
+	if diags := runIntegrationActionTest(context.Background(), d, iap); diags.HasError() {
+		t.Errorf("expected the test to pass, got %v", diags)
+	}
+
+	result.Success = false
+	result.Error = &actionTestError{Message: "Failed to resolve translation map"}
+	diags = runIntegrationActionTest(context.Background(), d, iap)
+	if !diags.HasError() || !strings.Contains(diags[0].Detail, "the action failed: Failed to resolve translation map") {
+		t.Errorf("expected a failed execution to fail the test, got %v", diags)
+	}
+}
@@ -0,0 +1,224 @@
+package integration_action
+
+import (
+	"archive/zip"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"io/fs"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+/*
+The resource_genesyscloud_integration_action_function_package.go file builds the zip of a function data action from a
+source directory. The zip is reproducible: entries are sorted, use forward slashes and carry a fixed timestamp and
+permissions, so that the same sources always give the same zip and the same hash.
+*/
+
+// functionZipModTime is the timestamp of every entry of a function zip. 1980-01-01 is the earliest time a zip can hold.
+var functionZipModTime = time.Date(1980, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+// functionSourceFile is a file of a function source directory
+type functionSourceFile struct {
+	// Name is the path of the file relative to the source directory, using forward slashes
+	Name       string
+	Path       string
+	Executable bool
+}
+
+// listFunctionSourceFiles returns the regular files of a function source directory, sorted by name
+func listFunctionSourceFiles(sourceDir string) ([]functionSourceFile, error) {
+	var sourceFiles []functionSourceFile
+	err := filepath.WalkDir(sourceDir, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if !entry.Type().IsRegular() {
+			return nil
+		}
+		info, err := entry.Info()
+		if err != nil {
+			return err
+		}
+		relPath, err := filepath.Rel(sourceDir, path)
+		if err != nil {
+			return err
+		}
+		sourceFiles = append(sourceFiles, functionSourceFile{
+			Name:       filepath.ToSlash(relPath),
+			Path:       path,
+			Executable: info.Mode()&0111 != 0,
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to read function source directory %s: %w", sourceDir, err)
+	}
+	if len(sourceFiles) == 0 {
+		return nil, fmt.Errorf("function source directory %s has no files", sourceDir)
+	}
+	sort.Slice(sourceFiles, func(i, j int) bool {
+		return sourceFiles[i].Name < sourceFiles[j].Name
+	})
+	return sourceFiles, nil
+}
+
+// hashFunctionSourceDir hashes the names, permissions and content of the files of a function source directory. The hash
+// does not depend on the zip encoding, so it is stable across provider versions.
+func hashFunctionSourceDir(sourceDir string) (string, error) {
+	sourceFiles, err := listFunctionSourceFiles(sourceDir)
+	if err != nil {
+		return "", err
+	}
+
+	hash := sha256.New()
+	for _, sourceFile := range sourceFiles {
+		content, err := os.ReadFile(sourceFile.Path)
+		if err != nil {
+			return "", err
+		}
+		fmt.Fprintf(hash, "%s\x00%t\x00%x\n", sourceFile.Name, sourceFile.Executable, sha256.Sum256(content))
+	}
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+// writeFunctionZip writes the files of a function source directory to a reproducible zip
+func writeFunctionZip(sourceDir string, w io.Writer) error {
+	sourceFiles, err := listFunctionSourceFiles(sourceDir)
+	if err != nil {
+		return err
+	}
+
+	zipWriter := zip.NewWriter(w)
+	for _, sourceFile := range sourceFiles {
+		header := &zip.FileHeader{
+			Name:     sourceFile.Name,
+			Method:   zip.Deflate,
+			Modified: functionZipModTime,
+		}
+		if sourceFile.Executable {
+			header.SetMode(0755)
+		} else {
+			header.SetMode(0644)
+		}
+
+		entryWriter, err := zipWriter.CreateHeader(header)
+		if err != nil {
+			return err
+		}
+		if err := copyFileTo(entryWriter, sourceFile.Path); err != nil {
+			return fmt.Errorf("failed to add %s to function zip: %w", sourceFile.Name, err)
+		}
+	}
+	return zipWriter.Close()
+}
+
+func copyFileTo(w io.Writer, path string) error {
+	file, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+	_, err = io.Copy(w, file)
+	return err
+}
+
+// buildFunctionZip packages a function source directory into a zip in a temporary directory. The zip is named after
+// the source directory. The returned cleanup function removes the zip.
+func buildFunctionZip(sourceDir string) (zipPath string, cleanup func(), err error) {
+	tempDir, err := os.MkdirTemp("", "function-zip-")
+	if err != nil {
+		return "", nil, err
+	}
+	cleanup = func() {
+		if err := os.RemoveAll(tempDir); err != nil {
+			log.Printf("Failed to remove temporary function zip directory %s: %v", tempDir, err)
+		}
+	}
+
+	zipPath = filepath.Join(tempDir, filepath.Base(filepath.Clean(sourceDir))+".zip")
+	zipFile, err := os.Create(zipPath)
+	if err != nil {
+		cleanup()
+		return "", nil, err
+	}
+	err = writeFunctionZip(sourceDir, zipFile)
+	if closeErr := zipFile.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		cleanup()
+		return "", nil, err
+	}
+	return zipPath, cleanup, nil
+}
+
+// getFunctionCodeFile returns the zip to upload for a function data action, either the configured file_path or a zip
+// built from source_dir. The returned cleanup function must be called once the zip is uploaded.
+func getFunctionCodeFile(d *schema.ResourceData) (filePath string, cleanup func(), err error) {
+	noCleanup := func() {}
+
+	filePath, _ = d.Get("function_config.0.file_path").(string)
+	sourceDir, _ := d.Get("function_config.0.source_dir").(string)
+	if sourceDir == "" {
+		if filePath == "" {
+			return "", noCleanup, fmt.Errorf("file_path or source_dir is required in function_config for function data actions")
+		}
+		log.Printf("DEBUG: file_path extracted from function_config: %s", filePath)
+		return filePath, noCleanup, nil
+	}
+
+	log.Printf("Building function zip from source directory %s", sourceDir)
+	filePath, cleanup, err = buildFunctionZip(sourceDir)
+	if err != nil {
+		return "", noCleanup, fmt.Errorf("failed to build function zip from %s: %w", sourceDir, err)
+	}
+	return filePath, cleanup, nil
+}
+
+// customizeFunctionSourceDiff hashes the function source directory so that a change to any of its files shows in the
+// plan, while rebuilding identical sources does not
+func customizeFunctionSourceDiff(ctx context.Context, d *schema.ResourceDiff, _ any) error {
+	if !d.NewValueKnown("function_config") {
+		return nil
+	}
+
+	oldHash, _ := d.Get("function_source_hash").(string)
+	sourceDir, _ := d.Get("function_config.0.source_dir").(string)
+	if sourceDir == "" {
+		if oldHash != "" {
+			return d.SetNew("function_source_hash", "")
+		}
+		return nil
+	}
+
+	newHash, err := hashFunctionSourceDir(sourceDir)
+	if err != nil {
+		return err
+	}
+	if newHash != oldHash {
+		return d.SetNew("function_source_hash", newHash)
+	}
+	return nil
+}
+
+// setFunctionSourceHash records the hash of the uploaded function sources
+func setFunctionSourceHash(d *schema.ResourceData) error {
+	sourceDir, _ := d.Get("function_config.0.source_dir").(string)
+	if sourceDir == "" {
+		return d.Set("function_source_hash", "")
+	}
+	hash, err := hashFunctionSourceDir(sourceDir)
+	if err != nil {
+		return err
+	}
+	return d.Set("function_source_hash", hash)
+}
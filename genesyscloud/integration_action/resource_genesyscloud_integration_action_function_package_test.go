@@ -0,0 +1,132 @@
+package integration_action
+
+import (
+	"archive/zip"
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func writeFunctionSources(t *testing.T, dir string, files map[string]string) {
+	t.Helper()
+	for name, content := range files {
+		path := filepath.Join(dir, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+// TestWriteFunctionZipIsReproducible asserts that the same sources always give byte for byte the same zip, whatever
+// the modification times of the files, and that the entries are sorted
+func TestWriteFunctionZipIsReproducible(t *testing.T) {
+	sources := map[string]string{
+		"index.js":              "exports.handler = async () => ({ ok: true });",
+		"lib/util.js":           "module.exports = {};",
+		"node_modules/a/pkg.js": "module.exports = 'a';",
+	}
+	dir1 := t.TempDir()
+	dir2 := t.TempDir()
+	writeFunctionSources(t, dir1, sources)
+	writeFunctionSources(t, dir2, sources)
+
+	later := time.Now().Add(time.Hour)
+	if err := os.Chtimes(filepath.Join(dir2, "index.js"), later, later); err != nil {
+		t.Fatal(err)
+	}
+
+	var zip1, zip2 bytes.Buffer
+	if err := writeFunctionZip(dir1, &zip1); err != nil {
+		t.Fatal(err)
+	}
+	if err := writeFunctionZip(dir2, &zip2); err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(zip1.Bytes(), zip2.Bytes()) {
+		t.Error("expected identical sources to give identical zips")
+	}
+
+	reader, err := zip.NewReader(bytes.NewReader(zip1.Bytes()), int64(zip1.Len()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	expectedNames := []string{"index.js", "lib/util.js", "node_modules/a/pkg.js"}
+	if len(reader.File) != len(expectedNames) {
+		t.Fatalf("expected %d entries, got %d", len(expectedNames), len(reader.File))
+	}
+	for i, file := range reader.File {
+		if file.Name != expectedNames[i] {
+			t.Errorf("expected entry %d to be %s, got %s", i, expectedNames[i], file.Name)
+		}
+		if !file.Modified.Equal(functionZipModTime) {
+			t.Errorf("expected entry %s to have a fixed timestamp, got %s", file.Name, file.Modified)
+		}
+	}
+}
+
+// TestHashFunctionSourceDir asserts that the hash only changes when the sources do
+func TestHashFunctionSourceDir(t *testing.T) {
+	dir := t.TempDir()
+	writeFunctionSources(t, dir, map[string]string{"index.js": "v1"})
+
+	hash1, err := hashFunctionSourceDir(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	hash2, err := hashFunctionSourceDir(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if hash1 != hash2 {
+		t.Error("expected the hash of unchanged sources to be stable")
+	}
+
+	writeFunctionSources(t, dir, map[string]string{"index.js": "v2"})
+	hash3, err := hashFunctionSourceDir(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if hash3 == hash1 {
+		t.Error("expected the hash to change when a file changes")
+	}
+
+	writeFunctionSources(t, dir, map[string]string{"index.js": "v1", "lib/extra.js": ""})
+	hash4, err := hashFunctionSourceDir(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if hash4 == hash1 {
+		t.Error("expected the hash to change when a file is added")
+	}
+
+	if _, err := hashFunctionSourceDir(t.TempDir()); err == nil {
+		t.Error("expected an error for an empty source directory")
+	}
+}
+
+// TestBuildFunctionZip asserts that the zip is named after the source directory and removed by the cleanup function
+func TestBuildFunctionZip(t *testing.T) {
+	sourceDir := filepath.Join(t.TempDir(), "lookup_customer")
+	writeFunctionSources(t, sourceDir, map[string]string{"index.js": "exports.handler = () => {};"})
+
+	zipPath, cleanup, err := buildFunctionZip(sourceDir + string(filepath.Separator))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if filepath.Base(zipPath) != "lookup_customer.zip" {
+		t.Errorf("expected the zip to be named lookup_customer.zip, got %s", filepath.Base(zipPath))
+	}
+	if _, err := os.Stat(zipPath); err != nil {
+		t.Fatal(err)
+	}
+
+	cleanup()
+	if _, err := os.Stat(zipPath); !os.IsNotExist(err) {
+		t.Errorf("expected %s to be removed", zipPath)
+	}
+}
@@ -121,10 +121,44 @@ func ResourceIntegrationAction() *schema.Resource {
 				Computed:    true,
 			},
 			"file_path": {
-				Description:  "The zip file path containing the function data action's code. During the export just the name of the zip file will be exported",
+				Description:  "The zip file path containing the function data action's code. During the export just the name of the zip file will be exported. Exactly one of `file_path` and `source_dir` must be set.",
 				Type:         schema.TypeString,
-				Required:     true,
+				Optional:     true,
+				Computed:     true,
 				ValidateFunc: validators.ValidatePath,
+				ExactlyOneOf: []string{"function_config.0.file_path", "function_config.0.source_dir"},
+			},
+			"source_dir": {
+				Description:  "Path to a directory holding the function data action's code. The provider packages the directory into a reproducible zip (sorted entries, fixed timestamps and permissions) and uploads it, so a separate packaging step is not needed. Changes to the files are detected through `function_source_hash`.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+				ExactlyOneOf: []string{"function_config.0.file_path", "function_config.0.source_dir"},
+			},
+		},
+	}
+
+	actionTest := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"input": {
+				Description:      "JSON object sent as the input of the action. It must match `contract_input`.",
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateFunc:     validation.StringIsJSON,
+				DiffSuppressFunc: util.SuppressEquivalentJsonDiffs,
+			},
+			"expected_output": {
+				Description:      "JSON the output of the action must match. Objects are matched partially, only the fields they set are compared, while arrays must match element by element. Only checked when the action succeeds.",
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateFunc:     validation.StringIsJSON,
+				DiffSuppressFunc: util.SuppressEquivalentJsonDiffs,
+			},
+			"expect_success": {
+				Description: "Whether the action is expected to succeed. Set to false to check that invalid input is rejected.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
 			},
 		},
 	}
@@ -139,6 +173,7 @@ func ResourceIntegrationAction() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		CustomizeDiff: customizeFunctionSourceDiff,
 		SchemaVersion: 1,
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -209,6 +244,18 @@ func ResourceIntegrationAction() *schema.Resource {
 				MaxItems:    1,
 				Elem:        functionConfig,
 			},
+			"test": {
+				Description: "Sample execution of the action that runs through the Genesys Cloud action test endpoint after every create and update. The apply fails with the action's execution trace if the outcome does not match `expect_success` and `expected_output`. Changing the test block alone runs the test again.",
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Elem:        actionTest,
+			},
+			"function_source_hash": {
+				Description: "SHA-256 hash of the files in `function_config.source_dir`. Used to detect changes to the function's code.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
 		},
 	}
 }
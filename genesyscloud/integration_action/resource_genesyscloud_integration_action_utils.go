@@ -250,6 +250,16 @@ func FlattenFunctionConfigRequest(functionConfig platformclientv2.Functionconfig
 	return []interface{}{functionMap}
 }
 
+// flattenFunctionConfig flattens the function settings of an action. The API only knows the name of the uploaded zip,
+// so the source directory the zip was built from is kept from the configuration.
+func flattenFunctionConfig(d *schema.ResourceData, functionConfig platformclientv2.Functionconfig) []interface{} {
+	flattened := FlattenFunctionConfigRequest(functionConfig)
+	if sourceDir, _ := d.Get("function_config.0.source_dir").(string); sourceDir != "" {
+		flattened[0].(map[string]interface{})["source_dir"] = sourceDir
+	}
+	return flattened
+}
+
 // BuildSdkFunctionConfig takes the resource data and builds the SDK platformclientv2.Functionconfig from it
 func BuildSdkFunctionConfig(d *schema.ResourceData, zipId string) *platformclientv2.Functionconfig {
 	log.Printf("DEBUG: BuildSdkFunctionConfig called with zipId: %s", zipId)
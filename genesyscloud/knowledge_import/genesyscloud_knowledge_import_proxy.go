@@ -0,0 +1,202 @@
+package knowledge_import
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+
+	customapi "github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/custom_api_client"
+	"github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/provider"
+	"github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/util"
+	"github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/util/files"
+
+	"github.com/mypurecloud/platform-client-sdk-go/v192/platformclientv2"
+)
+
+var internalProxy *knowledgeImportProxy
+
+type createDocumentUploadFunc func(ctx context.Context, p *knowledgeImportProxy, fileName string) (*documentUploadResponse, *platformclientv2.APIResponse, error)
+type uploadImportFileFunc func(ctx context.Context, p *knowledgeImportProxy, upload *documentUploadResponse, content []byte) error
+type createImportJobFunc func(ctx context.Context, p *knowledgeImportProxy, knowledgeBaseId string, body *importJobRequest) (*importJobResponse, *platformclientv2.APIResponse, error)
+type getImportJobFunc func(ctx context.Context, p *knowledgeImportProxy, knowledgeBaseId string, jobId string) (*importJobResponse, *platformclientv2.APIResponse, error)
+type getAllDocumentsFunc func(ctx context.Context, p *knowledgeImportProxy, knowledgeBaseId string) ([]documentSummary, *platformclientv2.APIResponse, error)
+type deleteDocumentFunc func(ctx context.Context, p *knowledgeImportProxy, knowledgeBaseId string, documentId string) (*platformclientv2.APIResponse, error)
+
+// documentUploadResponse is the presigned upload returned by /api/v2/knowledge/documentuploads
+type documentUploadResponse struct {
+	Url       string            `json:"url"`
+	UploadKey string            `json:"uploadKey"`
+	Headers   map[string]string `json:"headers"`
+}
+
+type importJobRequest struct {
+	UploadKey            string            `json:"uploadKey"`
+	FileType             string            `json:"fileType"`
+	Settings             importJobSettings `json:"settings"`
+	SkipConfirmationStep bool              `json:"skipConfirmationStep"`
+}
+
+type importJobSettings struct {
+	KnowledgeBase entityReference `json:"knowledgeBase"`
+	Visible       bool            `json:"visible"`
+}
+
+type entityReference struct {
+	Id string `json:"id"`
+}
+
+type importJobResponse struct {
+	Id     string           `json:"id"`
+	Status string           `json:"status"`
+	Report *importJobReport `json:"report,omitempty"`
+}
+
+type importJobReport struct {
+	Errors []importJobError `json:"errors,omitempty"`
+}
+
+type importJobError struct {
+	ErrorMessage  string `json:"errorMessage"`
+	DocumentIndex *int   `json:"documentIndex,omitempty"`
+}
+
+// documentSummary holds the fields of a knowledge document needed to match it to an article
+type documentSummary struct {
+	Id    string `json:"id"`
+	Title string `json:"title"`
+}
+
+type documentListing struct {
+	Entities []documentSummary `json:"entities"`
+	NextUri  *string           `json:"nextUri"`
+}
+
+type knowledgeImportProxy struct {
+	clientConfig             *platformclientv2.Configuration
+	knowledgeApi             *platformclientv2.KnowledgeApi
+	customApiClient          *customapi.Client
+	createDocumentUploadAttr createDocumentUploadFunc
+	uploadImportFileAttr     uploadImportFileFunc
+	createImportJobAttr      createImportJobFunc
+	getImportJobAttr         getImportJobFunc
+	getAllDocumentsAttr      getAllDocumentsFunc
+	deleteDocumentAttr       deleteDocumentFunc
+}
+
+func newKnowledgeImportProxy(clientConfig *platformclientv2.Configuration) *knowledgeImportProxy {
+	return &knowledgeImportProxy{
+		clientConfig:             clientConfig,
+		knowledgeApi:             platformclientv2.NewKnowledgeApiWithConfig(clientConfig),
+		customApiClient:          customapi.NewClient(clientConfig, ResourceType),
+		createDocumentUploadAttr: createDocumentUploadFn,
+		uploadImportFileAttr:     uploadImportFileFn,
+		createImportJobAttr:      createImportJobFn,
+		getImportJobAttr:         getImportJobFn,
+		getAllDocumentsAttr:      getAllDocumentsFn,
+		deleteDocumentAttr:       deleteDocumentFn,
+	}
+}
+
+func getKnowledgeImportProxy(clientConfig *platformclientv2.Configuration) *knowledgeImportProxy {
+	if internalProxy == nil {
+		internalProxy = newKnowledgeImportProxy(clientConfig)
+	}
+	return internalProxy
+}
+
+func (p *knowledgeImportProxy) createDocumentUpload(ctx context.Context, fileName string) (*documentUploadResponse, *platformclientv2.APIResponse, error) {
+	return p.createDocumentUploadAttr(ctx, p, fileName)
+}
+
+func (p *knowledgeImportProxy) uploadImportFile(ctx context.Context, upload *documentUploadResponse, content []byte) error {
+	return p.uploadImportFileAttr(ctx, p, upload, content)
+}
+
+func (p *knowledgeImportProxy) createImportJob(ctx context.Context, knowledgeBaseId string, body *importJobRequest) (*importJobResponse, *platformclientv2.APIResponse, error) {
+	return p.createImportJobAttr(ctx, p, knowledgeBaseId, body)
+}
+
+func (p *knowledgeImportProxy) getImportJob(ctx context.Context, knowledgeBaseId string, jobId string) (*importJobResponse, *platformclientv2.APIResponse, error) {
+	return p.getImportJobAttr(ctx, p, knowledgeBaseId, jobId)
+}
+
+func (p *knowledgeImportProxy) getAllDocuments(ctx context.Context, knowledgeBaseId string) ([]documentSummary, *platformclientv2.APIResponse, error) {
+	return p.getAllDocumentsAttr(ctx, p, knowledgeBaseId)
+}
+
+func (p *knowledgeImportProxy) deleteDocument(ctx context.Context, knowledgeBaseId string, documentId string) (*platformclientv2.APIResponse, error) {
+	return p.deleteDocumentAttr(ctx, p, knowledgeBaseId, documentId)
+}
+
+func createDocumentUploadFn(ctx context.Context, p *knowledgeImportProxy, fileName string) (*documentUploadResponse, *platformclientv2.APIResponse, error) {
+	body := map[string]string{"fileName": fileName}
+	return customapi.Do[documentUploadResponse](ctx, p.customApiClient, customapi.MethodPost, "/api/v2/knowledge/documentuploads", body, nil)
+}
+
+func uploadImportFileFn(ctx context.Context, p *knowledgeImportProxy, upload *documentUploadResponse, content []byte) error {
+	s3Uploader := files.NewS3Uploader(bytes.NewReader(content), nil, nil, upload.Headers, http.MethodPut, upload.Url)
+	if _, err := s3Uploader.Upload(); err != nil {
+		return fmt.Errorf("failed to upload import file: %w", err)
+	}
+	return nil
+}
+
+func createImportJobFn(ctx context.Context, p *knowledgeImportProxy, knowledgeBaseId string, body *importJobRequest) (*importJobResponse, *platformclientv2.APIResponse, error) {
+	path := fmt.Sprintf("/api/v2/knowledge/knowledgebases/%s/import/jobs", knowledgeBaseId)
+	return customapi.Do[importJobResponse](ctx, p.customApiClient, customapi.MethodPost, path, body, nil)
+}
+
+func getImportJobFn(ctx context.Context, p *knowledgeImportProxy, knowledgeBaseId string, jobId string) (*importJobResponse, *platformclientv2.APIResponse, error) {
+	path := fmt.Sprintf("/api/v2/knowledge/knowledgebases/%s/import/jobs/%s", knowledgeBaseId, jobId)
+	queryParams := customapi.NewQueryParams(map[string]string{"expand": "errors"})
+	return customapi.Do[importJobResponse](ctx, p.customApiClient, customapi.MethodGet, path, nil, queryParams)
+}
+
+func getAllDocumentsFn(ctx context.Context, p *knowledgeImportProxy, knowledgeBaseId string) ([]documentSummary, *platformclientv2.APIResponse, error) {
+	const pageSize = 100
+	var (
+		documents []documentSummary
+		after     string
+		resp      *platformclientv2.APIResponse
+	)
+
+	path := fmt.Sprintf("/api/v2/knowledge/knowledgebases/%s/documents", knowledgeBaseId)
+	for {
+		queryParams := customapi.NewQueryParams(map[string]string{
+			"pageSize":      fmt.Sprintf("%d", pageSize),
+			"includeDrafts": "true",
+		})
+		if after != "" {
+			queryParams.Set("after", after)
+		}
+
+		var (
+			listing *documentListing
+			err     error
+		)
+		listing, resp, err = customapi.Do[documentListing](ctx, p.customApiClient, customapi.MethodGet, path, nil, queryParams)
+		if err != nil {
+			return nil, resp, fmt.Errorf("failed to get documents of knowledge base %s: %w", knowledgeBaseId, err)
+		}
+		documents = append(documents, listing.Entities...)
+
+		if listing.NextUri == nil || *listing.NextUri == "" || len(listing.Entities) == 0 {
+			break
+		}
+		after, err = util.GetQueryParamValueFromUri(*listing.NextUri, "after")
+		if err != nil {
+			return nil, resp, fmt.Errorf("failed to parse after cursor from documents nextUri: %w", err)
+		}
+		if after == "" {
+			break
+		}
+	}
+	return documents, resp, nil
+}
+
+func deleteDocumentFn(ctx context.Context, p *knowledgeImportProxy, knowledgeBaseId string, documentId string) (*platformclientv2.APIResponse, error) {
+	// Set resource context for SDK debug logging
+	ctx = provider.EnsureResourceContext(ctx, ResourceType)
+	return p.knowledgeApi.DeleteKnowledgeKnowledgebaseDocument(knowledgeBaseId, documentId)
+}
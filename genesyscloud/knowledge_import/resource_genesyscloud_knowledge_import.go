@@ -0,0 +1,305 @@
+package knowledge_import
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/provider"
+	"github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/util"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// importJobPollInterval is the time between two checks of the status of an import job
+var importJobPollInterval = 5 * time.Second
+
+func createKnowledgeImport(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	log.Printf("Creating knowledge import for directory %s", d.Get("directory").(string))
+	return applyKnowledgeImport(ctx, d, meta, d.Timeout(schema.TimeoutCreate))
+}
+
+func updateKnowledgeImport(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	log.Printf("Updating knowledge import %s", d.Id())
+	return applyKnowledgeImport(ctx, d, meta, d.Timeout(schema.TimeoutUpdate))
+}
+
+func applyKnowledgeImport(ctx context.Context, d *schema.ResourceData, meta interface{}, timeout time.Duration) diag.Diagnostics {
+	sdkConfig := meta.(*provider.ProviderMeta).ClientConfig
+	proxy := getKnowledgeImportProxy(sdkConfig)
+
+	knowledgeBaseId := d.Get("knowledge_base_id").(string)
+	directory := d.Get("directory").(string)
+
+	directoryHash, err := hashArticlesDirectory(directory)
+	if err != nil {
+		return util.BuildDiagnosticError(ResourceType, fmt.Sprintf("Failed to hash articles directory %s", directory), err)
+	}
+	articles, err := readArticles(directory, d.Get("visible").(bool))
+	if err != nil {
+		return util.BuildDiagnosticError(ResourceType, fmt.Sprintf("Failed to read articles from %s", directory), err)
+	}
+
+	if d.Id() == "" {
+		d.SetId(uuid.NewString())
+	}
+
+	previous := buildImportedDocumentStates(d)
+	states, jobId, diags := importArticles(ctx, proxy, knowledgeBaseId, articles, previous, timeout)
+
+	// A failed import leaves the hash empty so that the next plan imports again
+	if diags.HasError() {
+		directoryHash = ""
+	}
+	if jobId != "" {
+		_ = d.Set("import_job_id", jobId)
+	}
+	_ = d.Set("content_hash", directoryHash)
+	_ = d.Set("documents", flattenImportedDocumentStates(states))
+
+	log.Printf("Applied knowledge import %s with %d documents", d.Id(), len(states))
+	return diags
+}
+
+// importArticles imports the articles that changed since the last apply and deletes the documents they replace, as
+// well as the documents of articles that were removed. It returns the state of every article it manages afterwards.
+func importArticles(ctx context.Context, proxy *knowledgeImportProxy, knowledgeBaseId string, articles []article, previous map[string]importedDocumentState, timeout time.Duration) (map[string]importedDocumentState, string, diag.Diagnostics) {
+	var (
+		diags   diag.Diagnostics
+		jobId   string
+		changed []article
+		states  = make(map[string]importedDocumentState)
+	)
+
+	for _, a := range articles {
+		state, ok := previous[a.Path]
+		if ok && state.DocumentId != "" && state.Hash == a.Hash {
+			states[a.Path] = state
+			continue
+		}
+		changed = append(changed, a)
+	}
+
+	if len(changed) > 0 {
+		existing, resp, err := proxy.getAllDocuments(ctx, knowledgeBaseId)
+		if err != nil {
+			return previous, "", util.BuildAPIDiagnosticError(ResourceType, fmt.Sprintf("Failed to get documents of knowledge base %s", knowledgeBaseId), resp)
+		}
+		existingIds := make(map[string]bool, len(existing))
+		for _, document := range existing {
+			existingIds[document.Id] = true
+		}
+
+		jobId, diags = runImportJob(ctx, proxy, knowledgeBaseId, changed, timeout)
+		if diags.HasError() {
+			// Nothing was imported, keep the previous documents of the changed articles
+			for _, a := range changed {
+				if state, ok := previous[a.Path]; ok {
+					states[a.Path] = importedDocumentState{Title: state.Title, DocumentId: state.DocumentId}
+				}
+			}
+			for path, state := range previous {
+				if _, ok := states[path]; !ok {
+					states[path] = state
+				}
+			}
+			return states, jobId, diags
+		}
+
+		imported, resp, err := proxy.getAllDocuments(ctx, knowledgeBaseId)
+		if err != nil {
+			return previous, jobId, util.BuildAPIDiagnosticError(ResourceType, fmt.Sprintf("Failed to get documents of knowledge base %s", knowledgeBaseId), resp)
+		}
+		newIdsByTitle := make(map[string]string)
+		for _, document := range imported {
+			if !existingIds[document.Id] {
+				newIdsByTitle[document.Title] = document.Id
+			}
+		}
+
+		for _, a := range changed {
+			documentId, ok := newIdsByTitle[a.Document.Title]
+			if !ok {
+				diags = append(diags, diag.Diagnostic{
+					Severity: diag.Warning,
+					Summary:  fmt.Sprintf("Failed to find the document imported from %s", a.Path),
+					Detail:   fmt.Sprintf("Import job %s completed but no new document with the title %q was found. The article will be imported again on the next apply.", jobId, a.Document.Title),
+				})
+				if state, ok := previous[a.Path]; ok {
+					states[a.Path] = importedDocumentState{Title: state.Title, DocumentId: state.DocumentId}
+				}
+				continue
+			}
+			states[a.Path] = importedDocumentState{Title: a.Document.Title, DocumentId: documentId, Hash: a.Hash}
+
+			// The import created a new document, so the one it replaces is removed
+			if state, ok := previous[a.Path]; ok && state.DocumentId != "" && state.DocumentId != documentId {
+				diags = append(diags, deleteImportedDocument(ctx, proxy, knowledgeBaseId, a.Path, state.DocumentId)...)
+			}
+		}
+	}
+
+	for path, state := range previous {
+		if _, ok := states[path]; ok || state.DocumentId == "" || isArticleInList(path, articles) {
+			continue
+		}
+		if deleteDiags := deleteImportedDocument(ctx, proxy, knowledgeBaseId, path, state.DocumentId); deleteDiags != nil {
+			diags = append(diags, deleteDiags...)
+			states[path] = state
+		}
+	}
+
+	return states, jobId, diags
+}
+
+// runImportJob uploads the changed articles and waits for the import job to finish
+func runImportJob(ctx context.Context, proxy *knowledgeImportProxy, knowledgeBaseId string, articles []article, timeout time.Duration) (string, diag.Diagnostics) {
+	file := importFile{KnowledgeBase: entityReference{Id: knowledgeBaseId}}
+	for _, a := range articles {
+		file.Documents = append(file.Documents, a.Document)
+	}
+	content, err := json.Marshal(file)
+	if err != nil {
+		return "", util.BuildDiagnosticError(ResourceType, "Failed to marshal knowledge import file", err)
+	}
+
+	upload, resp, err := proxy.createDocumentUpload(ctx, fmt.Sprintf("terraform-knowledge-import-%s.json", uuid.NewString()))
+	if err != nil {
+		return "", util.BuildAPIDiagnosticError(ResourceType, "Failed to request an upload URL for the knowledge import file", resp)
+	}
+	if err := proxy.uploadImportFile(ctx, upload, content); err != nil {
+		return "", util.BuildDiagnosticError(ResourceType, "Failed to upload the knowledge import file", err)
+	}
+
+	job, resp, err := proxy.createImportJob(ctx, knowledgeBaseId, &importJobRequest{
+		UploadKey:            upload.UploadKey,
+		FileType:             "Json",
+		Settings:             importJobSettings{KnowledgeBase: entityReference{Id: knowledgeBaseId}, Visible: true},
+		SkipConfirmationStep: true,
+	})
+	if err != nil {
+		return "", util.BuildAPIDiagnosticError(ResourceType, fmt.Sprintf("Failed to create import job in knowledge base %s", knowledgeBaseId), resp)
+	}
+	log.Printf("Started knowledge import job %s with %d documents", job.Id, len(articles))
+
+	diags := util.WithRetries(ctx, timeout, func() *retry.RetryError {
+		current, resp, err := proxy.getImportJob(ctx, knowledgeBaseId, job.Id)
+		if err != nil {
+			return retry.NonRetryableError(util.BuildWithRetriesApiDiagnosticError(ResourceType, fmt.Sprintf("Failed to get import job %s", job.Id), resp))
+		}
+
+		switch current.Status {
+		case "Completed":
+			return nil
+		case "Failed", "Aborted", "ValidationFailed":
+			return retry.NonRetryableError(fmt.Errorf("import job %s finished with status %s: %s", job.Id, current.Status, importJobErrors(current, articles)))
+		default:
+			time.Sleep(importJobPollInterval)
+			return retry.RetryableError(fmt.Errorf("import job %s has status %s", job.Id, current.Status))
+		}
+	})
+	return job.Id, diags
+}
+
+// importJobErrors describes the errors of a failed import job, pointing at the article of each error when known
+func importJobErrors(job *importJobResponse, articles []article) string {
+	if job.Report == nil || len(job.Report.Errors) == 0 {
+		return "no errors were reported"
+	}
+	messages := ""
+	for i, jobError := range job.Report.Errors {
+		if i > 0 {
+			messages += "; "
+		}
+		if jobError.DocumentIndex != nil && *jobError.DocumentIndex >= 0 && *jobError.DocumentIndex < len(articles) {
+			messages += articles[*jobError.DocumentIndex].Path + ": "
+		}
+		messages += jobError.ErrorMessage
+	}
+	return messages
+}
+
+func deleteImportedDocument(ctx context.Context, proxy *knowledgeImportProxy, knowledgeBaseId string, path string, documentId string) diag.Diagnostics {
+	resp, err := proxy.deleteDocument(ctx, knowledgeBaseId, documentId)
+	if err != nil && !util.IsStatus404(resp) {
+		return diag.Diagnostics{{
+			Severity: diag.Warning,
+			Summary:  fmt.Sprintf("Failed to delete document %s of %s", documentId, path),
+			Detail:   err.Error(),
+		}}
+	}
+	return nil
+}
+
+func isArticleInList(path string, articles []article) bool {
+	for _, a := range articles {
+		if a.Path == path {
+			return true
+		}
+	}
+	return false
+}
+
+func readKnowledgeImport(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sdkConfig := meta.(*provider.ProviderMeta).ClientConfig
+	proxy := getKnowledgeImportProxy(sdkConfig)
+	knowledgeBaseId := d.Get("knowledge_base_id").(string)
+
+	log.Printf("Reading knowledge import %s", d.Id())
+
+	return util.WithRetriesForRead(ctx, d, func() *retry.RetryError {
+		documents, resp, err := proxy.getAllDocuments(ctx, knowledgeBaseId)
+		if err != nil {
+			if util.IsStatus404(resp) {
+				return retry.RetryableError(util.BuildWithRetriesApiDiagnosticError(ResourceType, fmt.Sprintf("Failed to read knowledge base %s", knowledgeBaseId), resp))
+			}
+			return retry.NonRetryableError(util.BuildWithRetriesApiDiagnosticError(ResourceType, fmt.Sprintf("Failed to read knowledge base %s", knowledgeBaseId), resp))
+		}
+		existingIds := make(map[string]bool, len(documents))
+		for _, document := range documents {
+			existingIds[document.Id] = true
+		}
+
+		// Articles whose document was deleted outside of Terraform are imported again on the next apply
+		states := buildImportedDocumentStates(d)
+		for path, state := range states {
+			if state.DocumentId != "" && !existingIds[state.DocumentId] {
+				log.Printf("Document %s of %s no longer exists", state.DocumentId, path)
+				states[path] = importedDocumentState{Title: state.Title}
+				_ = d.Set("content_hash", "")
+			}
+		}
+		_ = d.Set("documents", flattenImportedDocumentStates(states))
+
+		log.Printf("Read knowledge import %s", d.Id())
+		return nil
+	})
+}
+
+func deleteKnowledgeImport(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sdkConfig := meta.(*provider.ProviderMeta).ClientConfig
+	proxy := getKnowledgeImportProxy(sdkConfig)
+	knowledgeBaseId := d.Get("knowledge_base_id").(string)
+
+	var diags diag.Diagnostics
+	for path, state := range buildImportedDocumentStates(d) {
+		if state.DocumentId == "" {
+			continue
+		}
+		resp, err := proxy.deleteDocument(ctx, knowledgeBaseId, state.DocumentId)
+		if err != nil && !util.IsStatus404(resp) {
+			diags = append(diags, util.BuildAPIDiagnosticError(ResourceType, fmt.Sprintf("Failed to delete document %s of %s", state.DocumentId, path), resp)...)
+		}
+	}
+	if diags.HasError() {
+		return diags
+	}
+
+	log.Printf("Deleted knowledge import %s", d.Id())
+	return nil
+}
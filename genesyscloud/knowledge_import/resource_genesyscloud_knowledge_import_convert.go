@@ -0,0 +1,460 @@
+package knowledge_import
+
+import (
+	"bytes"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/extension"
+	goldmarkhtml "github.com/yuin/goldmark/renderer/html"
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+)
+
+// The structs below mirror the document variation body of the knowledge API. A single block type is used for every
+// level of nesting, only the fields that are valid for the block type are set.
+
+type bodyBlock struct {
+	Type      string         `json:"type"`
+	Text      *bodyText      `json:"text,omitempty"`
+	Paragraph *bodyParagraph `json:"paragraph,omitempty"`
+	Image     *bodyImage     `json:"image,omitempty"`
+	Video     *bodyVideo     `json:"video,omitempty"`
+	List      *bodyList      `json:"list,omitempty"`
+	Table     *bodyTable     `json:"table,omitempty"`
+	// Blocks holds the content of a ListItem block
+	Blocks []bodyBlock `json:"blocks,omitempty"`
+}
+
+type bodyText struct {
+	Text      string   `json:"text"`
+	Marks     []string `json:"marks,omitempty"`
+	Hyperlink string   `json:"hyperlink,omitempty"`
+}
+
+type bodyParagraph struct {
+	Blocks     []bodyBlock              `json:"blocks"`
+	Properties *bodyParagraphProperties `json:"properties,omitempty"`
+}
+
+type bodyParagraphProperties struct {
+	FontType string `json:"fontType,omitempty"`
+}
+
+type bodyImage struct {
+	Url       string `json:"url"`
+	Hyperlink string `json:"hyperlink,omitempty"`
+}
+
+type bodyVideo struct {
+	Url string `json:"url"`
+}
+
+type bodyList struct {
+	Blocks []bodyBlock `json:"blocks"`
+}
+
+type bodyTable struct {
+	Rows []bodyTableRow `json:"rows"`
+}
+
+type bodyTableRow struct {
+	Cells []bodyTableCell `json:"cells"`
+}
+
+type bodyTableCell struct {
+	Blocks     []bodyBlock              `json:"blocks"`
+	Properties *bodyTableCellProperties `json:"properties,omitempty"`
+}
+
+type bodyTableCellProperties struct {
+	CellType string `json:"cellType"`
+}
+
+var (
+	markdownRenderer = goldmark.New(
+		goldmark.WithExtensions(extension.GFM),
+		// Articles are authored in the customer's own repository, so raw HTML (e.g. video iframes) is passed through
+		goldmark.WithRendererOptions(goldmarkhtml.WithUnsafe()),
+	)
+
+	whitespaceRegex = regexp.MustCompile(`\s+`)
+
+	headingFontTypes = map[atom.Atom]string{
+		atom.H1: "Heading1",
+		atom.H2: "Heading2",
+		atom.H3: "Heading3",
+		atom.H4: "Heading4",
+		atom.H5: "Heading5",
+		atom.H6: "Heading6",
+	}
+)
+
+// markdownToHtml renders a Markdown article to HTML
+func markdownToHtml(source []byte) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := markdownRenderer.Convert(source, &buf); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// htmlToBodyBlocks converts an HTML article to the blocks of a document variation body. Elements that have no
+// equivalent in the body, such as block quotes or sections, contribute their content only.
+func htmlToBodyBlocks(source []byte) ([]bodyBlock, error) {
+	nodes, err := html.ParseFragment(bytes.NewReader(source), &html.Node{Type: html.ElementNode, Data: "body", DataAtom: atom.Body})
+	if err != nil {
+		return nil, err
+	}
+
+	c := &bodyConverter{}
+	for _, node := range nodes {
+		c.convertBlock(node)
+	}
+	c.flushParagraph()
+
+	if len(c.blocks) == 0 {
+		return nil, fmt.Errorf("article has no content")
+	}
+	return c.blocks, nil
+}
+
+type bodyConverter struct {
+	blocks []bodyBlock
+	// inline collects text and images that are not inside a paragraph element
+	inline []bodyBlock
+}
+
+func (c *bodyConverter) convertBlock(n *html.Node) {
+	if n.Type == html.TextNode {
+		c.inline = append(c.inline, inlineBlocks(n, nil, "")...)
+		return
+	}
+	if n.Type != html.ElementNode {
+		return
+	}
+
+	if fontType, ok := headingFontTypes[n.DataAtom]; ok {
+		c.flushParagraph()
+		c.appendParagraph(inlineChildren(n, nil, ""), fontType)
+		return
+	}
+
+	switch n.DataAtom {
+	case atom.P:
+		c.flushParagraph()
+		c.appendParagraph(inlineChildren(n, nil, ""), "")
+	case atom.Pre:
+		c.flushParagraph()
+		text := strings.TrimSuffix(textContent(n), "\n")
+		if text != "" {
+			c.blocks = append(c.blocks, bodyBlock{
+				Type: "Paragraph",
+				Paragraph: &bodyParagraph{
+					Blocks:     []bodyBlock{{Type: "Text", Text: &bodyText{Text: text}}},
+					Properties: &bodyParagraphProperties{FontType: "Preformatted"},
+				},
+			})
+		}
+	case atom.Ul, atom.Ol:
+		c.flushParagraph()
+		c.blocks = append(c.blocks, listBlock(n))
+	case atom.Table:
+		c.flushParagraph()
+		c.blocks = append(c.blocks, tableBlock(n))
+	case atom.Iframe, atom.Video:
+		c.flushParagraph()
+		if block, ok := videoBlock(n); ok {
+			c.blocks = append(c.blocks, block)
+		}
+	case atom.Img:
+		c.flushParagraph()
+		if src := attr(n, "src"); src != "" {
+			c.blocks = append(c.blocks, bodyBlock{Type: "Image", Image: &bodyImage{Url: src}})
+		}
+	case atom.Hr, atom.Script, atom.Style, atom.Head, atom.Title, atom.Meta, atom.Link:
+		c.flushParagraph()
+	case atom.Br:
+		c.inline = append(c.inline, bodyBlock{Type: "Text", Text: &bodyText{Text: "\n"}})
+	case atom.Div, atom.Blockquote, atom.Section, atom.Article, atom.Main, atom.Header, atom.Footer, atom.Aside,
+		atom.Nav, atom.Figure, atom.Figcaption, atom.Details, atom.Summary, atom.Html, atom.Body:
+		c.flushParagraph()
+		for child := n.FirstChild; child != nil; child = child.NextSibling {
+			c.convertBlock(child)
+		}
+		c.flushParagraph()
+	default:
+		c.inline = append(c.inline, inlineBlocks(n, nil, "")...)
+	}
+}
+
+// flushParagraph turns loose inline content into a paragraph
+func (c *bodyConverter) flushParagraph() {
+	if len(c.inline) > 0 {
+		c.appendParagraph(c.inline, "")
+		c.inline = nil
+	}
+}
+
+func (c *bodyConverter) appendParagraph(content []bodyBlock, fontType string) {
+	content = trimInlineBlocks(content)
+	if len(content) == 0 {
+		return
+	}
+
+	paragraph := &bodyParagraph{Blocks: content}
+	if fontType != "" {
+		paragraph.Properties = &bodyParagraphProperties{FontType: fontType}
+	}
+	c.blocks = append(c.blocks, bodyBlock{Type: "Paragraph", Paragraph: paragraph})
+}
+
+func listBlock(n *html.Node) bodyBlock {
+	listType := "UnorderedList"
+	if n.DataAtom == atom.Ol {
+		listType = "OrderedList"
+	}
+
+	list := &bodyList{Blocks: []bodyBlock{}}
+	for item := n.FirstChild; item != nil; item = item.NextSibling {
+		if item.Type != html.ElementNode || item.DataAtom != atom.Li {
+			continue
+		}
+		list.Blocks = append(list.Blocks, bodyBlock{Type: "ListItem", Blocks: listItemBlocks(item)})
+	}
+	return bodyBlock{Type: listType, List: list}
+}
+
+// listItemBlocks flattens the content of a list item. List items cannot hold paragraphs, so the text of paragraphs
+// inside loose Markdown lists is inlined and nested lists are kept as they are.
+func listItemBlocks(item *html.Node) []bodyBlock {
+	var blocks, inline []bodyBlock
+	flush := func() {
+		blocks = append(blocks, trimInlineBlocks(inline)...)
+		inline = nil
+	}
+
+	for child := item.FirstChild; child != nil; child = child.NextSibling {
+		if child.Type == html.ElementNode {
+			switch child.DataAtom {
+			case atom.Ul, atom.Ol:
+				flush()
+				blocks = append(blocks, listBlock(child))
+				continue
+			case atom.Iframe, atom.Video:
+				flush()
+				if block, ok := videoBlock(child); ok {
+					blocks = append(blocks, block)
+				}
+				continue
+			case atom.P, atom.Div:
+				if len(inline) > 0 {
+					inline = append(inline, bodyBlock{Type: "Text", Text: &bodyText{Text: "\n"}})
+				}
+				inline = append(inline, inlineChildren(child, nil, "")...)
+				continue
+			}
+		}
+		inline = append(inline, inlineBlocks(child, nil, "")...)
+	}
+	flush()
+
+	if len(blocks) == 0 {
+		blocks = []bodyBlock{{Type: "Text", Text: &bodyText{Text: ""}}}
+	}
+	return blocks
+}
+
+func tableBlock(n *html.Node) bodyBlock {
+	table := &bodyTable{Rows: []bodyTableRow{}}
+
+	var addRows func(*html.Node)
+	addRows = func(parent *html.Node) {
+		for child := parent.FirstChild; child != nil; child = child.NextSibling {
+			if child.Type != html.ElementNode {
+				continue
+			}
+			switch child.DataAtom {
+			case atom.Thead, atom.Tbody, atom.Tfoot:
+				addRows(child)
+			case atom.Tr:
+				table.Rows = append(table.Rows, tableRow(child))
+			}
+		}
+	}
+	addRows(n)
+
+	return bodyBlock{Type: "Table", Table: table}
+}
+
+func tableRow(n *html.Node) bodyTableRow {
+	row := bodyTableRow{Cells: []bodyTableCell{}}
+	for cell := n.FirstChild; cell != nil; cell = cell.NextSibling {
+		if cell.Type != html.ElementNode || (cell.DataAtom != atom.Td && cell.DataAtom != atom.Th) {
+			continue
+		}
+		cellType := "Cell"
+		if cell.DataAtom == atom.Th {
+			cellType = "HeaderCell"
+		}
+		content := trimInlineBlocks(inlineChildren(cell, nil, ""))
+		if content == nil {
+			content = []bodyBlock{}
+		}
+		row.Cells = append(row.Cells, bodyTableCell{
+			Blocks:     content,
+			Properties: &bodyTableCellProperties{CellType: cellType},
+		})
+	}
+	return row
+}
+
+func videoBlock(n *html.Node) (bodyBlock, bool) {
+	src := attr(n, "src")
+	if src == "" && n.DataAtom == atom.Video {
+		for child := n.FirstChild; child != nil; child = child.NextSibling {
+			if child.Type == html.ElementNode && child.DataAtom == atom.Source {
+				src = attr(child, "src")
+				break
+			}
+		}
+	}
+	if src == "" {
+		return bodyBlock{}, false
+	}
+	return bodyBlock{Type: "Video", Video: &bodyVideo{Url: src}}, true
+}
+
+func inlineChildren(n *html.Node, marks []string, hyperlink string) []bodyBlock {
+	var blocks []bodyBlock
+	for child := n.FirstChild; child != nil; child = child.NextSibling {
+		blocks = append(blocks, inlineBlocks(child, marks, hyperlink)...)
+	}
+	return blocks
+}
+
+// inlineBlocks converts inline content to Text and Image blocks, carrying the marks and link of the enclosing elements
+func inlineBlocks(n *html.Node, marks []string, hyperlink string) []bodyBlock {
+	switch n.Type {
+	case html.TextNode:
+		text := whitespaceRegex.ReplaceAllString(n.Data, " ")
+		if text == "" {
+			return nil
+		}
+		return []bodyBlock{{Type: "Text", Text: &bodyText{Text: text, Marks: marks, Hyperlink: hyperlink}}}
+	case html.ElementNode:
+	default:
+		return nil
+	}
+
+	switch n.DataAtom {
+	case atom.Strong, atom.B:
+		marks = withMark(marks, "Bold")
+	case atom.Em, atom.I:
+		marks = withMark(marks, "Italic")
+	case atom.U, atom.Ins:
+		marks = withMark(marks, "Underline")
+	case atom.A:
+		if href := attr(n, "href"); href != "" {
+			hyperlink = href
+		}
+	case atom.Br:
+		return []bodyBlock{{Type: "Text", Text: &bodyText{Text: "\n", Marks: marks, Hyperlink: hyperlink}}}
+	case atom.Img:
+		src := attr(n, "src")
+		if src == "" {
+			return nil
+		}
+		return []bodyBlock{{Type: "Image", Image: &bodyImage{Url: src, Hyperlink: hyperlink}}}
+	case atom.Script, atom.Style:
+		return nil
+	}
+	return inlineChildren(n, marks, hyperlink)
+}
+
+// trimInlineBlocks merges adjacent text with the same formatting and removes the whitespace left over from the
+// HTML source at the start and end of the content
+func trimInlineBlocks(blocks []bodyBlock) []bodyBlock {
+	var merged []bodyBlock
+	for _, block := range blocks {
+		if block.Type == "Text" && len(merged) > 0 {
+			last := &merged[len(merged)-1]
+			if last.Type == "Text" && last.Text.Hyperlink == block.Text.Hyperlink && strings.Join(last.Text.Marks, ",") == strings.Join(block.Text.Marks, ",") {
+				text := *last.Text
+				text.Text = joinText(text.Text, block.Text.Text)
+				last.Text = &text
+				continue
+			}
+			if last.Type == "Text" && strings.HasSuffix(last.Text.Text, " ") && strings.HasPrefix(block.Text.Text, " ") {
+				text := *block.Text
+				text.Text = strings.TrimPrefix(text.Text, " ")
+				block.Text = &text
+			}
+		}
+		merged = append(merged, block)
+	}
+
+	for len(merged) > 0 && merged[0].Type == "Text" {
+		text := *merged[0].Text
+		text.Text = strings.TrimLeft(text.Text, " \n")
+		if text.Text != "" {
+			merged[0].Text = &text
+			break
+		}
+		merged = merged[1:]
+	}
+	for len(merged) > 0 && merged[len(merged)-1].Type == "Text" {
+		text := *merged[len(merged)-1].Text
+		text.Text = strings.TrimRight(text.Text, " \n")
+		if text.Text != "" {
+			merged[len(merged)-1].Text = &text
+			break
+		}
+		merged = merged[:len(merged)-1]
+	}
+	return merged
+}
+
+// joinText concatenates two pieces of text, collapsing the whitespace where they meet
+func joinText(a, b string) string {
+	if strings.HasPrefix(b, "\n") {
+		return strings.TrimRight(a, " ") + b
+	}
+	if strings.HasSuffix(a, " ") && strings.HasPrefix(b, " ") {
+		return a + strings.TrimPrefix(b, " ")
+	}
+	return a + b
+}
+
+func withMark(marks []string, mark string) []string {
+	for _, m := range marks {
+		if m == mark {
+			return marks
+		}
+	}
+	result := make([]string, 0, len(marks)+1)
+	result = append(result, marks...)
+	return append(result, mark)
+}
+
+func textContent(n *html.Node) string {
+	if n.Type == html.TextNode {
+		return n.Data
+	}
+	var sb strings.Builder
+	for child := n.FirstChild; child != nil; child = child.NextSibling {
+		sb.WriteString(textContent(child))
+	}
+	return sb.String()
+}
+
+func attr(n *html.Node, key string) string {
+	for _, a := range n.Attr {
+		if a.Key == key {
+			return strings.TrimSpace(a.Val)
+		}
+	}
+	return ""
+}
@@ -0,0 +1,106 @@
+package knowledge_import
+
+import (
+	"time"
+
+	"github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/provider"
+	registrar "github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/resource_register"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const ResourceType = "genesyscloud_knowledge_import"
+
+// SetRegistrar registers all the resources in the package
+func SetRegistrar(l registrar.Registrar) {
+	l.RegisterResource(ResourceType, ResourceKnowledgeImport())
+}
+
+var importedDocumentResource = &schema.Resource{
+	Schema: map[string]*schema.Schema{
+		"path": {
+			Description: "Path of the article file, relative to the directory.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"title": {
+			Description: "Title of the document.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"document_id": {
+			Description: "ID of the knowledge document created from the article.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"content_hash": {
+			Description: "Hash of the converted article when it was last imported.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+	},
+}
+
+// ResourceKnowledgeImport registers the genesyscloud_knowledge_import resource with terraform
+func ResourceKnowledgeImport() *schema.Resource {
+	return &schema.Resource{
+		Description: `Genesys Cloud Knowledge Import publishes a directory of Markdown and HTML articles to a knowledge base using the knowledge import job API.
+
+Every ` + "`.md`, `.markdown`, `.html` and `.htm`" + ` file in the directory and its subdirectories becomes one document. A file may start with a YAML front matter block between ` + "`---`" + ` lines with the keys ` + "`title`, `category`, `labels`, `alternatives` and `visible`" + `. The title is required and must be unique within the directory. Categories and labels are referenced by name. Headings, paragraphs, bold, italic and underlined text, links, images, videos, lists, tables and preformatted text are converted to the document body.
+
+Only articles whose converted content changed are imported. The import job always creates a new document, so the previous document of a changed article is deleted afterwards and its ID changes. Documents of articles removed from the directory are deleted. Changes made to the documents outside of Terraform are not detected.`,
+
+		CreateContext: provider.CreateWithPooledClient(createKnowledgeImport),
+		ReadContext:   provider.ReadWithPooledClient(readKnowledgeImport),
+		UpdateContext: provider.UpdateWithPooledClient(updateKnowledgeImport),
+		DeleteContext: provider.DeleteWithPooledClient(deleteKnowledgeImport),
+		CustomizeDiff: customdiff.All(
+			customdiff.ComputedIf("content_hash", articlesDirectoryChanged),
+			customdiff.ComputedIf("documents", articlesDirectoryChanged),
+		),
+		SchemaVersion: 1,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			"knowledge_base_id": {
+				Description: "Knowledge base ID.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"directory": {
+				Description:  "Path to the directory containing the articles.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+			"visible": {
+				Description: "Default visibility of the documents. Overridden by the `visible` key of the front matter of an article.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+			},
+			"content_hash": {
+				Description: "Hash of the content of the directory. Used to detect changes. Empty when an import failed.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+			},
+			"import_job_id": {
+				Description: "ID of the last import job.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"documents": {
+				Description: "The documents managed by this resource.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        importedDocumentResource,
+			},
+		},
+	}
+}
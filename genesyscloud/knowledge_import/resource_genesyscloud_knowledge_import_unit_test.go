@@ -0,0 +1,178 @@
+package knowledge_import
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/mypurecloud/platform-client-sdk-go/v192/platformclientv2"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnitConvertArticleMarkdown(t *testing.T) {
+	content := `---
+title: Reset your password
+category: Accounts
+labels: [self-service]
+alternatives:
+  - forgot password
+visible: false
+---
+# Reset your password
+
+Open the **portal** and select [Reset](https://example.com/reset).
+
+- First step
+- Second step
+`
+	document, err := convertArticle("accounts/reset.md", []byte(content), true)
+	assert.NoError(t, err)
+
+	assert.Equal(t, "Reset your password", document.Title)
+	assert.False(t, document.Visible)
+	assert.Equal(t, &namedReference{Name: "Accounts"}, document.Category)
+	assert.Equal(t, []namedReference{{Name: "self-service"}}, document.Labels)
+	assert.Equal(t, []importAlternative{{Phrase: "forgot password", Autocomplete: true}}, document.Alternatives)
+
+	blocks := document.Variations[0].Body.Blocks
+	assert.Len(t, blocks, 3)
+	assert.Equal(t, "Heading1", blocks[0].Paragraph.Properties.FontType)
+	assert.Equal(t, []bodyBlock{
+		{Type: "Text", Text: &bodyText{Text: "Open the "}},
+		{Type: "Text", Text: &bodyText{Text: "portal", Marks: []string{"Bold"}}},
+		{Type: "Text", Text: &bodyText{Text: " and select "}},
+		{Type: "Text", Text: &bodyText{Text: "Reset", Hyperlink: "https://example.com/reset"}},
+		{Type: "Text", Text: &bodyText{Text: "."}},
+	}, blocks[1].Paragraph.Blocks)
+	assert.Equal(t, "UnorderedList", blocks[2].Type)
+	assert.Len(t, blocks[2].List.Blocks, 2)
+	assert.Equal(t, "Second step", blocks[2].List.Blocks[1].Blocks[0].Text.Text)
+}
+
+func TestUnitConvertArticleHtml(t *testing.T) {
+	content := `<h2>Supported devices</h2>
+<table>
+  <tr><th>Device</th><th>Version</th></tr>
+  <tr><td>Phone</td><td><em>12</em> or later</td></tr>
+</table>
+<pre>dial *99#
+  then wait</pre>
+<iframe src="https://example.com/video"></iframe>`
+
+	// The title is required
+	_, err := convertArticle("devices.html", []byte(content), true)
+	assert.Error(t, err)
+
+	document, err := convertArticle("devices.html", []byte("---\ntitle: Devices\n---\n"+content), true)
+	assert.NoError(t, err)
+	assert.True(t, document.Visible)
+
+	blocks := document.Variations[0].Body.Blocks
+	assert.Len(t, blocks, 4)
+	assert.Equal(t, "Heading2", blocks[0].Paragraph.Properties.FontType)
+
+	assert.Equal(t, "Table", blocks[1].Type)
+	assert.Len(t, blocks[1].Table.Rows, 2)
+	assert.Equal(t, "HeaderCell", blocks[1].Table.Rows[0].Cells[0].Properties.CellType)
+	assert.Equal(t, []bodyBlock{
+		{Type: "Text", Text: &bodyText{Text: "12", Marks: []string{"Italic"}}},
+		{Type: "Text", Text: &bodyText{Text: " or later"}},
+	}, blocks[1].Table.Rows[1].Cells[1].Blocks)
+
+	assert.Equal(t, "Preformatted", blocks[2].Paragraph.Properties.FontType)
+	assert.Equal(t, "dial *99#\n  then wait", blocks[2].Paragraph.Blocks[0].Text.Text)
+	assert.Equal(t, &bodyVideo{Url: "https://example.com/video"}, blocks[3].Video)
+
+	// Unknown front matter keys are rejected
+	_, err = convertArticle("devices.html", []byte("---\ntitel: Devices\n---\n"+content), true)
+	assert.Error(t, err)
+}
+
+func TestUnitReadArticlesDuplicateTitles(t *testing.T) {
+	directory := t.TempDir()
+	assert.NoError(t, os.MkdirAll(filepath.Join(directory, "billing"), 0755))
+	assert.NoError(t, os.WriteFile(filepath.Join(directory, "a.md"), []byte("---\ntitle: Invoices\n---\nText"), 0644))
+	assert.NoError(t, os.WriteFile(filepath.Join(directory, "billing", "b.html"), []byte("---\ntitle: Invoices\n---\n<p>Text</p>"), 0644))
+	assert.NoError(t, os.WriteFile(filepath.Join(directory, "notes.txt"), []byte("not an article"), 0644))
+
+	paths, err := listArticleFiles(directory)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"a.md", "billing/b.html"}, paths)
+
+	_, err = readArticles(directory, true)
+	assert.ErrorContains(t, err, "same title")
+}
+
+func TestUnitImportArticles(t *testing.T) {
+	unchanged := article{Path: "unchanged.md", Document: importDocument{Title: "Unchanged"}, Hash: "hash-unchanged"}
+	changed := article{Path: "changed.md", Document: importDocument{Title: "Changed"}, Hash: "hash-new"}
+	added := article{Path: "added.md", Document: importDocument{Title: "Added"}, Hash: "hash-added"}
+
+	unchangedId := uuid.NewString()
+	oldChangedId := uuid.NewString()
+	removedId := uuid.NewString()
+	previous := map[string]importedDocumentState{
+		unchanged.Path: {Title: "Unchanged", DocumentId: unchangedId, Hash: unchanged.Hash},
+		changed.Path:   {Title: "Changed", DocumentId: oldChangedId, Hash: "hash-old"},
+		"removed.md":   {Title: "Removed", DocumentId: removedId, Hash: "hash-removed"},
+	}
+
+	newChangedId := uuid.NewString()
+	addedId := uuid.NewString()
+	documents := []documentSummary{
+		{Id: unchangedId, Title: "Unchanged"},
+		{Id: oldChangedId, Title: "Changed"},
+		{Id: removedId, Title: "Removed"},
+		// A document with the same title that is not managed by the resource
+		{Id: uuid.NewString(), Title: "Added"},
+	}
+
+	var (
+		importedTitles []string
+		deletedIds     []string
+	)
+	proxy := &knowledgeImportProxy{
+		getAllDocumentsAttr: func(ctx context.Context, p *knowledgeImportProxy, knowledgeBaseId string) ([]documentSummary, *platformclientv2.APIResponse, error) {
+			return documents, nil, nil
+		},
+		createDocumentUploadAttr: func(ctx context.Context, p *knowledgeImportProxy, fileName string) (*documentUploadResponse, *platformclientv2.APIResponse, error) {
+			return &documentUploadResponse{UploadKey: "upload-key"}, nil, nil
+		},
+		uploadImportFileAttr: func(ctx context.Context, p *knowledgeImportProxy, upload *documentUploadResponse, content []byte) error {
+			var file importFile
+			assert.NoError(t, json.Unmarshal(content, &file))
+			for _, document := range file.Documents {
+				importedTitles = append(importedTitles, document.Title)
+			}
+			return nil
+		},
+		createImportJobAttr: func(ctx context.Context, p *knowledgeImportProxy, knowledgeBaseId string, body *importJobRequest) (*importJobResponse, *platformclientv2.APIResponse, error) {
+			assert.Equal(t, "upload-key", body.UploadKey)
+			return &importJobResponse{Id: "job-1", Status: "Started"}, nil, nil
+		},
+		getImportJobAttr: func(ctx context.Context, p *knowledgeImportProxy, knowledgeBaseId string, jobId string) (*importJobResponse, *platformclientv2.APIResponse, error) {
+			documents = append(documents, documentSummary{Id: newChangedId, Title: "Changed"}, documentSummary{Id: addedId, Title: "Added"})
+			return &importJobResponse{Id: jobId, Status: "Completed"}, nil, nil
+		},
+		deleteDocumentAttr: func(ctx context.Context, p *knowledgeImportProxy, knowledgeBaseId string, documentId string) (*platformclientv2.APIResponse, error) {
+			deletedIds = append(deletedIds, documentId)
+			return nil, nil
+		},
+	}
+
+	states, jobId, diags := importArticles(context.Background(), proxy, "kb-1", []article{unchanged, changed, added}, previous, time.Minute)
+	assert.False(t, diags.HasError(), diags)
+	assert.Equal(t, "job-1", jobId)
+	assert.Equal(t, []string{"Changed", "Added"}, importedTitles)
+
+	assert.Equal(t, previous[unchanged.Path], states[unchanged.Path])
+	assert.Equal(t, importedDocumentState{Title: "Changed", DocumentId: newChangedId, Hash: changed.Hash}, states[changed.Path])
+	assert.Equal(t, importedDocumentState{Title: "Added", DocumentId: addedId, Hash: added.Hash}, states[added.Path])
+	assert.NotContains(t, states, "removed.md")
+
+	assert.ElementsMatch(t, []string{oldChangedId, removedId}, deletedIds)
+}
@@ -0,0 +1,290 @@
+package knowledge_import
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/util"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"gopkg.in/yaml.v3"
+)
+
+const frontMatterDelimiter = "---"
+
+// article is a single Markdown or HTML file of the directory converted to a knowledge document
+type article struct {
+	Path     string
+	Document importDocument
+	Hash     string
+}
+
+// articleFrontMatter is the YAML block at the start of an article
+type articleFrontMatter struct {
+	Title        string   `yaml:"title"`
+	Category     string   `yaml:"category"`
+	Labels       []string `yaml:"labels"`
+	Alternatives []string `yaml:"alternatives"`
+	Visible      *bool    `yaml:"visible"`
+}
+
+// importFile is the content of the JSON file sent to the knowledge import job
+type importFile struct {
+	KnowledgeBase entityReference  `json:"knowledgeBase"`
+	Documents     []importDocument `json:"documents"`
+}
+
+type importDocument struct {
+	Title        string              `json:"title"`
+	Visible      bool                `json:"visible"`
+	Category     *namedReference     `json:"category,omitempty"`
+	Labels       []namedReference    `json:"labels,omitempty"`
+	Alternatives []importAlternative `json:"alternatives,omitempty"`
+	Variations   []importVariation   `json:"variations"`
+}
+
+type namedReference struct {
+	Name string `json:"name"`
+}
+
+type importAlternative struct {
+	Phrase       string `json:"phrase"`
+	Autocomplete bool   `json:"autocomplete"`
+}
+
+type importVariation struct {
+	Body importVariationBody `json:"body"`
+}
+
+type importVariationBody struct {
+	Blocks []bodyBlock `json:"blocks"`
+}
+
+// importedDocumentState is what the resource remembers about an article it imported
+type importedDocumentState struct {
+	Title      string
+	DocumentId string
+	Hash       string
+}
+
+// isArticleFile reports whether a file of the directory is an article
+func isArticleFile(path string) bool {
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".md", ".markdown", ".html", ".htm":
+		return true
+	}
+	return false
+}
+
+// listArticleFiles returns the paths of the articles in a directory, relative to the directory and using forward
+// slashes so that the state does not depend on the operating system
+func listArticleFiles(directory string) ([]string, error) {
+	var paths []string
+	err := filepath.WalkDir(directory, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if entry.IsDir() {
+			if path != directory && strings.HasPrefix(entry.Name(), ".") {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if !isArticleFile(path) {
+			return nil
+		}
+		relPath, err := filepath.Rel(directory, path)
+		if err != nil {
+			return err
+		}
+		paths = append(paths, filepath.ToSlash(relPath))
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(paths)
+	return paths, nil
+}
+
+// hashArticlesDirectory hashes the paths and content of the articles in a directory
+func hashArticlesDirectory(directory string) (string, error) {
+	paths, err := listArticleFiles(directory)
+	if err != nil {
+		return "", err
+	}
+
+	hash := sha256.New()
+	for _, path := range paths {
+		content, err := os.ReadFile(filepath.Join(directory, filepath.FromSlash(path)))
+		if err != nil {
+			return "", err
+		}
+		contentHash := sha256.Sum256(content)
+		fmt.Fprintf(hash, "%s\x00%x\n", path, contentHash)
+	}
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+// articlesDirectoryChanged marks the computed attributes as unknown when the articles or the default visibility changed
+func articlesDirectoryChanged(ctx context.Context, d *schema.ResourceDiff, meta any) bool {
+	if d.HasChange("visible") {
+		return true
+	}
+
+	directory := d.Get("directory").(string)
+	if directory == "" {
+		return false
+	}
+	newHash, err := hashArticlesDirectory(directory)
+	if err != nil {
+		log.Printf("Error calculating hash of articles directory %s: %v", directory, err)
+		return false
+	}
+	return d.Get("content_hash").(string) != newHash
+}
+
+// readArticles reads and converts all articles in a directory
+func readArticles(directory string, defaultVisible bool) ([]article, error) {
+	paths, err := listArticleFiles(directory)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list articles in %s: %w", directory, err)
+	}
+
+	var (
+		articles []article
+		titles   = make(map[string]string)
+	)
+	for _, path := range paths {
+		content, err := os.ReadFile(filepath.Join(directory, filepath.FromSlash(path)))
+		if err != nil {
+			return nil, fmt.Errorf("failed to read article %s: %w", path, err)
+		}
+
+		document, err := convertArticle(path, content, defaultVisible)
+		if err != nil {
+			return nil, fmt.Errorf("failed to convert article %s: %w", path, err)
+		}
+		if otherPath, ok := titles[document.Title]; ok {
+			return nil, fmt.Errorf("articles %s and %s have the same title %q", otherPath, path, document.Title)
+		}
+		titles[document.Title] = path
+
+		hash, err := util.QuickHashFields(document)
+		if err != nil {
+			return nil, fmt.Errorf("failed to hash article %s: %w", path, err)
+		}
+		articles = append(articles, article{Path: path, Document: document, Hash: hash})
+	}
+	return articles, nil
+}
+
+// convertArticle converts the content of an article file to a knowledge document
+func convertArticle(path string, content []byte, defaultVisible bool) (importDocument, error) {
+	frontMatter, body, err := splitFrontMatter(content)
+	if err != nil {
+		return importDocument{}, err
+	}
+	if frontMatter.Title == "" {
+		return importDocument{}, fmt.Errorf("the front matter must set a title")
+	}
+
+	ext := strings.ToLower(filepath.Ext(path))
+	if ext == ".md" || ext == ".markdown" {
+		if body, err = markdownToHtml(body); err != nil {
+			return importDocument{}, fmt.Errorf("failed to render markdown: %w", err)
+		}
+	}
+	blocks, err := htmlToBodyBlocks(body)
+	if err != nil {
+		return importDocument{}, err
+	}
+
+	document := importDocument{
+		Title:      frontMatter.Title,
+		Visible:    defaultVisible,
+		Variations: []importVariation{{Body: importVariationBody{Blocks: blocks}}},
+	}
+	if frontMatter.Visible != nil {
+		document.Visible = *frontMatter.Visible
+	}
+	if frontMatter.Category != "" {
+		document.Category = &namedReference{Name: frontMatter.Category}
+	}
+	for _, label := range frontMatter.Labels {
+		document.Labels = append(document.Labels, namedReference{Name: label})
+	}
+	for _, phrase := range frontMatter.Alternatives {
+		document.Alternatives = append(document.Alternatives, importAlternative{Phrase: phrase, Autocomplete: true})
+	}
+	return document, nil
+}
+
+// splitFrontMatter separates the YAML front matter from the body of an article. Unknown keys are rejected so that
+// typos do not silently drop data.
+func splitFrontMatter(content []byte) (articleFrontMatter, []byte, error) {
+	var frontMatter articleFrontMatter
+
+	content = bytes.TrimPrefix(content, []byte("\xef\xbb\xbf"))
+	lines := strings.SplitAfter(string(content), "\n")
+	if len(lines) == 0 || strings.TrimSpace(lines[0]) != frontMatterDelimiter {
+		return frontMatter, content, nil
+	}
+
+	for i := 1; i < len(lines); i++ {
+		if strings.TrimSpace(lines[i]) != frontMatterDelimiter {
+			continue
+		}
+		decoder := yaml.NewDecoder(strings.NewReader(strings.Join(lines[1:i], "")))
+		decoder.KnownFields(true)
+		if err := decoder.Decode(&frontMatter); err != nil && !errors.Is(err, io.EOF) {
+			return frontMatter, nil, fmt.Errorf("invalid front matter: %w", err)
+		}
+		return frontMatter, []byte(strings.Join(lines[i+1:], "")), nil
+	}
+	return frontMatter, nil, fmt.Errorf("the front matter is not closed with a %s line", frontMatterDelimiter)
+}
+
+func buildImportedDocumentStates(d *schema.ResourceData) map[string]importedDocumentState {
+	states := make(map[string]importedDocumentState)
+	for _, item := range d.Get("documents").([]interface{}) {
+		documentMap := item.(map[string]interface{})
+		states[documentMap["path"].(string)] = importedDocumentState{
+			Title:      documentMap["title"].(string),
+			DocumentId: documentMap["document_id"].(string),
+			Hash:       documentMap["content_hash"].(string),
+		}
+	}
+	return states
+}
+
+func flattenImportedDocumentStates(states map[string]importedDocumentState) []interface{} {
+	paths := make([]string, 0, len(states))
+	for path := range states {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+
+	documents := make([]interface{}, 0, len(states))
+	for _, path := range paths {
+		state := states[path]
+		documents = append(documents, map[string]interface{}{
+			"path":         path,
+			"title":        state.Title,
+			"document_id":  state.DocumentId,
+			"content_hash": state.Hash,
+		})
+	}
+	return documents
+}
@@ -22,6 +22,7 @@ import (
 	userPrompt "github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/architect_user_prompt"
 	authDivision "github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/auth_division"
 	authRole "github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/auth_role"
+	authorizationPolicy "github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/authorization_policy"
 	authorizatioProduct "github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/authorization_product"
 	bcpTfExporter "github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/bcp_tf_exporter"
 	businessRulesDecisionTable "github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/business_rules_decision_table"
@@ -38,12 +39,17 @@ import (
 	conversationsSettings "github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/conversations_settings"
 	employeeperformanceExternalmetricsDefinition "github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/employeeperformance_externalmetrics_definitions"
 	externalContacts "github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/external_contacts"
+	externalContactsContactSchema "github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/external_contacts_contact_schema"
 	externalSource "github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/external_contacts_external_source"
 	externalOrganization "github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/external_contacts_organization"
+	externalOrganizationSchema "github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/external_contacts_organization_schema"
+	externalRelationship "github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/external_contacts_relationship"
 	externalUser "github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/external_user"
 	flowLogLevel "github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/flow_loglevel"
 	flowMilestone "github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/flow_milestone"
 	flowOutcome "github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/flow_outcome"
+	gamificationMetric "github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/gamification_metric"
+	gamificationProfile "github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/gamification_profile"
 	greeting "github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/greeting"
 	greetingUser "github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/greeting_user"
 	"github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/group"
@@ -76,6 +82,7 @@ import (
 	knowledgeCategory "github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/knowledge_category"
 	knowledgeDocument "github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/knowledge_document"
 	knowledgeDocumentVariation "github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/knowledge_document_variation"
+	knowledgeImport "github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/knowledge_import"
 	knowledgeKnowledgebase "github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/knowledge_knowledgebase"
 	knowledgeLabel "github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/knowledge_label"
 	learningModules "github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/learning_modules"
@@ -105,6 +112,7 @@ import (
 	qualityFormsEvaluation "github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/quality_forms_evaluation"
 	qualityFormsSurvey "github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/quality_forms_survey"
 	recMediaRetPolicy "github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/recording_media_retention_policy"
+	recordingSettings "github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/recording_settings"
 	resourceExporter "github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/resource_exporter"
 	registrar "github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/resource_register"
 	respmanagementLibrary "github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/responsemanagement_library"
@@ -113,6 +121,7 @@ import (
 	routingEmailDomain "github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/routing_email_domain"
 	routingEmailRoute "github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/routing_email_route"
 	routingLanguage "github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/routing_language"
+	routingPredictor "github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/routing_predictor"
 	routingQueue "github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/routing_queue"
 	routingQueueConditionalGroupActivation "github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/routing_queue_conditional_group_activation"
 	routingQueueConditionalGroupRouting "github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/routing_queue_conditional_group_routing"
@@ -152,6 +161,7 @@ import (
 	tfexp "github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/tfexporter"
 	"github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/user"
 	userRoles "github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/user_roles"
+	usersBulk "github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/users_bulk"
 	usersRules "github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/users_rules"
 	webDeployConfig "github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/webdeployments_configuration"
 	webDeployDeploy "github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/webdeployments_deployment"
@@ -256,6 +266,7 @@ func registerResources() {
 	aiStudioSummarySetting.SetRegistrar(regInstance)                       //Registering aiStudioSummarySetting
 	authRole.SetRegistrar(regInstance)                                     //Registering auth_role
 	authDivision.SetRegistrar(regInstance)                                 //Registering auth_division
+	authorizationPolicy.SetRegistrar(regInstance)                          //Registering authorization_policy
 	oauth.SetRegistrar(regInstance)                                        //Registering oauth_client
 	dt.SetRegistrar(regInstance)                                           //Registering architect data table
 	dtr.SetRegistrar(regInstance)                                          //Registering architect data table row
@@ -265,6 +276,8 @@ func registerResources() {
 	dictionaryFeedback.SetRegistrar(regInstance)                           //Registering dictionary feedback
 	sttTopic.SetRegistrar(regInstance)                                     //Registering speech and text analytics topics
 	employeeperformanceExternalmetricsDefinition.SetRegistrar(regInstance) //Registering employee performance external metrics definitions
+	gamificationProfile.SetRegistrar(regInstance)                          //Registering gamification profile
+	gamificationMetric.SetRegistrar(regInstance)                           //Registering gamification metric
 	grammar.SetRegistrar(regInstance)                                      //Registering architect grammar
 	grammarLanguage.SetRegistrar(regInstance)                              //Registering architect grammar language
 	groupGreeting.SetRegistrar(regInstance)                                //Registering group greeting
@@ -325,6 +338,7 @@ func registerResources() {
 	caseManagementStageplan.SetRegistrar(regInstance)                      //Registering case management stageplan
 	caseManagementStepplan.SetRegistrar(regInstance)                       //Registering case management stepplan
 	recMediaRetPolicy.SetRegistrar(regInstance)                            //Registering recording media retention policies
+	recordingSettings.SetRegistrar(regInstance)                            //Registering recording settings
 	responsemanagementResponse.SetRegistrar(regInstance)                   //Registering responsemanagement responses
 	responsemanagementResponseasset.SetRegistrar(regInstance)              //Registering responsemanagement response asset
 	respmanagementLibrary.SetRegistrar(regInstance)                        //Registering responsemanagement library
@@ -362,6 +376,7 @@ func registerResources() {
 	group.SetRegistrar(regInstance)                                        //Registering group
 	userPrompt.SetRegistrar(regInstance)                                   //Registering user prompt
 	routingQueue.SetRegistrar(regInstance)                                 //Registering routing queue
+	routingPredictor.SetRegistrar(regInstance)                             //Registering routing predictor
 	routingQueueConditionalGroupActivation.SetRegistrar(regInstance)       //Registering routing queue conditional group activation
 	routingQueueConditionalGroupRouting.SetRegistrar(regInstance)          //Registering routing queue conditional group routing
 	routingQueueOutboundEmailAddress.SetRegistrar(regInstance)             //Registering routing queue outbound email address
@@ -388,8 +403,12 @@ func registerResources() {
 	location.SetRegistrar(regInstance)                                     //Registering location
 	knowledgeDocument.SetRegistrar(regInstance)                            //Registering knowledge document
 	knowledgeDocumentVariation.SetRegistrar(regInstance)                   //Registering knowledge document variation
+	knowledgeImport.SetRegistrar(regInstance)                              //Registering knowledge import
 	externalOrganization.SetRegistrar(regInstance)                         //Registering external organization
 	externalSource.SetRegistrar(regInstance)                               //Registering external source
+	externalContactsContactSchema.SetRegistrar(regInstance)                //Registering external contacts contact schema
+	externalOrganizationSchema.SetRegistrar(regInstance)                   //Registering external organization schema
+	externalRelationship.SetRegistrar(regInstance)                         //Registering external contacts relationship
 	knowledgeCategory.SetRegistrar(regInstance)                            //Registering knowledge category
 	knowledgeLabel.SetRegistrar(regInstance)                               //Registering Knowledge Label
 	knowledgeKnowledgebase.SetRegistrar(regInstance)                       //Registering Knowledge base
@@ -400,6 +419,7 @@ func registerResources() {
 	workforcemanagementBusinessunits.SetRegistrar(regInstance)             //Registering workforcemanagement businessunits
 	learningModules.SetRegistrar(regInstance)                              //Registering learning modules
 	usersRules.SetRegistrar(regInstance)                                   //Registering users rules
+	usersBulk.SetRegistrar(regInstance)                                    //Registering users bulk
 	// setting resources for Use cases  like TF export where provider is used in resource classes.
 	tfexp.SetRegistrar(regInstance)         //Registering tf exporter
 	bcpTfExporter.SetRegistrar(regInstance) //Registering bcp tf exporter
@@ -0,0 +1,114 @@
+package recording_settings
+
+import (
+	"context"
+
+	"github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/provider"
+
+	"github.com/mypurecloud/platform-client-sdk-go/v192/platformclientv2"
+)
+
+var internalProxy *recordingSettingsProxy
+
+type getRecordingSettingsFunc func(ctx context.Context, p *recordingSettingsProxy) (*platformclientv2.Recordingsettings, *platformclientv2.APIResponse, error)
+type updateRecordingSettingsFunc func(ctx context.Context, p *recordingSettingsProxy, settings *platformclientv2.Recordingsettings) (*platformclientv2.Recordingsettings, *platformclientv2.APIResponse, error)
+type getKeyRotationScheduleFunc func(ctx context.Context, p *recordingSettingsProxy) (*platformclientv2.Keyrotationschedule, *platformclientv2.APIResponse, error)
+type updateKeyRotationScheduleFunc func(ctx context.Context, p *recordingSettingsProxy, schedule *platformclientv2.Keyrotationschedule) (*platformclientv2.Keyrotationschedule, *platformclientv2.APIResponse, error)
+type getKeyConfigurationsFunc func(ctx context.Context, p *recordingSettingsProxy) (*[]platformclientv2.Recordingencryptionconfiguration, *platformclientv2.APIResponse, error)
+
+// recordingSettingsProxy contains all of the methods that call genesys cloud APIs.
+type recordingSettingsProxy struct {
+	clientConfig                  *platformclientv2.Configuration
+	recordingApi                  *platformclientv2.RecordingApi
+	getRecordingSettingsAttr      getRecordingSettingsFunc
+	updateRecordingSettingsAttr   updateRecordingSettingsFunc
+	getKeyRotationScheduleAttr    getKeyRotationScheduleFunc
+	updateKeyRotationScheduleAttr updateKeyRotationScheduleFunc
+	getKeyConfigurationsAttr      getKeyConfigurationsFunc
+}
+
+// newRecordingSettingsProxy initializes the recording settings proxy with all of the data needed to communicate with Genesys Cloud
+func newRecordingSettingsProxy(clientConfig *platformclientv2.Configuration) *recordingSettingsProxy {
+	api := platformclientv2.NewRecordingApiWithConfig(clientConfig)
+	return &recordingSettingsProxy{
+		clientConfig:                  clientConfig,
+		recordingApi:                  api,
+		getRecordingSettingsAttr:      getRecordingSettingsFn,
+		updateRecordingSettingsAttr:   updateRecordingSettingsFn,
+		getKeyRotationScheduleAttr:    getKeyRotationScheduleFn,
+		updateKeyRotationScheduleAttr: updateKeyRotationScheduleFn,
+		getKeyConfigurationsAttr:      getKeyConfigurationsFn,
+	}
+}
+
+// getRecordingSettingsProxy acts as a singleton to for the internalProxy. It also ensures
+// that we can still proxy our tests by directly setting internalProxy package variable
+func getRecordingSettingsProxy(clientConfig *platformclientv2.Configuration) *recordingSettingsProxy {
+	if internalProxy == nil {
+		internalProxy = newRecordingSettingsProxy(clientConfig)
+	}
+	return internalProxy
+}
+
+// getRecordingSettings retrieves the Genesys Cloud organization recording settings
+func (p *recordingSettingsProxy) getRecordingSettings(ctx context.Context) (*platformclientv2.Recordingsettings, *platformclientv2.APIResponse, error) {
+	return p.getRecordingSettingsAttr(ctx, p)
+}
+
+// updateRecordingSettings updates the Genesys Cloud organization recording settings
+func (p *recordingSettingsProxy) updateRecordingSettings(ctx context.Context, settings *platformclientv2.Recordingsettings) (*platformclientv2.Recordingsettings, *platformclientv2.APIResponse, error) {
+	return p.updateRecordingSettingsAttr(ctx, p, settings)
+}
+
+// getKeyRotationSchedule retrieves the Genesys Cloud recording key rotation schedule
+func (p *recordingSettingsProxy) getKeyRotationSchedule(ctx context.Context) (*platformclientv2.Keyrotationschedule, *platformclientv2.APIResponse, error) {
+	return p.getKeyRotationScheduleAttr(ctx, p)
+}
+
+// updateKeyRotationSchedule updates the Genesys Cloud recording key rotation schedule
+func (p *recordingSettingsProxy) updateKeyRotationSchedule(ctx context.Context, schedule *platformclientv2.Keyrotationschedule) (*platformclientv2.Keyrotationschedule, *platformclientv2.APIResponse, error) {
+	return p.updateKeyRotationScheduleAttr(ctx, p, schedule)
+}
+
+// getKeyConfigurations retrieves the Genesys Cloud recording encryption key configurations
+func (p *recordingSettingsProxy) getKeyConfigurations(ctx context.Context) (*[]platformclientv2.Recordingencryptionconfiguration, *platformclientv2.APIResponse, error) {
+	return p.getKeyConfigurationsAttr(ctx, p)
+}
+
+// getRecordingSettingsFn is the implementation for retrieving the recording settings from Genesys Cloud
+func getRecordingSettingsFn(ctx context.Context, p *recordingSettingsProxy) (*platformclientv2.Recordingsettings, *platformclientv2.APIResponse, error) {
+	ctx = provider.EnsureResourceContext(ctx, ResourceType)
+	return p.recordingApi.GetRecordingSettings(false)
+}
+
+// updateRecordingSettingsFn is the implementation for updating the recording settings in Genesys Cloud
+func updateRecordingSettingsFn(ctx context.Context, p *recordingSettingsProxy, settings *platformclientv2.Recordingsettings) (*platformclientv2.Recordingsettings, *platformclientv2.APIResponse, error) {
+	ctx = provider.EnsureResourceContext(ctx, ResourceType)
+	return p.recordingApi.PutRecordingSettings(*settings)
+}
+
+// getKeyRotationScheduleFn is the implementation for retrieving the recording key rotation schedule from Genesys Cloud
+func getKeyRotationScheduleFn(ctx context.Context, p *recordingSettingsProxy) (*platformclientv2.Keyrotationschedule, *platformclientv2.APIResponse, error) {
+	ctx = provider.EnsureResourceContext(ctx, ResourceType)
+	return p.recordingApi.GetRecordingRecordingkeysRotationschedule()
+}
+
+// updateKeyRotationScheduleFn is the implementation for updating the recording key rotation schedule in Genesys Cloud
+func updateKeyRotationScheduleFn(ctx context.Context, p *recordingSettingsProxy, schedule *platformclientv2.Keyrotationschedule) (*platformclientv2.Keyrotationschedule, *platformclientv2.APIResponse, error) {
+	ctx = provider.EnsureResourceContext(ctx, ResourceType)
+	return p.recordingApi.PutRecordingRecordingkeysRotationschedule(*schedule)
+}
+
+// getKeyConfigurationsFn is the implementation for retrieving the recording encryption key configurations from Genesys Cloud
+func getKeyConfigurationsFn(ctx context.Context, p *recordingSettingsProxy) (*[]platformclientv2.Recordingencryptionconfiguration, *platformclientv2.APIResponse, error) {
+	ctx = provider.EnsureResourceContext(ctx, ResourceType)
+
+	keyConfigurations, resp, err := p.recordingApi.GetRecordingKeyconfigurations()
+	if err != nil {
+		return nil, resp, err
+	}
+	if keyConfigurations.Entities == nil {
+		return &[]platformclientv2.Recordingencryptionconfiguration{}, resp, nil
+	}
+	return keyConfigurations.Entities, resp, nil
+}
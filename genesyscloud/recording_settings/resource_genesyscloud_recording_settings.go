@@ -0,0 +1,152 @@
+package recording_settings
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/consistency_checker"
+	"github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/provider"
+	resourceExporter "github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/resource_exporter"
+	"github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/util"
+	"github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/util/constants"
+	"github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/util/resourcedata"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mypurecloud/platform-client-sdk-go/v192/platformclientv2"
+)
+
+/*
+The resource_genesyscloud_recording_settings.go contains all the methods that perform the core logic for a resource.
+*/
+
+// getAllRecordingSettings retrieves the recording settings for export
+func getAllRecordingSettings(ctx context.Context, clientConfig *platformclientv2.Configuration) (resourceExporter.ResourceIDMetaMap, diag.Diagnostics) {
+	// Although this resource only has a single instance, we fetch the settings
+	// to verify the user's permission to access this resource's API endpoint(s).
+	proxy := getRecordingSettingsProxy(clientConfig)
+	resources := make(resourceExporter.ResourceIDMetaMap)
+
+	_, resp, err := proxy.getRecordingSettings(ctx)
+	if err != nil {
+		if util.IsStatus404(resp) {
+			// Don't export if config doesn't exist
+			return resources, nil
+		}
+		return nil, util.BuildAPIDiagnosticError(ResourceType, fmt.Sprintf("Failed to get %s due to error: %s", ResourceType, err), resp)
+	}
+
+	resources[ResourceType] = &resourceExporter.ResourceMeta{BlockLabel: "recording_settings"}
+	return resources, nil
+}
+
+// createRecordingSettings creates the recording settings resource
+func createRecordingSettings(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	log.Printf("Creating Recording Settings")
+	d.SetId("settings")
+	return updateRecordingSettings(ctx, d, meta)
+}
+
+// readRecordingSettings reads the recording settings, key rotation schedule and key configurations from the API
+func readRecordingSettings(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sdkConfig := meta.(*provider.ProviderMeta).ClientConfig
+	proxy := getRecordingSettingsProxy(sdkConfig)
+	cc := consistency_checker.NewConsistencyCheck(ctx, d, meta, ResourceRecordingSettings(), constants.ConsistencyChecks(), ResourceType)
+
+	log.Printf("Reading recording settings")
+
+	return util.WithRetriesForRead(ctx, d, func() *retry.RetryError {
+		settings, resp, getErr := proxy.getRecordingSettings(ctx)
+		if getErr != nil {
+			diagErr := util.BuildWithRetriesApiDiagnosticError(ResourceType, fmt.Sprintf("Failed to read recording settings | error: %s", getErr), resp)
+			if util.IsStatus404(resp) {
+				return retry.RetryableError(diagErr)
+			}
+			return retry.NonRetryableError(diagErr)
+		}
+
+		schedule, resp, getErr := proxy.getKeyRotationSchedule(ctx)
+		if getErr != nil {
+			return retry.NonRetryableError(util.BuildWithRetriesApiDiagnosticError(ResourceType, fmt.Sprintf("Failed to read recording key rotation schedule | error: %s", getErr), resp))
+		}
+
+		keyConfigurations, resp, getErr := proxy.getKeyConfigurations(ctx)
+		if getErr != nil {
+			return retry.NonRetryableError(util.BuildWithRetriesApiDiagnosticError(ResourceType, fmt.Sprintf("Failed to read recording key configurations | error: %s", getErr), resp))
+		}
+
+		resourcedata.SetNillableValue(d, "max_simultaneous_streams", settings.MaxSimultaneousStreams)
+		resourcedata.SetNillableValue(d, "max_configurable_screen_recording_streams", settings.MaxConfigurableScreenRecordingStreams)
+		resourcedata.SetNillableValue(d, "regional_recording_storage_enabled", settings.RegionalRecordingStorageEnabled)
+		resourcedata.SetNillableValue(d, "recording_playback_url_ttl", settings.RecordingPlaybackUrlTtl)
+		resourcedata.SetNillableValue(d, "key_rotation_period", schedule.Period)
+		_ = d.Set("encryption_key_configuration_ids", flattenKeyConfigurationIds(keyConfigurations))
+
+		log.Printf("Read recording settings")
+		return cc.CheckState(d)
+	})
+}
+
+// updateRecordingSettings updates the recording settings and key rotation schedule via the API
+func updateRecordingSettings(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sdkConfig := meta.(*provider.ProviderMeta).ClientConfig
+	proxy := getRecordingSettingsProxy(sdkConfig)
+
+	log.Printf("Updating recording settings")
+
+	// PUT replaces the whole settings object, so start from the current settings
+	// to keep values for attributes that are not set in the configuration
+	settings, resp, err := proxy.getRecordingSettings(ctx)
+	if err != nil {
+		return util.BuildAPIDiagnosticError(ResourceType, fmt.Sprintf("Failed to get recording settings error: %s", err), resp)
+	}
+	if value, ok := d.GetOk("max_simultaneous_streams"); ok {
+		settings.MaxSimultaneousStreams = platformclientv2.Int(value.(int))
+	}
+	if value, ok := d.GetOk("max_configurable_screen_recording_streams"); ok {
+		settings.MaxConfigurableScreenRecordingStreams = platformclientv2.Int(value.(int))
+	}
+	if value, ok := d.GetOk("recording_playback_url_ttl"); ok {
+		settings.RecordingPlaybackUrlTtl = platformclientv2.Int(value.(int))
+	}
+	if value := resourcedata.GetNillableBool(d, "regional_recording_storage_enabled"); value != nil {
+		settings.RegionalRecordingStorageEnabled = value
+	}
+
+	if _, resp, err := proxy.updateRecordingSettings(ctx, settings); err != nil {
+		return util.BuildAPIDiagnosticError(ResourceType, fmt.Sprintf("Failed to update recording settings error: %s", err), resp)
+	}
+
+	if period, ok := d.GetOk("key_rotation_period"); ok && d.HasChange("key_rotation_period") {
+		schedule := platformclientv2.Keyrotationschedule{Period: platformclientv2.String(period.(string))}
+		if _, resp, err := proxy.updateKeyRotationSchedule(ctx, &schedule); err != nil {
+			return util.BuildAPIDiagnosticError(ResourceType, fmt.Sprintf("Failed to update recording key rotation schedule error: %s", err), resp)
+		}
+	}
+
+	log.Printf("Updated recording settings")
+	return readRecordingSettings(ctx, d, meta)
+}
+
+// deleteRecordingSettings handles deletion (no-op for singleton resources)
+func deleteRecordingSettings(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// The recording settings cannot be deleted. They remain in the organization with their current values
+	log.Printf("Deleting (no-op) recording settings")
+	return nil
+}
+
+// flattenKeyConfigurationIds maps a list of platformclientv2.Recordingencryptionconfiguration to a list of ids
+func flattenKeyConfigurationIds(keyConfigurations *[]platformclientv2.Recordingencryptionconfiguration) []interface{} {
+	ids := make([]interface{}, 0)
+	if keyConfigurations == nil {
+		return ids
+	}
+	for _, keyConfiguration := range *keyConfigurations {
+		if keyConfiguration.Id != nil {
+			ids = append(ids, *keyConfiguration.Id)
+		}
+	}
+	return ids
+}
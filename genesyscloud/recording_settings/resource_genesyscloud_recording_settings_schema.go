@@ -0,0 +1,85 @@
+package recording_settings
+
+import (
+	"github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/provider"
+	resourceExporter "github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/resource_exporter"
+	registrar "github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/resource_register"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const ResourceType = "genesyscloud_recording_settings"
+
+// SetRegistrar registers all of the resources and exporters in the package
+func SetRegistrar(regInstance registrar.Registrar) {
+	regInstance.RegisterResource(ResourceType, ResourceRecordingSettings())
+	regInstance.RegisterExporter(ResourceType, RecordingSettingsExporter())
+}
+
+// ResourceRecordingSettings registers the genesyscloud_recording_settings resource
+func ResourceRecordingSettings() *schema.Resource {
+	return &schema.Resource{
+		Description: "Genesys Cloud organization recording settings. Destroying this resource leaves the organization's recording settings unchanged.",
+
+		CreateContext: provider.CreateWithPooledClient(createRecordingSettings),
+		ReadContext:   provider.ReadWithPooledClient(readRecordingSettings),
+		UpdateContext: provider.UpdateWithPooledClient(updateRecordingSettings),
+		DeleteContext: provider.DeleteWithPooledClient(deleteRecordingSettings),
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		SchemaVersion: 1,
+		Schema: map[string]*schema.Schema{
+			"max_simultaneous_streams": {
+				Description:  "Maximum number of simultaneous screen recording streams.",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"max_configurable_screen_recording_streams": {
+				Description:  "Upper limit that max_simultaneous_streams can be configured to.",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"regional_recording_storage_enabled": {
+				Description: "Store call recordings in the region where they are intended to be recorded, otherwise in the organization's home region.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+			},
+			"recording_playback_url_ttl": {
+				Description:  "The duration in minutes for which the generated URL for recording playback remains valid.",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(2, 60),
+			},
+			"key_rotation_period": {
+				Description:  "How often the organization's recording encryption keys are rotated. Valid values: Disabled, Daily, Weekly, Monthly, Yearly.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice([]string{"Disabled", "Daily", "Weekly", "Monthly", "Yearly"}, false),
+			},
+			"encryption_key_configuration_ids": {
+				Description: "IDs of the recording encryption key configurations of the organization.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+// RecordingSettingsExporter returns the resourceExporter object used to hold the genesyscloud_recording_settings exporter's config
+func RecordingSettingsExporter() *resourceExporter.ResourceExporter {
+	return &resourceExporter.ResourceExporter{
+		GetResourcesFunc: provider.GetAllWithPooledClient(getAllRecordingSettings),
+		IsSingleton:      true,
+		ExportId:         ResourceType,
+	}
+}
@@ -0,0 +1,79 @@
+package recording_settings
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/provider"
+	"github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/util"
+)
+
+func TestAccResourceRecordingSettings(t *testing.T) {
+	var (
+		resourceLabel    = "recording_settings"
+		fullResourcePath = ResourceType + "." + resourceLabel
+	)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { util.TestAccPreCheck(t) },
+		ProviderFactories: provider.GetProviderFactories(providerResources, nil),
+		Steps: []resource.TestStep{
+			{
+				// Create with specific settings
+				Config: generateRecordingSettingsResource(
+					resourceLabel,
+					"5",       // max_simultaneous_streams
+					"10",      // recording_playback_url_ttl
+					"Monthly", // key_rotation_period
+				),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fullResourcePath, "max_simultaneous_streams", "5"),
+					resource.TestCheckResourceAttr(fullResourcePath, "recording_playback_url_ttl", "10"),
+					resource.TestCheckResourceAttr(fullResourcePath, "key_rotation_period", "Monthly"),
+				),
+			},
+			{
+				// Update settings
+				Config: generateRecordingSettingsResource(
+					resourceLabel,
+					"3",      // max_simultaneous_streams
+					"30",     // recording_playback_url_ttl
+					"Yearly", // key_rotation_period
+				),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fullResourcePath, "max_simultaneous_streams", "3"),
+					resource.TestCheckResourceAttr(fullResourcePath, "recording_playback_url_ttl", "30"),
+					resource.TestCheckResourceAttr(fullResourcePath, "key_rotation_period", "Yearly"),
+				),
+			},
+			{
+				// Import/Read
+				ResourceName:      fullResourcePath,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+		// CheckDestroy disabled since the recording settings cannot be destroyed
+		CheckDestroy: nil,
+	})
+}
+
+func generateRecordingSettingsResource(
+	resourceLabel string,
+	maxSimultaneousStreams string,
+	recordingPlaybackUrlTtl string,
+	keyRotationPeriod string,
+) string {
+	return fmt.Sprintf(`
+resource "genesyscloud_recording_settings" "%s" {
+	max_simultaneous_streams   = %s
+	recording_playback_url_ttl = %s
+	key_rotation_period        = "%s"
+}
+`, resourceLabel,
+		maxSimultaneousStreams,
+		recordingPlaybackUrlTtl,
+		keyRotationPeriod,
+	)
+}
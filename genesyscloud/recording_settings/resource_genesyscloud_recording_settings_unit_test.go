@@ -0,0 +1,75 @@
+package recording_settings
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/provider"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mypurecloud/platform-client-sdk-go/v192/platformclientv2"
+	"github.com/stretchr/testify/assert"
+)
+
+// Unit Test
+
+func TestUnitResourceRecordingSettingsUpdate(t *testing.T) {
+	tKeyConfigurationId := uuid.NewString()
+	tPeriod := "Weekly"
+	currentSettings := platformclientv2.Recordingsettings{
+		MaxSimultaneousStreams:                platformclientv2.Int(1),
+		MaxConfigurableScreenRecordingStreams: platformclientv2.Int(10),
+		RegionalRecordingStorageEnabled:       platformclientv2.Bool(true),
+		RecordingPlaybackUrlTtl:               platformclientv2.Int(5),
+	}
+	scheduleUpdated := false
+
+	settingsProxy := &recordingSettingsProxy{}
+	settingsProxy.getRecordingSettingsAttr = func(ctx context.Context, p *recordingSettingsProxy) (*platformclientv2.Recordingsettings, *platformclientv2.APIResponse, error) {
+		settings := currentSettings
+		return &settings, &platformclientv2.APIResponse{StatusCode: http.StatusOK}, nil
+	}
+	settingsProxy.updateRecordingSettingsAttr = func(ctx context.Context, p *recordingSettingsProxy, settings *platformclientv2.Recordingsettings) (*platformclientv2.Recordingsettings, *platformclientv2.APIResponse, error) {
+		assert.Equal(t, 4, *settings.MaxSimultaneousStreams)
+		// Attributes that are not configured keep their current values
+		assert.Equal(t, 10, *settings.MaxConfigurableScreenRecordingStreams)
+		assert.Equal(t, true, *settings.RegionalRecordingStorageEnabled)
+		assert.Equal(t, 5, *settings.RecordingPlaybackUrlTtl)
+
+		currentSettings = *settings
+		return settings, &platformclientv2.APIResponse{StatusCode: http.StatusOK}, nil
+	}
+	settingsProxy.getKeyRotationScheduleAttr = func(ctx context.Context, p *recordingSettingsProxy) (*platformclientv2.Keyrotationschedule, *platformclientv2.APIResponse, error) {
+		return &platformclientv2.Keyrotationschedule{Period: &tPeriod}, &platformclientv2.APIResponse{StatusCode: http.StatusOK}, nil
+	}
+	settingsProxy.updateKeyRotationScheduleAttr = func(ctx context.Context, p *recordingSettingsProxy, schedule *platformclientv2.Keyrotationschedule) (*platformclientv2.Keyrotationschedule, *platformclientv2.APIResponse, error) {
+		assert.Equal(t, tPeriod, *schedule.Period)
+		scheduleUpdated = true
+		return schedule, &platformclientv2.APIResponse{StatusCode: http.StatusOK}, nil
+	}
+	settingsProxy.getKeyConfigurationsAttr = func(ctx context.Context, p *recordingSettingsProxy) (*[]platformclientv2.Recordingencryptionconfiguration, *platformclientv2.APIResponse, error) {
+		return &[]platformclientv2.Recordingencryptionconfiguration{{Id: &tKeyConfigurationId}}, &platformclientv2.APIResponse{StatusCode: http.StatusOK}, nil
+	}
+	internalProxy = settingsProxy
+	defer func() { internalProxy = nil }()
+
+	ctx := context.Background()
+	gcloud := &provider.ProviderMeta{ClientConfig: &platformclientv2.Configuration{}}
+
+	resourceDataMap := map[string]interface{}{
+		"max_simultaneous_streams": 4,
+		"key_rotation_period":      tPeriod,
+	}
+	d := schema.TestResourceDataRaw(t, ResourceRecordingSettings().Schema, resourceDataMap)
+
+	diag := createRecordingSettings(ctx, d, gcloud)
+	assert.Equal(t, false, diag.HasError())
+	assert.Equal(t, true, scheduleUpdated)
+	assert.Equal(t, "settings", d.Id())
+	assert.Equal(t, 4, d.Get("max_simultaneous_streams").(int))
+	assert.Equal(t, 10, d.Get("max_configurable_screen_recording_streams").(int))
+	assert.Equal(t, tPeriod, d.Get("key_rotation_period").(string))
+	assert.Equal(t, tKeyConfigurationId, d.Get("encryption_key_configuration_ids.0").(string))
+}
@@ -0,0 +1,41 @@
+package routing_predictor
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/provider"
+	"github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/util"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+/*
+   The data_source_genesyscloud_routing_predictor.go contains the data source implementation
+   for the resource.
+*/
+
+// dataSourceRoutingPredictorRead retrieves by queue id the id of the routing predictor governing that queue
+func dataSourceRoutingPredictorRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sdkConfig := meta.(*provider.ProviderMeta).ClientConfig
+	proxy := getRoutingPredictorProxy(sdkConfig)
+
+	queueId := d.Get("queue_id").(string)
+
+	return util.WithRetries(ctx, 15*time.Second, func() *retry.RetryError {
+		predictorId, retryable, resp, err := proxy.getRoutingPredictorIdByQueueId(ctx, queueId)
+		if err != nil {
+			diagErr := util.BuildWithRetriesApiDiagnosticError(ResourceType, fmt.Sprintf("Error requesting routing predictor for queue %s | error: %s", queueId, err), resp)
+			if !retryable {
+				return retry.NonRetryableError(diagErr)
+			}
+			return retry.RetryableError(diagErr)
+		}
+
+		d.SetId(predictorId)
+		return nil
+	})
+}
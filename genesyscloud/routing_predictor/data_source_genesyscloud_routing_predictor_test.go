@@ -0,0 +1,47 @@
+package routing_predictor
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/provider"
+	routingQueue "github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/routing_queue"
+	"github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/util"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+/*
+Test Class for the routing predictor Data Source
+*/
+
+func TestAccDataSourceRoutingPredictor(t *testing.T) {
+	t.Parallel()
+	var (
+		resourceLabel   = "predictor"
+		dataSourceLabel = "predictor_data"
+		queueLabel      = "predictor_data_queue"
+		queueName       = "Terraform Predictor Data Queue " + uuid.NewString()
+		queueRef        = routingQueue.ResourceType + "." + queueLabel + ".id"
+	)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { util.TestAccPreCheck(t) },
+		ProviderFactories: provider.GetProviderFactories(providerResources, providerDataSources),
+		Steps: []resource.TestStep{
+			{
+				Config: routingQueue.GenerateRoutingQueueResourceBasic(queueLabel, queueName) +
+					GenerateRoutingPredictorResource(resourceLabel, "tHandle", []string{queueRef}) +
+					fmt.Sprintf(`data "%s" "%s" {
+		queue_id   = %s
+		depends_on = [%s.%s]
+	}
+	`, ResourceType, dataSourceLabel, queueRef, ResourceType, resourceLabel),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data."+ResourceType+"."+dataSourceLabel, "id", ResourceType+"."+resourceLabel, "id"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,202 @@
+package routing_predictor
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/provider"
+	rc "github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/resource_cache"
+	"github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/util"
+
+	"github.com/mypurecloud/platform-client-sdk-go/v192/platformclientv2"
+)
+
+/*
+The genesyscloud_routing_predictor_proxy.go file contains the proxy structures and methods that interact
+with the Genesys Cloud SDK. We use composition here for each function on the proxy so individual functions can be stubbed
+out during testing.
+*/
+
+// internalProxy holds a proxy instance that can be used throughout the package
+var internalProxy *routingPredictorProxy
+
+// Type definitions for each func on our proxy so we can easily mock them out later
+type createRoutingPredictorFunc func(ctx context.Context, p *routingPredictorProxy, predictor *platformclientv2.Predictorrequest) (*platformclientv2.Predictor, *platformclientv2.APIResponse, error)
+type getAllRoutingPredictorsFunc func(ctx context.Context, p *routingPredictorProxy, queueId string) (*[]platformclientv2.Predictor, *platformclientv2.APIResponse, error)
+type getRoutingPredictorIdByQueueIdFunc func(ctx context.Context, p *routingPredictorProxy, queueId string) (id string, retryable bool, response *platformclientv2.APIResponse, err error)
+type getRoutingPredictorByIdFunc func(ctx context.Context, p *routingPredictorProxy, id string) (*platformclientv2.Predictor, *platformclientv2.APIResponse, error)
+type updateRoutingPredictorFunc func(ctx context.Context, p *routingPredictorProxy, id string, predictor *platformclientv2.Patchpredictorrequest) (*platformclientv2.Predictor, *platformclientv2.APIResponse, error)
+type deleteRoutingPredictorFunc func(ctx context.Context, p *routingPredictorProxy, id string) (*platformclientv2.APIResponse, error)
+
+// routingPredictorProxy contains all of the methods that call genesys cloud APIs.
+type routingPredictorProxy struct {
+	clientConfig                       *platformclientv2.Configuration
+	routingApi                         *platformclientv2.RoutingApi
+	createRoutingPredictorAttr         createRoutingPredictorFunc
+	getAllRoutingPredictorsAttr        getAllRoutingPredictorsFunc
+	getRoutingPredictorIdByQueueIdAttr getRoutingPredictorIdByQueueIdFunc
+	getRoutingPredictorByIdAttr        getRoutingPredictorByIdFunc
+	updateRoutingPredictorAttr         updateRoutingPredictorFunc
+	deleteRoutingPredictorAttr         deleteRoutingPredictorFunc
+	predictorCache                     rc.CacheInterface[platformclientv2.Predictor]
+}
+
+// newRoutingPredictorProxy initializes the routing predictor proxy with all of the data needed to communicate with Genesys Cloud
+func newRoutingPredictorProxy(clientConfig *platformclientv2.Configuration) *routingPredictorProxy {
+	api := platformclientv2.NewRoutingApiWithConfig(clientConfig)
+	predictorCache := rc.NewResourceCache[platformclientv2.Predictor]()
+	return &routingPredictorProxy{
+		clientConfig:                       clientConfig,
+		routingApi:                         api,
+		predictorCache:                     predictorCache,
+		createRoutingPredictorAttr:         createRoutingPredictorFn,
+		getAllRoutingPredictorsAttr:        getAllRoutingPredictorsFn,
+		getRoutingPredictorIdByQueueIdAttr: getRoutingPredictorIdByQueueIdFn,
+		getRoutingPredictorByIdAttr:        getRoutingPredictorByIdFn,
+		updateRoutingPredictorAttr:         updateRoutingPredictorFn,
+		deleteRoutingPredictorAttr:         deleteRoutingPredictorFn,
+	}
+}
+
+// getRoutingPredictorProxy acts as a singleton to for the internalProxy.  It also ensures
+// that we can still proxy our tests by directly setting internalProxy package variable
+func getRoutingPredictorProxy(clientConfig *platformclientv2.Configuration) *routingPredictorProxy {
+	if internalProxy == nil {
+		internalProxy = newRoutingPredictorProxy(clientConfig)
+	}
+	return internalProxy
+}
+
+// createRoutingPredictor creates a Genesys Cloud routing predictor
+func (p *routingPredictorProxy) createRoutingPredictor(ctx context.Context, predictor *platformclientv2.Predictorrequest) (*platformclientv2.Predictor, *platformclientv2.APIResponse, error) {
+	return p.createRoutingPredictorAttr(ctx, p, predictor)
+}
+
+// getAllRoutingPredictors retrieves all Genesys Cloud routing predictors. When queueId is set, only the predictors governing that queue are returned
+func (p *routingPredictorProxy) getAllRoutingPredictors(ctx context.Context, queueId string) (*[]platformclientv2.Predictor, *platformclientv2.APIResponse, error) {
+	return p.getAllRoutingPredictorsAttr(ctx, p, queueId)
+}
+
+// getRoutingPredictorIdByQueueId returns the id of the Genesys Cloud routing predictor governing a queue
+func (p *routingPredictorProxy) getRoutingPredictorIdByQueueId(ctx context.Context, queueId string) (string, bool, *platformclientv2.APIResponse, error) {
+	return p.getRoutingPredictorIdByQueueIdAttr(ctx, p, queueId)
+}
+
+// getRoutingPredictorById returns a single Genesys Cloud routing predictor by Id
+func (p *routingPredictorProxy) getRoutingPredictorById(ctx context.Context, id string) (*platformclientv2.Predictor, *platformclientv2.APIResponse, error) {
+	if predictor := rc.GetCacheItem(p.predictorCache, id); predictor != nil {
+		return predictor, nil, nil
+	}
+	return p.getRoutingPredictorByIdAttr(ctx, p, id)
+}
+
+// updateRoutingPredictor updates a Genesys Cloud routing predictor
+func (p *routingPredictorProxy) updateRoutingPredictor(ctx context.Context, id string, predictor *platformclientv2.Patchpredictorrequest) (*platformclientv2.Predictor, *platformclientv2.APIResponse, error) {
+	return p.updateRoutingPredictorAttr(ctx, p, id, predictor)
+}
+
+// deleteRoutingPredictor deletes a Genesys Cloud routing predictor by Id
+func (p *routingPredictorProxy) deleteRoutingPredictor(ctx context.Context, id string) (*platformclientv2.APIResponse, error) {
+	return p.deleteRoutingPredictorAttr(ctx, p, id)
+}
+
+// createRoutingPredictorFn is an implementation function for creating a Genesys Cloud routing predictor
+func createRoutingPredictorFn(ctx context.Context, p *routingPredictorProxy, predictor *platformclientv2.Predictorrequest) (*platformclientv2.Predictor, *platformclientv2.APIResponse, error) {
+	ctx = provider.EnsureResourceContext(ctx, ResourceType)
+	return p.routingApi.PostRoutingPredictors(*predictor)
+}
+
+// getAllRoutingPredictorsFn is the implementation for retrieving all routing predictors in Genesys Cloud
+func getAllRoutingPredictorsFn(ctx context.Context, p *routingPredictorProxy, queueId string) (*[]platformclientv2.Predictor, *platformclientv2.APIResponse, error) {
+	ctx = provider.EnsureResourceContext(ctx, ResourceType)
+
+	const pageSize = "100"
+
+	var (
+		allPredictors []platformclientv2.Predictor
+		response      *platformclientv2.APIResponse
+		queueIds      []string
+		after         string
+	)
+	if queueId != "" {
+		queueIds = []string{queueId}
+	}
+
+	for {
+		predictors, resp, err := p.routingApi.GetRoutingPredictors("", after, "", pageSize, queueIds)
+		response = resp
+		if err != nil {
+			return nil, resp, fmt.Errorf("failed to get routing predictors: %v", err)
+		}
+		if predictors.Entities == nil || len(*predictors.Entities) == 0 {
+			break
+		}
+
+		allPredictors = append(allPredictors, *predictors.Entities...)
+
+		if predictors.NextUri == nil || *predictors.NextUri == "" {
+			break
+		}
+		after, err = util.GetQueryParamValueFromUri(*predictors.NextUri, "after")
+		if err != nil {
+			return nil, resp, fmt.Errorf("failed to parse after cursor from %s: %v", *predictors.NextUri, err)
+		}
+		if after == "" {
+			break
+		}
+	}
+
+	if queueId == "" {
+		for _, predictor := range allPredictors {
+			rc.SetCache(p.predictorCache, *predictor.Id, predictor)
+		}
+	}
+
+	return &allPredictors, response, nil
+}
+
+// getRoutingPredictorIdByQueueIdFn is an implementation of the function to get the Genesys Cloud routing predictor governing a queue
+func getRoutingPredictorIdByQueueIdFn(ctx context.Context, p *routingPredictorProxy, queueId string) (string, bool, *platformclientv2.APIResponse, error) {
+	predictors, resp, err := getAllRoutingPredictorsFn(ctx, p, queueId)
+	if err != nil {
+		return "", false, resp, err
+	}
+
+	for _, predictor := range *predictors {
+		if predictor.Queues == nil {
+			continue
+		}
+		for _, queue := range *predictor.Queues {
+			if queue.Id != nil && *queue.Id == queueId {
+				log.Printf("Retrieved the routing predictor id %s by queue id %s", *predictor.Id, queueId)
+				return *predictor.Id, false, resp, nil
+			}
+		}
+	}
+
+	return "", true, resp, fmt.Errorf("unable to find a routing predictor governing queue %s", queueId)
+}
+
+// getRoutingPredictorByIdFn is an implementation of the function to get a Genesys Cloud routing predictor by Id
+func getRoutingPredictorByIdFn(ctx context.Context, p *routingPredictorProxy, id string) (*platformclientv2.Predictor, *platformclientv2.APIResponse, error) {
+	ctx = provider.EnsureResourceContext(ctx, ResourceType)
+	return p.routingApi.GetRoutingPredictor(id)
+}
+
+// updateRoutingPredictorFn is an implementation of the function to update a Genesys Cloud routing predictor
+func updateRoutingPredictorFn(ctx context.Context, p *routingPredictorProxy, id string, predictor *platformclientv2.Patchpredictorrequest) (*platformclientv2.Predictor, *platformclientv2.APIResponse, error) {
+	ctx = provider.EnsureResourceContext(ctx, ResourceType)
+	return p.routingApi.PatchRoutingPredictor(id, *predictor)
+}
+
+// deleteRoutingPredictorFn is an implementation function for deleting a Genesys Cloud routing predictor
+func deleteRoutingPredictorFn(ctx context.Context, p *routingPredictorProxy, id string) (*platformclientv2.APIResponse, error) {
+	ctx = provider.EnsureResourceContext(ctx, ResourceType)
+	resp, err := p.routingApi.DeleteRoutingPredictor(id)
+	if err != nil {
+		return resp, err
+	}
+	rc.DeleteCacheItem(p.predictorCache, id)
+	return resp, nil
+}
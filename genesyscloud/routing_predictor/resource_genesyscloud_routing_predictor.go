@@ -0,0 +1,132 @@
+package routing_predictor
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/consistency_checker"
+	"github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/provider"
+	resourceExporter "github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/resource_exporter"
+	"github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/util"
+	"github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/util/constants"
+	"github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/util/resourcedata"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mypurecloud/platform-client-sdk-go/v192/platformclientv2"
+)
+
+/*
+The resource_genesyscloud_routing_predictor.go contains all of the methods that perform the core logic for a resource.
+*/
+
+// getAllRoutingPredictors retrieves all of the routing predictors via Terraform in the Genesys Cloud and is used for the exporter
+func getAllRoutingPredictors(ctx context.Context, clientConfig *platformclientv2.Configuration) (resourceExporter.ResourceIDMetaMap, diag.Diagnostics) {
+	proxy := getRoutingPredictorProxy(clientConfig)
+	resources := make(resourceExporter.ResourceIDMetaMap)
+
+	predictors, resp, err := proxy.getAllRoutingPredictors(ctx, "")
+	if err != nil {
+		return nil, util.BuildAPIDiagnosticError(ResourceType, fmt.Sprintf("Failed to get routing predictors error: %s", err), resp)
+	}
+
+	for _, predictor := range *predictors {
+		log.Printf("Dealing with routing predictor id: %s", *predictor.Id)
+		blockLabel := *predictor.Id
+		if predictor.Kpi != nil {
+			blockLabel = *predictor.Kpi + "_" + *predictor.Id
+		}
+		resources[*predictor.Id] = &resourceExporter.ResourceMeta{BlockLabel: blockLabel}
+	}
+	return resources, nil
+}
+
+// createRoutingPredictor is used by the routing_predictor resource to create a Genesys Cloud routing predictor
+func createRoutingPredictor(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sdkConfig := meta.(*provider.ProviderMeta).ClientConfig
+	proxy := getRoutingPredictorProxy(sdkConfig)
+
+	predictorRequest := getPredictorRequestFromResourceData(d)
+
+	log.Printf("Creating routing predictor for KPI %s", *predictorRequest.Kpi)
+	predictor, resp, err := proxy.createRoutingPredictor(ctx, &predictorRequest)
+	if err != nil {
+		return util.BuildAPIDiagnosticError(ResourceType, fmt.Sprintf("Failed to create routing predictor for KPI %s error: %s", *predictorRequest.Kpi, err), resp)
+	}
+
+	d.SetId(*predictor.Id)
+	log.Printf("Created routing predictor %s", *predictor.Id)
+	return readRoutingPredictor(ctx, d, meta)
+}
+
+// readRoutingPredictor is used by the routing_predictor resource to read a routing predictor from genesys cloud
+func readRoutingPredictor(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sdkConfig := meta.(*provider.ProviderMeta).ClientConfig
+	proxy := getRoutingPredictorProxy(sdkConfig)
+	cc := consistency_checker.NewConsistencyCheck(ctx, d, meta, ResourceRoutingPredictor(), constants.ConsistencyChecks(), ResourceType)
+
+	log.Printf("Reading routing predictor %s", d.Id())
+
+	return util.WithRetriesForRead(ctx, d, func() *retry.RetryError {
+		predictor, resp, getErr := proxy.getRoutingPredictorById(ctx, d.Id())
+		if getErr != nil {
+			diagErr := util.BuildWithRetriesApiDiagnosticError(ResourceType, fmt.Sprintf("Failed to read routing predictor %s | error: %s", d.Id(), getErr), resp)
+			if util.IsStatus404(resp) {
+				return retry.RetryableError(diagErr)
+			}
+			return retry.NonRetryableError(diagErr)
+		}
+
+		resourcedata.SetNillableValue(d, "kpi", predictor.Kpi)
+		_ = d.Set("queue_ids", flattenQueueRefs(predictor.Queues))
+		resourcedata.SetNillableValue(d, "routing_timeout_seconds", predictor.RoutingTimeoutSeconds)
+		resourcedata.SetNillableValueWithInterfaceArrayWithFunc(d, "schedule", predictor.Schedule, flattenPredictorSchedule)
+		resourcedata.SetNillableValueWithInterfaceArrayWithFunc(d, "workload_balancing_config", predictor.WorkloadBalancingConfig, flattenPredictorWorkloadBalancing)
+		resourcedata.SetNillableValue(d, "state", predictor.State)
+
+		log.Printf("Read routing predictor %s", d.Id())
+		return cc.CheckState(d)
+	})
+}
+
+// updateRoutingPredictor is used by the routing_predictor resource to update a routing predictor in Genesys Cloud
+func updateRoutingPredictor(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sdkConfig := meta.(*provider.ProviderMeta).ClientConfig
+	proxy := getRoutingPredictorProxy(sdkConfig)
+
+	patchRequest := getPatchPredictorRequestFromResourceData(d)
+
+	log.Printf("Updating routing predictor %s", d.Id())
+	if _, resp, err := proxy.updateRoutingPredictor(ctx, d.Id(), &patchRequest); err != nil {
+		return util.BuildAPIDiagnosticError(ResourceType, fmt.Sprintf("Failed to update routing predictor %s error: %s", d.Id(), err), resp)
+	}
+
+	log.Printf("Updated routing predictor %s", d.Id())
+	return readRoutingPredictor(ctx, d, meta)
+}
+
+// deleteRoutingPredictor is used by the routing_predictor resource to delete a routing predictor from Genesys cloud
+func deleteRoutingPredictor(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sdkConfig := meta.(*provider.ProviderMeta).ClientConfig
+	proxy := getRoutingPredictorProxy(sdkConfig)
+
+	resp, err := proxy.deleteRoutingPredictor(ctx, d.Id())
+	if err != nil {
+		return util.BuildAPIDiagnosticError(ResourceType, fmt.Sprintf("Failed to delete routing predictor %s error: %s", d.Id(), err), resp)
+	}
+
+	return util.WithRetries(ctx, 180*time.Second, func() *retry.RetryError {
+		_, resp, err := proxy.getRoutingPredictorById(ctx, d.Id())
+		if err != nil {
+			if util.IsStatus404(resp) {
+				log.Printf("Deleted routing predictor %s", d.Id())
+				return nil
+			}
+			return retry.NonRetryableError(util.BuildWithRetriesApiDiagnosticError(ResourceType, fmt.Sprintf("Error deleting routing predictor %s | error: %s", d.Id(), err), resp))
+		}
+		return retry.RetryableError(util.BuildWithRetriesApiDiagnosticError(ResourceType, fmt.Sprintf("Routing predictor %s still exists", d.Id()), resp))
+	})
+}
@@ -0,0 +1,153 @@
+package routing_predictor
+
+// @team: Assignment
+// @chat: #genesys-cloud-acd-routing
+// @pm: Rob Blane
+// @jira: AS
+// @description: Predictive routing predictors. A predictor scores agents against a KPI and routes interactions on the queues it governs to the agents most likely to improve that KPI.
+
+import (
+	"github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/provider"
+	resourceExporter "github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/resource_exporter"
+	registrar "github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/resource_register"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+/*
+resource_genesyscloud_routing_predictor_schema.go holds four functions within it:
+
+1.  The registration code that registers the Datasource, Resource and Exporter for the package.
+2.  The resource schema definitions for the routing_predictor resource.
+3.  The datasource schema definitions for the routing_predictor datasource.
+4.  The resource exporter configuration for the routing_predictor exporter.
+*/
+const ResourceType = "genesyscloud_routing_predictor"
+
+// SetRegistrar registers all of the resources, datasources and exporters in the package
+func SetRegistrar(regInstance registrar.Registrar) {
+	regInstance.RegisterResource(ResourceType, ResourceRoutingPredictor())
+	regInstance.RegisterDataSource(ResourceType, DataSourceRoutingPredictor())
+	regInstance.RegisterExporter(ResourceType, RoutingPredictorExporter())
+}
+
+var (
+	scheduleResource = &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"schedule_type": {
+				Description: "The predictor schedule type, e.g. `Weekly`.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+		},
+	}
+
+	workloadBalancingConfigResource = &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"enabled": {
+				Description: "Whether workload balancing is enabled for the predictor.",
+				Type:        schema.TypeBool,
+				Required:    true,
+			},
+			"minimum_occupancy": {
+				Description:  "The desired minimum occupancy (in percent) of agents while they are working.",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(0, 100),
+			},
+			"maximum_occupancy": {
+				Description:  "The desired maximum occupancy (in percent) of agents while they are working.",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(0, 100),
+			},
+		},
+	}
+)
+
+// ResourceRoutingPredictor registers the genesyscloud_routing_predictor resource with Terraform
+func ResourceRoutingPredictor() *schema.Resource {
+	return &schema.Resource{
+		Description: `Genesys Cloud predictive routing predictor`,
+
+		CreateContext: provider.CreateWithPooledClient(createRoutingPredictor),
+		ReadContext:   provider.ReadWithPooledClient(readRoutingPredictor),
+		UpdateContext: provider.UpdateWithPooledClient(updateRoutingPredictor),
+		DeleteContext: provider.DeleteWithPooledClient(deleteRoutingPredictor),
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		SchemaVersion: 1,
+		Schema: map[string]*schema.Schema{
+			"kpi": {
+				Description: "The KPI the predictor optimizes for, e.g. `tHandle`. Changing the KPI will cause the predictor to be dropped and recreated.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"queue_ids": {
+				Description: "IDs of the queues governed by the predictor. A queue can only be governed by a single predictor.",
+				Type:        schema.TypeList,
+				Required:    true,
+				MinItems:    1,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"routing_timeout_seconds": {
+				Description:  "The number of seconds an interaction waits for the best scored agent before it is offered to any available agent.",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"schedule": {
+				Description: "The predictor schedule.",
+				Type:        schema.TypeList,
+				Optional:    true,
+				Computed:    true,
+				MaxItems:    1,
+				Elem:        scheduleResource,
+			},
+			"workload_balancing_config": {
+				Description: "Workload balancing settings that govern agent occupancy during working time.",
+				Type:        schema.TypeList,
+				Optional:    true,
+				Computed:    true,
+				MaxItems:    1,
+				Elem:        workloadBalancingConfigResource,
+			},
+			"state": {
+				Description: "The state of the predictor, e.g. `Created`, `Active` or `Error`.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// RoutingPredictorExporter returns the resourceExporter object used to hold the genesyscloud_routing_predictor exporter's config
+func RoutingPredictorExporter() *resourceExporter.ResourceExporter {
+	return &resourceExporter.ResourceExporter{
+		GetResourcesFunc: provider.GetAllWithPooledClient(getAllRoutingPredictors),
+		RefAttrs: map[string]*resourceExporter.RefAttrSettings{
+			"queue_ids": {RefType: "genesyscloud_routing_queue"},
+		},
+	}
+}
+
+// DataSourceRoutingPredictor registers the genesyscloud_routing_predictor data source
+func DataSourceRoutingPredictor() *schema.Resource {
+	return &schema.Resource{
+		Description: `Genesys Cloud predictive routing predictor data source. Select the predictor that governs a queue.`,
+		ReadContext: provider.ReadWithPooledClient(dataSourceRoutingPredictorRead),
+		Schema: map[string]*schema.Schema{
+			"queue_id": {
+				Description: "ID of a queue governed by the predictor.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+		},
+	}
+}
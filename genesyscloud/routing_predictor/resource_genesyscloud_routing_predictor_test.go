@@ -0,0 +1,105 @@
+package routing_predictor
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/provider"
+	routingQueue "github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/routing_queue"
+	"github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/util"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/mypurecloud/platform-client-sdk-go/v192/platformclientv2"
+)
+
+/*
+The resource_genesyscloud_routing_predictor_test.go contains all of the test cases for running the resource
+tests for routing_predictor.
+*/
+
+func TestAccResourceRoutingPredictor(t *testing.T) {
+	t.Parallel()
+	var (
+		resourceLabel    = "predictor"
+		fullResourcePath = ResourceType + "." + resourceLabel
+		kpi              = "tHandle"
+
+		queueResourceLabel1 = "predictor_queue_1"
+		queueName1          = "Terraform Predictor Queue " + uuid.NewString()
+		queueResourceLabel2 = "predictor_queue_2"
+		queueName2          = "Terraform Predictor Queue " + uuid.NewString()
+		queueRef1           = routingQueue.ResourceType + "." + queueResourceLabel1 + ".id"
+		queueRef2           = routingQueue.ResourceType + "." + queueResourceLabel2 + ".id"
+
+		queuesConfig = routingQueue.GenerateRoutingQueueResourceBasic(queueResourceLabel1, queueName1) +
+			routingQueue.GenerateRoutingQueueResourceBasic(queueResourceLabel2, queueName2)
+	)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { util.TestAccPreCheck(t) },
+		ProviderFactories: provider.GetProviderFactories(providerResources, providerDataSources),
+		Steps: []resource.TestStep{
+			{
+				// Create
+				Config: queuesConfig + GenerateRoutingPredictorResource(
+					resourceLabel,
+					kpi,
+					[]string{queueRef1},
+					GenerateWorkloadBalancingConfigBlock(false, 0, 0),
+				),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fullResourcePath, "kpi", kpi),
+					resource.TestCheckResourceAttr(fullResourcePath, "queue_ids.#", "1"),
+					resource.TestCheckResourceAttrPair(fullResourcePath, "queue_ids.0", routingQueue.ResourceType+"."+queueResourceLabel1, "id"),
+					resource.TestCheckResourceAttr(fullResourcePath, "workload_balancing_config.0.enabled", "false"),
+					resource.TestCheckResourceAttrSet(fullResourcePath, "state"),
+				),
+			},
+			{
+				// Update
+				Config: queuesConfig + GenerateRoutingPredictorResource(
+					resourceLabel,
+					kpi,
+					[]string{queueRef1, queueRef2},
+					GenerateWorkloadBalancingConfigBlock(true, 60, 90),
+				),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fullResourcePath, "kpi", kpi),
+					resource.TestCheckResourceAttr(fullResourcePath, "queue_ids.#", "2"),
+					resource.TestCheckResourceAttrPair(fullResourcePath, "queue_ids.1", routingQueue.ResourceType+"."+queueResourceLabel2, "id"),
+					resource.TestCheckResourceAttr(fullResourcePath, "workload_balancing_config.0.enabled", "true"),
+					resource.TestCheckResourceAttr(fullResourcePath, "workload_balancing_config.0.minimum_occupancy", "60"),
+					resource.TestCheckResourceAttr(fullResourcePath, "workload_balancing_config.0.maximum_occupancy", "90"),
+				),
+			},
+			{
+				// Import/Read
+				ResourceName:      fullResourcePath,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+		CheckDestroy: testVerifyRoutingPredictorDestroyed,
+	})
+}
+
+func testVerifyRoutingPredictorDestroyed(state *terraform.State) error {
+	routingAPI := platformclientv2.NewRoutingApi()
+	for _, rs := range state.RootModule().Resources {
+		if rs.Type != ResourceType {
+			continue
+		}
+
+		predictor, resp, err := routingAPI.GetRoutingPredictor(rs.Primary.ID)
+		if predictor != nil {
+			return fmt.Errorf("routing predictor (%s) still exists", rs.Primary.ID)
+		}
+		if util.IsStatus404(resp) {
+			continue
+		}
+		return fmt.Errorf("unexpected error: %s", err)
+	}
+	return nil
+}
@@ -0,0 +1,154 @@
+package routing_predictor
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/provider"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mypurecloud/platform-client-sdk-go/v192/platformclientv2"
+	"github.com/stretchr/testify/assert"
+)
+
+/** Unit Test **/
+func TestUnitResourceRoutingPredictorCreate(t *testing.T) {
+	tId := uuid.NewString()
+	tKpi := "tHandle"
+	tQueueIds := []string{uuid.NewString(), uuid.NewString()}
+	tState := "Created"
+
+	predictorProxy := &routingPredictorProxy{}
+
+	predictorProxy.createRoutingPredictorAttr = func(ctx context.Context, p *routingPredictorProxy, predictor *platformclientv2.Predictorrequest) (*platformclientv2.Predictor, *platformclientv2.APIResponse, error) {
+		assert.Equal(t, tKpi, *predictor.Kpi)
+		assert.Equal(t, len(tQueueIds), len(*predictor.Queues))
+		for i, queue := range *predictor.Queues {
+			assert.Equal(t, tQueueIds[i], *queue.Id)
+		}
+		assert.Equal(t, true, *predictor.WorkloadBalancingConfig.Enabled)
+		assert.Equal(t, 60, *predictor.WorkloadBalancingConfig.MinimumOccupancy)
+		assert.Equal(t, 90, *predictor.WorkloadBalancingConfig.MaximumOccupancy)
+
+		return &platformclientv2.Predictor{Id: &tId}, &platformclientv2.APIResponse{StatusCode: http.StatusOK}, nil
+	}
+
+	predictorProxy.getRoutingPredictorByIdAttr = func(ctx context.Context, p *routingPredictorProxy, id string) (*platformclientv2.Predictor, *platformclientv2.APIResponse, error) {
+		assert.Equal(t, tId, id)
+		return buildTestPredictor(tId, tKpi, tQueueIds, tState), &platformclientv2.APIResponse{StatusCode: http.StatusOK}, nil
+	}
+
+	internalProxy = predictorProxy
+	defer func() { internalProxy = nil }()
+
+	ctx := context.Background()
+	gcloud := &provider.ProviderMeta{ClientConfig: &platformclientv2.Configuration{}}
+
+	d := schema.TestResourceDataRaw(t, ResourceRoutingPredictor().Schema, buildRoutingPredictorResourceMap(tKpi, tQueueIds))
+
+	diag := createRoutingPredictor(ctx, d, gcloud)
+	assert.Equal(t, false, diag.HasError())
+	assert.Equal(t, tId, d.Id())
+	assert.Equal(t, tKpi, d.Get("kpi").(string))
+	assert.Equal(t, tState, d.Get("state").(string))
+	assert.Equal(t, tQueueIds[1], d.Get("queue_ids.1").(string))
+}
+
+func TestUnitResourceRoutingPredictorUpdate(t *testing.T) {
+	tId := uuid.NewString()
+	tKpi := "tHandle"
+	tQueueIds := []string{uuid.NewString()}
+
+	predictorProxy := &routingPredictorProxy{}
+
+	predictorProxy.updateRoutingPredictorAttr = func(ctx context.Context, p *routingPredictorProxy, id string, predictor *platformclientv2.Patchpredictorrequest) (*platformclientv2.Predictor, *platformclientv2.APIResponse, error) {
+		assert.Equal(t, tId, id)
+		assert.Equal(t, tQueueIds[0], *(*predictor.Queues)[0].Id)
+		return &platformclientv2.Predictor{Id: &tId}, &platformclientv2.APIResponse{StatusCode: http.StatusOK}, nil
+	}
+
+	predictorProxy.getRoutingPredictorByIdAttr = func(ctx context.Context, p *routingPredictorProxy, id string) (*platformclientv2.Predictor, *platformclientv2.APIResponse, error) {
+		return buildTestPredictor(tId, tKpi, tQueueIds, "Active"), &platformclientv2.APIResponse{StatusCode: http.StatusOK}, nil
+	}
+
+	internalProxy = predictorProxy
+	defer func() { internalProxy = nil }()
+
+	ctx := context.Background()
+	gcloud := &provider.ProviderMeta{ClientConfig: &platformclientv2.Configuration{}}
+
+	d := schema.TestResourceDataRaw(t, ResourceRoutingPredictor().Schema, buildRoutingPredictorResourceMap(tKpi, tQueueIds))
+	d.SetId(tId)
+
+	diag := updateRoutingPredictor(ctx, d, gcloud)
+	assert.Equal(t, false, diag.HasError())
+	assert.Equal(t, "Active", d.Get("state").(string))
+}
+
+func TestUnitResourceRoutingPredictorDelete(t *testing.T) {
+	tId := uuid.NewString()
+
+	predictorProxy := &routingPredictorProxy{}
+
+	predictorProxy.deleteRoutingPredictorAttr = func(ctx context.Context, p *routingPredictorProxy, id string) (*platformclientv2.APIResponse, error) {
+		assert.Equal(t, tId, id)
+		return &platformclientv2.APIResponse{StatusCode: http.StatusNoContent}, nil
+	}
+
+	predictorProxy.getRoutingPredictorByIdAttr = func(ctx context.Context, p *routingPredictorProxy, id string) (*platformclientv2.Predictor, *platformclientv2.APIResponse, error) {
+		assert.Equal(t, tId, id)
+		return nil, &platformclientv2.APIResponse{StatusCode: http.StatusNotFound}, fmt.Errorf("not found")
+	}
+
+	internalProxy = predictorProxy
+	defer func() { internalProxy = nil }()
+
+	ctx := context.Background()
+	gcloud := &provider.ProviderMeta{ClientConfig: &platformclientv2.Configuration{}}
+
+	d := schema.TestResourceDataRaw(t, ResourceRoutingPredictor().Schema, buildRoutingPredictorResourceMap("tHandle", []string{uuid.NewString()}))
+	d.SetId(tId)
+
+	diag := deleteRoutingPredictor(ctx, d, gcloud)
+	assert.Nil(t, diag)
+	assert.Equal(t, tId, d.Id())
+}
+
+func buildTestPredictor(id, kpi string, queueIds []string, state string) *platformclientv2.Predictor {
+	queues := make([]platformclientv2.Addressableentityref, len(queueIds))
+	for i, queueId := range queueIds {
+		queues[i] = platformclientv2.Addressableentityref{Id: platformclientv2.String(queueId)}
+	}
+	return &platformclientv2.Predictor{
+		Id:     &id,
+		Kpi:    &kpi,
+		Queues: &queues,
+		State:  &state,
+		WorkloadBalancingConfig: &platformclientv2.Predictorworkloadbalancing{
+			Enabled:          platformclientv2.Bool(true),
+			MinimumOccupancy: platformclientv2.Int(60),
+			MaximumOccupancy: platformclientv2.Int(90),
+		},
+	}
+}
+
+func buildRoutingPredictorResourceMap(kpi string, queueIds []string) map[string]interface{} {
+	queues := make([]interface{}, len(queueIds))
+	for i, queueId := range queueIds {
+		queues[i] = queueId
+	}
+	return map[string]interface{}{
+		"kpi":       kpi,
+		"queue_ids": queues,
+		"workload_balancing_config": []interface{}{
+			map[string]interface{}{
+				"enabled":           true,
+				"minimum_occupancy": 60,
+				"maximum_occupancy": 90,
+			},
+		},
+	}
+}
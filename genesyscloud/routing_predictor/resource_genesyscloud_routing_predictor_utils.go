@@ -0,0 +1,118 @@
+package routing_predictor
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/util/lists"
+	"github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/util/resourcedata"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mypurecloud/platform-client-sdk-go/v192/platformclientv2"
+)
+
+/*
+The resource_genesyscloud_routing_predictor_utils.go file contains various helper methods to marshal
+and unmarshal data into formats consumable by Terraform and/or Genesys Cloud.
+*/
+
+// getPredictorRequestFromResourceData maps data from schema ResourceData object to a platformclientv2.Predictorrequest
+func getPredictorRequestFromResourceData(d *schema.ResourceData) platformclientv2.Predictorrequest {
+	return platformclientv2.Predictorrequest{
+		Kpi:                     platformclientv2.String(d.Get("kpi").(string)),
+		Queues:                  buildQueueRefs(d),
+		RoutingTimeoutSeconds:   resourcedata.GetNonZeroPointer[int](d, "routing_timeout_seconds"),
+		Schedule:                resourcedata.BuildSdkListFirstElement(d, "schedule", buildPredictorSchedule, true),
+		WorkloadBalancingConfig: resourcedata.BuildSdkListFirstElement(d, "workload_balancing_config", buildPredictorWorkloadBalancing, true),
+	}
+}
+
+// getPatchPredictorRequestFromResourceData maps data from schema ResourceData object to a platformclientv2.Patchpredictorrequest.
+// The KPI of a predictor cannot be changed once it has been created.
+func getPatchPredictorRequestFromResourceData(d *schema.ResourceData) platformclientv2.Patchpredictorrequest {
+	return platformclientv2.Patchpredictorrequest{
+		Queues:                  buildQueueRefs(d),
+		RoutingTimeoutSeconds:   resourcedata.GetNonZeroPointer[int](d, "routing_timeout_seconds"),
+		Schedule:                resourcedata.BuildSdkListFirstElement(d, "schedule", buildPredictorSchedule, true),
+		WorkloadBalancingConfig: resourcedata.BuildSdkListFirstElement(d, "workload_balancing_config", buildPredictorWorkloadBalancing, true),
+	}
+}
+
+// buildQueueRefs maps the queue_ids attribute to a list of platformclientv2.Addressableentityref
+func buildQueueRefs(d *schema.ResourceData) *[]platformclientv2.Addressableentityref {
+	queueIds := *lists.BuildSdkStringListFromInterfaceArray(d, "queue_ids")
+	queues := make([]platformclientv2.Addressableentityref, len(queueIds))
+	for i, queueId := range queueIds {
+		queues[i] = platformclientv2.Addressableentityref{Id: platformclientv2.String(queueId)}
+	}
+	return &queues
+}
+
+// buildPredictorSchedule maps a schedule block to a platformclientv2.Predictorschedule
+func buildPredictorSchedule(scheduleMap map[string]interface{}) *platformclientv2.Predictorschedule {
+	return &platformclientv2.Predictorschedule{
+		ScheduleType: platformclientv2.String(scheduleMap["schedule_type"].(string)),
+	}
+}
+
+// buildPredictorWorkloadBalancing maps a workload_balancing_config block to a platformclientv2.Predictorworkloadbalancing
+func buildPredictorWorkloadBalancing(configMap map[string]interface{}) *platformclientv2.Predictorworkloadbalancing {
+	return &platformclientv2.Predictorworkloadbalancing{
+		Enabled:          platformclientv2.Bool(configMap["enabled"].(bool)),
+		MinimumOccupancy: resourcedata.GetNillableNonZeroValueFromMap[int](configMap, "minimum_occupancy"),
+		MaximumOccupancy: resourcedata.GetNillableNonZeroValueFromMap[int](configMap, "maximum_occupancy"),
+	}
+}
+
+// flattenQueueRefs maps a list of platformclientv2.Addressableentityref to a list of queue ids
+func flattenQueueRefs(queues *[]platformclientv2.Addressableentityref) []interface{} {
+	queueIds := make([]interface{}, 0)
+	if queues == nil {
+		return queueIds
+	}
+	for _, queue := range *queues {
+		if queue.Id != nil {
+			queueIds = append(queueIds, *queue.Id)
+		}
+	}
+	return queueIds
+}
+
+// flattenPredictorSchedule maps a platformclientv2.Predictorschedule to a schedule block
+func flattenPredictorSchedule(schedule *platformclientv2.Predictorschedule) []interface{} {
+	scheduleMap := make(map[string]interface{})
+	resourcedata.SetMapValueIfNotNil(scheduleMap, "schedule_type", schedule.ScheduleType)
+	return []interface{}{scheduleMap}
+}
+
+// flattenPredictorWorkloadBalancing maps a platformclientv2.Predictorworkloadbalancing to a workload_balancing_config block
+func flattenPredictorWorkloadBalancing(config *platformclientv2.Predictorworkloadbalancing) []interface{} {
+	configMap := make(map[string]interface{})
+	resourcedata.SetMapValueIfNotNil(configMap, "enabled", config.Enabled)
+	resourcedata.SetMapValueIfNotNil(configMap, "minimum_occupancy", config.MinimumOccupancy)
+	resourcedata.SetMapValueIfNotNil(configMap, "maximum_occupancy", config.MaximumOccupancy)
+	return []interface{}{configMap}
+}
+
+func GenerateRoutingPredictorResource(resourceLabel, kpi string, queueIds []string, nestedBlocks ...string) string {
+	return fmt.Sprintf(`resource "%s" "%s" {
+		kpi       = "%s"
+		queue_ids = [%s]
+		%s
+	}
+	`, ResourceType, resourceLabel, kpi, strings.Join(queueIds, ", "), strings.Join(nestedBlocks, "\n"))
+}
+
+func GenerateScheduleBlock(scheduleType string) string {
+	return fmt.Sprintf(`schedule {
+			schedule_type = "%s"
+		}`, scheduleType)
+}
+
+func GenerateWorkloadBalancingConfigBlock(enabled bool, minimumOccupancy, maximumOccupancy int) string {
+	return fmt.Sprintf(`workload_balancing_config {
+			enabled           = %t
+			minimum_occupancy = %d
+			maximum_occupancy = %d
+		}`, enabled, minimumOccupancy, maximumOccupancy)
+}
@@ -24,8 +24,8 @@ import (
 /*
 The genesyscloud_scripts_proxy.go file contains all of the logic associated with calling the Genesys cloud API for scripts.
 */
-type createScriptFunc func(ctx context.Context, filePath, scriptName, divisionId string, substitutions map[string]interface{}, p *scriptsProxy) (scriptId string, err error)
-type updateScriptFunc func(ctx context.Context, filePath, scriptName, scriptId, divisionId string, substitutions map[string]interface{}, p *scriptsProxy) (id string, err error)
+type createScriptFunc func(ctx context.Context, filePath, scriptName, divisionId string, substitutions map[string]interface{}, templateSettings *files.TemplateSettings, p *scriptsProxy) (scriptId string, err error)
+type updateScriptFunc func(ctx context.Context, filePath, scriptName, scriptId, divisionId string, substitutions map[string]interface{}, templateSettings *files.TemplateSettings, p *scriptsProxy) (id string, err error)
 type getAllPublishedScriptsFunc func(ctx context.Context, p *scriptsProxy) (*[]platformclientv2.Script, *platformclientv2.APIResponse, error)
 type publishScriptFunc func(ctx context.Context, p *scriptsProxy, scriptId string) (*platformclientv2.APIResponse, error)
 type getScriptsByNameFunc func(ctx context.Context, p *scriptsProxy, scriptName string) ([]platformclientv2.Script, *platformclientv2.APIResponse, error)
@@ -91,13 +91,13 @@ func newScriptsProxy(clientConfig *platformclientv2.Configuration) *scriptsProxy
 }
 
 // createScript creates a Genesys Cloud Script
-func (p *scriptsProxy) createScript(ctx context.Context, filePath, scriptName, divisionId string, substitutions map[string]interface{}) (string, error) {
-	return p.createScriptAttr(ctx, filePath, scriptName, divisionId, substitutions, p)
+func (p *scriptsProxy) createScript(ctx context.Context, filePath, scriptName, divisionId string, substitutions map[string]interface{}, templateSettings *files.TemplateSettings) (string, error) {
+	return p.createScriptAttr(ctx, filePath, scriptName, divisionId, substitutions, templateSettings, p)
 }
 
 // updateScript updates a Genesys Cloud Script
-func (p *scriptsProxy) updateScript(ctx context.Context, filePath, scriptName, scriptId, divisionId string, substitutions map[string]interface{}) (string, error) {
-	return p.updateScriptAttr(ctx, filePath, scriptName, scriptId, divisionId, substitutions, p)
+func (p *scriptsProxy) updateScript(ctx context.Context, filePath, scriptName, scriptId, divisionId string, substitutions map[string]interface{}, templateSettings *files.TemplateSettings) (string, error) {
+	return p.updateScriptAttr(ctx, filePath, scriptName, scriptId, divisionId, substitutions, templateSettings, p)
 }
 
 func (p *scriptsProxy) getAllPublishedScripts(ctx context.Context) (*[]platformclientv2.Script, *platformclientv2.APIResponse, error) {
@@ -271,7 +271,7 @@ func (p *scriptsProxy) createScriptFormData(filePath, scriptName, scriptId strin
 
 // uploadScriptFile uploads a script file to S3
 // For creates, scriptId should be an empty string
-func (p *scriptsProxy) uploadScriptFile(filePath, scriptName, scriptId string, substitutions map[string]interface{}) ([]byte, error) {
+func (p *scriptsProxy) uploadScriptFile(filePath, scriptName, scriptId string, substitutions map[string]interface{}, templateSettings *files.TemplateSettings) ([]byte, error) {
 	formData, err := p.createScriptFormData(filePath, scriptName, scriptId)
 	if err != nil {
 		return nil, err
@@ -281,6 +281,7 @@ func (p *scriptsProxy) uploadScriptFile(filePath, scriptName, scriptId string, s
 	headers["Authorization"] = "Bearer " + p.accessToken
 
 	s3Uploader := files.NewS3Uploader(nil, formData, substitutions, headers, "POST", p.basePath+"/uploads/v2/scripter")
+	s3Uploader.SetTemplateSettings(templateSettings)
 	resp, err := s3Uploader.Upload()
 	return resp, err
 }
@@ -443,11 +444,11 @@ func getScriptByIdFn(ctx context.Context, p *scriptsProxy, scriptId string) (scr
 }
 
 // createScriptFn is an implementation function for creating a Genesys Cloud Script
-func createScriptFn(ctx context.Context, filePath, scriptName, divisionId string, substitutions map[string]interface{}, p *scriptsProxy) (string, error) {
+func createScriptFn(ctx context.Context, filePath, scriptName, divisionId string, substitutions map[string]interface{}, templateSettings *files.TemplateSettings, p *scriptsProxy) (string, error) {
 	// Set resource context for SDK debug logging
 	ctx = provider.EnsureResourceContext(ctx, ResourceType)
 
-	resp, err := p.uploadScriptFile(filePath, scriptName, "", substitutions)
+	resp, err := p.uploadScriptFile(filePath, scriptName, "", substitutions, templateSettings)
 	if err != nil {
 		return "", err
 	}
@@ -482,11 +483,11 @@ func createScriptFn(ctx context.Context, filePath, scriptName, divisionId string
 }
 
 // updateScriptFn is an implementation function for updating a Genesys Cloud Script
-func updateScriptFn(ctx context.Context, filePath, scriptName, scriptId, divisionId string, substitutions map[string]interface{}, p *scriptsProxy) (string, error) {
+func updateScriptFn(ctx context.Context, filePath, scriptName, scriptId, divisionId string, substitutions map[string]interface{}, templateSettings *files.TemplateSettings, p *scriptsProxy) (string, error) {
 	// Set resource context for SDK debug logging
 	ctx = provider.EnsureResourceContext(ctx, ResourceType)
 
-	resp, err := p.uploadScriptFile(filePath, scriptName, scriptId, substitutions)
+	resp, err := p.uploadScriptFile(filePath, scriptName, scriptId, substitutions, templateSettings)
 	if err != nil {
 		return "", err
 	}
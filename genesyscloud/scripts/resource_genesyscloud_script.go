@@ -49,6 +49,11 @@ func createScript(ctx context.Context, d *schema.ResourceData, meta any) (diags
 	substitutions := d.Get("substitutions").(map[string]any)
 	divisionId := d.Get("division_id").(string)
 
+	templateSettings, err := files.BuildTemplateSettings(d, "template")
+	if err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+
 	if fch := d.Get("file_content_hash").(string); fch != "" {
 		diags = append(diags, diag.Diagnostic{
 			Severity: diag.Warning,
@@ -57,12 +62,12 @@ func createScript(ctx context.Context, d *schema.ResourceData, meta any) (diags
 	}
 
 	log.Printf("Creating script %s", scriptName)
-	scriptId, err := proxy.createScript(ctx, filePath, scriptName, divisionId, substitutions)
+	scriptId, err := proxy.createScript(ctx, filePath, scriptName, divisionId, substitutions, templateSettings)
 	if err != nil {
 		return append(diags, util.BuildDiagnosticError(ResourceType, fmt.Sprintf("Failed to create script '%s': %s", scriptName, err.Error()), err)...)
 	}
 
-	fileHash, err := files.HashFileContent(ctx, filePath, S3Enabled)
+	fileHash, err := files.HashRenderedFileContent(ctx, filePath, S3Enabled, substitutions, templateSettings)
 	if err != nil {
 		return append(diags, util.BuildDiagnosticError(ResourceType, fmt.Sprintf("failed to get file content hash: %s | error: %s", filePath, err.Error()), err)...)
 	}
@@ -84,6 +89,11 @@ func updateScript(ctx context.Context, d *schema.ResourceData, meta any) (diags
 	substitutions := d.Get("substitutions").(map[string]any)
 	divisionId := d.Get("division_id").(string)
 
+	templateSettings, err := files.BuildTemplateSettings(d, "template")
+	if err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+
 	if d.HasChange("file_content_hash") {
 		diags = append(diags, diag.Diagnostic{
 			Severity: diag.Warning,
@@ -93,7 +103,7 @@ func updateScript(ctx context.Context, d *schema.ResourceData, meta any) (diags
 
 	log.Printf("Updating script '%s' %s", scriptName, d.Id())
 
-	scriptId, err := proxy.updateScript(ctx, filePath, scriptName, d.Id(), divisionId, substitutions)
+	scriptId, err := proxy.updateScript(ctx, filePath, scriptName, d.Id(), divisionId, substitutions, templateSettings)
 	if err != nil {
 		return append(diags, util.BuildDiagnosticError(ResourceType, fmt.Sprintf("Failed to update script '%s': %s", scriptName, err.Error()), err)...)
 	}
@@ -102,7 +112,7 @@ func updateScript(ctx context.Context, d *schema.ResourceData, meta any) (diags
 		d.SetId(scriptId)
 	}
 
-	fileHash, err := files.HashFileContent(ctx, filePath, S3Enabled)
+	fileHash, err := files.HashRenderedFileContent(ctx, filePath, S3Enabled, substitutions, templateSettings)
 	if err != nil {
 		return append(diags, util.BuildDiagnosticError(ResourceType, fmt.Sprintf("failed to get file content hash: %s | error: %s", filePath, err.Error()), err)...)
 	}
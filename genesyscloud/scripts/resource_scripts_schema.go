@@ -5,6 +5,7 @@ import (
 	"github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/provider"
 	resourceExporter "github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/resource_exporter"
 	registrar "github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/resource_register"
+	"github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/util/files"
 	"github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/validators"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
@@ -51,7 +52,7 @@ func ResourceScript() *schema.Resource {
 		UpdateContext: provider.UpdateWithPooledClient(updateScript),
 		DeleteContext: provider.DeleteWithPooledClient(deleteScript),
 		CustomizeDiff: customdiff.All(
-			customdiff.ComputedIf("file_content_hash", validators.ValidateFileContentHashChanged("filepath", "file_content_hash", S3Enabled)),
+			customdiff.ComputedIf("file_content_hash", validators.ValidateTemplatedFileContentHashChanged("filepath", "file_content_hash", "substitutions", "template", S3Enabled)),
 		),
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
@@ -81,6 +82,13 @@ func ResourceScript() *schema.Resource {
 				Type:        schema.TypeMap,
 				Optional:    true,
 			},
+			"template": {
+				Description: "Opt-in templating of the script file with Go `text/template` syntax, supporting conditionals, loops over lists and typed values. The file is rendered after `substitutions` are applied and `file_content_hash` hashes the rendered output. Scripts use `{{` for their own variables, so set `left_delimiter` and `right_delimiter` to other values, e.g. `[[` and `]]`.",
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Elem:        files.TemplateResource,
+			},
 			"division_id": {
 				Description: "Specify division id",
 				Type:        schema.TypeString,
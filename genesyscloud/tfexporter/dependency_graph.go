@@ -0,0 +1,534 @@
+package tfexporter
+
+import (
+	"encoding/xml"
+	"fmt"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/util"
+	"github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/util/files"
+	"github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/util/lists"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+)
+
+/*
+The dependency_graph.go file writes the instance level dependency graph of an export. Every exported resource and data
+source is a node, and every reference from one to another is an edge, whether it comes from a reference attribute or a
+depends_on entry. Edges that are part of a cycle are highlighted, including the flow cycles that the dependent consumers
+lookup found and ignore_cyclic_deps left out of depends_on. The graph is written in the formats listed in dependency_graph_formats.
+*/
+
+const (
+	dependencyGraphFormatDOT     = "dot"
+	dependencyGraphFormatMermaid = "mermaid"
+	dependencyGraphFormatGraphML = "graphml"
+
+	dependencyGraphFileName = "dependency_graph"
+
+	// cyclicDependencySeparator separates the two flows of an entry found by the dependent consumers lookup
+	cyclicDependencySeparator = " , "
+	// cyclicDependencyAttribute names the edges of the cycles that the dependent consumers lookup left out of depends_on
+	cyclicDependencyAttribute = "depends_on (cyclic)"
+)
+
+var (
+	dependencyGraphFormats = []string{dependencyGraphFormatDOT, dependencyGraphFormatMermaid, dependencyGraphFormatGraphML}
+
+	dependencyGraphFileExtensions = map[string]string{
+		dependencyGraphFormatDOT:     ".dot",
+		dependencyGraphFormatMermaid: ".mmd",
+		dependencyGraphFormatGraphML: ".graphml",
+	}
+
+	// Matches the reference expressions (${type.label.id}) and depends_on entries ($dep$type.label$dep$) written by the exporter
+	graphReferenceRegex = regexp.MustCompile(`\$\{((?:data\.)?genesyscloud_[a-z0-9_]+\.[A-Za-z0-9_-]+)\.id\}|\$dep\$((?:data\.)?genesyscloud_[a-z0-9_]+\.[A-Za-z0-9_-]+)\$dep\$`)
+)
+
+type dependencyGraphNode struct {
+	Address  string
+	Type     string
+	Label    string
+	ID       string
+	IsData   bool
+	InCycle  bool
+	sequence int
+}
+
+type dependencyGraphEdge struct {
+	From string
+	To   string
+	// Attributes are the attributes of the From resource that reference the To resource
+	Attributes []string
+	InCycle    bool
+}
+
+type dependencyGraph struct {
+	Nodes []*dependencyGraphNode
+	Edges []*dependencyGraphEdge
+}
+
+// buildDependencyGraph builds the graph of the exported resources from their config maps. decodedAttributes holds the
+// content of the attributes that are exported with jsonencode, and ids the ID of each resource by address.
+// cyclicDependencies holds pairs of addresses that depend on each other but whose depends_on entries were left out
+// because of the cycle; both directions are added as edges so the cycle is highlighted.
+func buildDependencyGraph(resourceTypesMaps, dataSourceTypesMaps map[string]ResourceJSONMaps, decodedAttributes map[string]string, ids map[string]string, cyclicDependencies [][2]string) *dependencyGraph {
+	nodes := make(map[string]*dependencyGraphNode)
+	addNodes := func(typeMaps map[string]ResourceJSONMaps, isData bool) {
+		for resourceType, resources := range typeMaps {
+			for label := range resources {
+				address := resourceType + "." + label
+				if isData {
+					address = "data." + address
+				}
+				nodes[address] = &dependencyGraphNode{
+					Address: address,
+					Type:    resourceType,
+					Label:   label,
+					ID:      ids[address],
+					IsData:  isData,
+				}
+			}
+		}
+	}
+	addNodes(resourceTypesMaps, false)
+	addNodes(dataSourceTypesMaps, true)
+
+	edges := make(map[[2]string]*dependencyGraphEdge)
+	addEdges := func(typeMaps map[string]ResourceJSONMaps, isData bool) {
+		for resourceType, resources := range typeMaps {
+			for label, configMap := range resources {
+				from := resourceType + "." + label
+				if isData {
+					from = "data." + from
+				}
+				collectGraphReferences(map[string]interface{}(configMap), "", decodedAttributes, func(attribute string, to string) {
+					if nodes[to] == nil {
+						return
+					}
+					key := [2]string{from, to}
+					edge := edges[key]
+					if edge == nil {
+						edge = &dependencyGraphEdge{From: from, To: to}
+						edges[key] = edge
+					}
+					if !lists.ItemInSlice(attribute, edge.Attributes) {
+						edge.Attributes = append(edge.Attributes, attribute)
+					}
+				})
+			}
+		}
+	}
+	addEdges(resourceTypesMaps, false)
+	addEdges(dataSourceTypesMaps, true)
+
+	for _, pair := range cyclicDependencies {
+		if nodes[pair[0]] == nil || nodes[pair[1]] == nil {
+			continue
+		}
+		for _, key := range [][2]string{pair, {pair[1], pair[0]}} {
+			edge := edges[key]
+			if edge == nil {
+				edge = &dependencyGraphEdge{From: key[0], To: key[1]}
+				edges[key] = edge
+			}
+			if !lists.ItemInSlice(cyclicDependencyAttribute, edge.Attributes) {
+				edge.Attributes = append(edge.Attributes, cyclicDependencyAttribute)
+			}
+		}
+	}
+
+	graph := &dependencyGraph{}
+	for _, node := range nodes {
+		graph.Nodes = append(graph.Nodes, node)
+	}
+	sort.Slice(graph.Nodes, func(i, j int) bool {
+		return graph.Nodes[i].Address < graph.Nodes[j].Address
+	})
+	for i, node := range graph.Nodes {
+		node.sequence = i
+	}
+	for _, edge := range edges {
+		sort.Strings(edge.Attributes)
+		graph.Edges = append(graph.Edges, edge)
+	}
+	sort.Slice(graph.Edges, func(i, j int) bool {
+		if graph.Edges[i].From != graph.Edges[j].From {
+			return graph.Edges[i].From < graph.Edges[j].From
+		}
+		return graph.Edges[i].To < graph.Edges[j].To
+	})
+
+	graph.markCycles()
+	return graph
+}
+
+// collectGraphReferences calls addEdge for every reference found in a config map, with the path of the attribute
+// that holds it
+func collectGraphReferences(value interface{}, path string, decodedAttributes map[string]string, addEdge func(attribute string, to string)) {
+	switch v := value.(type) {
+	case util.JsonMap:
+		collectGraphReferences(map[string]interface{}(v), path, decodedAttributes, addEdge)
+	case map[string]interface{}:
+		for key, child := range v {
+			childPath := key
+			if path != "" {
+				childPath = path + "." + key
+			}
+			collectGraphReferences(child, childPath, decodedAttributes, addEdge)
+		}
+	case []interface{}:
+		for _, child := range v {
+			collectGraphReferences(child, path, decodedAttributes, addEdge)
+		}
+	case []string:
+		for _, child := range v {
+			collectGraphReferences(child, path, decodedAttributes, addEdge)
+		}
+	case string:
+		if decoded, ok := decodedAttributes[v]; ok {
+			v = decoded
+		}
+		for _, match := range graphReferenceRegex.FindAllStringSubmatch(v, -1) {
+			to := match[1]
+			if to == "" {
+				to = match[2]
+			}
+			addEdge(path, to)
+		}
+	}
+}
+
+// markCycles marks the nodes and edges that are part of a cycle. A node is part of a cycle if its strongly connected
+// component has more than one node or if it references itself.
+func (graph *dependencyGraph) markCycles() {
+	nodesByAddress := make(map[string]*dependencyGraphNode, len(graph.Nodes))
+	adjacency := make(map[string][]string)
+	for _, node := range graph.Nodes {
+		nodesByAddress[node.Address] = node
+	}
+	for _, edge := range graph.Edges {
+		adjacency[edge.From] = append(adjacency[edge.From], edge.To)
+	}
+
+	// Tarjan's strongly connected components algorithm
+	index := 0
+	indexes := make(map[string]int)
+	lowLinks := make(map[string]int)
+	onStack := make(map[string]bool)
+	var stack []string
+	component := make(map[string]int)
+	componentCount := 0
+
+	var strongConnect func(address string)
+	strongConnect = func(address string) {
+		indexes[address] = index
+		lowLinks[address] = index
+		index++
+		stack = append(stack, address)
+		onStack[address] = true
+
+		for _, next := range adjacency[address] {
+			if _, visited := indexes[next]; !visited {
+				strongConnect(next)
+				lowLinks[address] = min(lowLinks[address], lowLinks[next])
+			} else if onStack[next] {
+				lowLinks[address] = min(lowLinks[address], indexes[next])
+			}
+		}
+
+		if lowLinks[address] == indexes[address] {
+			var members []string
+			for {
+				member := stack[len(stack)-1]
+				stack = stack[:len(stack)-1]
+				onStack[member] = false
+				component[member] = componentCount
+				members = append(members, member)
+				if member == address {
+					break
+				}
+			}
+			if len(members) > 1 {
+				for _, member := range members {
+					nodesByAddress[member].InCycle = true
+				}
+			}
+			componentCount++
+		}
+	}
+	for _, node := range graph.Nodes {
+		if _, visited := indexes[node.Address]; !visited {
+			strongConnect(node.Address)
+		}
+	}
+
+	for _, edge := range graph.Edges {
+		if edge.From == edge.To {
+			nodesByAddress[edge.From].InCycle = true
+			edge.InCycle = true
+			continue
+		}
+		edge.InCycle = component[edge.From] == component[edge.To] && nodesByAddress[edge.From].InCycle
+	}
+}
+
+// cycleEdgeCount returns the number of edges that are part of a cycle
+func (graph *dependencyGraph) cycleEdgeCount() int {
+	count := 0
+	for _, edge := range graph.Edges {
+		if edge.InCycle {
+			count++
+		}
+	}
+	return count
+}
+
+// toDOT renders the graph in the Graphviz DOT language
+func (graph *dependencyGraph) toDOT() string {
+	var dot strings.Builder
+	dot.WriteString("digraph genesyscloud {\n")
+	dot.WriteString("  rankdir=LR;\n")
+	dot.WriteString("  node [shape=box];\n")
+	for _, node := range graph.Nodes {
+		attributes := []string{"label=" + strconv.Quote(node.Address)}
+		if node.ID != "" {
+			attributes = append(attributes, "tooltip="+strconv.Quote(node.ID))
+		}
+		if node.IsData {
+			attributes = append(attributes, "style=dashed")
+		}
+		if node.InCycle {
+			attributes = append(attributes, "color=red")
+		}
+		fmt.Fprintf(&dot, "  %s [%s];\n", strconv.Quote(node.Address), strings.Join(attributes, ", "))
+	}
+	for _, edge := range graph.Edges {
+		attributes := []string{"label=" + strconv.Quote(strings.Join(edge.Attributes, ", "))}
+		if edge.InCycle {
+			attributes = append(attributes, "color=red", "penwidth=2")
+		}
+		fmt.Fprintf(&dot, "  %s -> %s [%s];\n", strconv.Quote(edge.From), strconv.Quote(edge.To), strings.Join(attributes, ", "))
+	}
+	dot.WriteString("}\n")
+	return dot.String()
+}
+
+// toMermaid renders the graph as a Mermaid flowchart
+func (graph *dependencyGraph) toMermaid() string {
+	nodeIds := make(map[string]string, len(graph.Nodes))
+	var mermaid strings.Builder
+	mermaid.WriteString("flowchart LR\n")
+	mermaid.WriteString("  classDef cycle stroke:#d00,stroke-width:2px\n")
+	for _, node := range graph.Nodes {
+		nodeId := fmt.Sprintf("n%d", node.sequence)
+		nodeIds[node.Address] = nodeId
+		text := strings.ReplaceAll(node.Address, `"`, "#quot;")
+		if node.IsData {
+			fmt.Fprintf(&mermaid, "  %s([\"%s\"])\n", nodeId, text)
+		} else {
+			fmt.Fprintf(&mermaid, "  %s[\"%s\"]\n", nodeId, text)
+		}
+	}
+	var cycleLinks []string
+	for i, edge := range graph.Edges {
+		fmt.Fprintf(&mermaid, "  %s -->|\"%s\"| %s\n", nodeIds[edge.From], strings.Join(edge.Attributes, ", "), nodeIds[edge.To])
+		if edge.InCycle {
+			cycleLinks = append(cycleLinks, strconv.Itoa(i))
+		}
+	}
+	for _, node := range graph.Nodes {
+		if node.InCycle {
+			fmt.Fprintf(&mermaid, "  class %s cycle\n", nodeIds[node.Address])
+		}
+	}
+	if len(cycleLinks) > 0 {
+		fmt.Fprintf(&mermaid, "  linkStyle %s stroke:#d00,stroke-width:2px\n", strings.Join(cycleLinks, ","))
+	}
+	return mermaid.String()
+}
+
+type graphML struct {
+	XMLName xml.Name     `xml:"graphml"`
+	Xmlns   string       `xml:"xmlns,attr"`
+	Keys    []graphMLKey `xml:"key"`
+	Graph   graphMLGraph `xml:"graph"`
+}
+
+type graphMLKey struct {
+	ID   string `xml:"id,attr"`
+	For  string `xml:"for,attr"`
+	Name string `xml:"attr.name,attr"`
+	Type string `xml:"attr.type,attr"`
+}
+
+type graphMLGraph struct {
+	ID          string        `xml:"id,attr"`
+	EdgeDefault string        `xml:"edgedefault,attr"`
+	Nodes       []graphMLNode `xml:"node"`
+	Edges       []graphMLEdge `xml:"edge"`
+}
+
+type graphMLNode struct {
+	ID   string        `xml:"id,attr"`
+	Data []graphMLData `xml:"data"`
+}
+
+type graphMLEdge struct {
+	Source string        `xml:"source,attr"`
+	Target string        `xml:"target,attr"`
+	Data   []graphMLData `xml:"data"`
+}
+
+type graphMLData struct {
+	Key   string `xml:"key,attr"`
+	Value string `xml:",chardata"`
+}
+
+// toGraphML renders the graph as GraphML
+func (graph *dependencyGraph) toGraphML() (string, error) {
+	document := graphML{
+		Xmlns: "http://graphml.graphdrawing.org/xmlns",
+		Keys: []graphMLKey{
+			{ID: "type", For: "node", Name: "type", Type: "string"},
+			{ID: "label", For: "node", Name: "label", Type: "string"},
+			{ID: "resource_id", For: "node", Name: "resource_id", Type: "string"},
+			{ID: "data_source", For: "node", Name: "data_source", Type: "boolean"},
+			{ID: "node_cycle", For: "node", Name: "cycle", Type: "boolean"},
+			{ID: "attributes", For: "edge", Name: "attributes", Type: "string"},
+			{ID: "edge_cycle", For: "edge", Name: "cycle", Type: "boolean"},
+		},
+		Graph: graphMLGraph{ID: "genesyscloud", EdgeDefault: "directed"},
+	}
+	for _, node := range graph.Nodes {
+		document.Graph.Nodes = append(document.Graph.Nodes, graphMLNode{
+			ID: node.Address,
+			Data: []graphMLData{
+				{Key: "type", Value: node.Type},
+				{Key: "label", Value: node.Label},
+				{Key: "resource_id", Value: node.ID},
+				{Key: "data_source", Value: strconv.FormatBool(node.IsData)},
+				{Key: "node_cycle", Value: strconv.FormatBool(node.InCycle)},
+			},
+		})
+	}
+	for _, edge := range graph.Edges {
+		document.Graph.Edges = append(document.Graph.Edges, graphMLEdge{
+			Source: edge.From,
+			Target: edge.To,
+			Data: []graphMLData{
+				{Key: "attributes", Value: strings.Join(edge.Attributes, ", ")},
+				{Key: "edge_cycle", Value: strconv.FormatBool(edge.InCycle)},
+			},
+		})
+	}
+
+	content, err := xml.MarshalIndent(document, "", "  ")
+	if err != nil {
+		return "", err
+	}
+	return xml.Header + string(content) + "\n", nil
+}
+
+// buildExportDependencyGraph builds the dependency graph of the resources exported so far
+func (g *GenesysCloudResourceExporter) buildExportDependencyGraph() *dependencyGraph {
+	ids := make(map[string]string)
+	// The cyclic dependencies name a flow by its name or by its block label
+	addressesByName := make(map[string]string)
+	for _, resource := range g.getResources() {
+		if resource.State == nil {
+			continue
+		}
+		address := resource.Type + "." + resource.BlockLabel
+		if g.isDataSource(resource.Type, resource.BlockLabel, resource.OriginalLabel) {
+			address = "data." + address
+		}
+		ids[address] = resource.State.ID
+		addressesByName[resource.Type+"."+resource.BlockLabel] = address
+		if _, exists := addressesByName[resource.Type+"."+resource.OriginalLabel]; !exists && resource.OriginalLabel != "" {
+			addressesByName[resource.Type+"."+resource.OriginalLabel] = address
+		}
+	}
+
+	return buildDependencyGraph(g.getResourceTypesMaps(), g.getDataSourceTypesMaps(), g.copyAttributesDecoded(), ids, resolveCyclicDependencies(g.copyCyclicDependsList(), addressesByName))
+}
+
+// resolveCyclicDependencies turns the entries of the cyclic dependencies found by the dependent consumers lookup into
+// pairs of graph addresses. Entries that name a resource which was not exported are skipped.
+func resolveCyclicDependencies(cyclicDependsList []string, addressesByName map[string]string) [][2]string {
+	var pairs [][2]string
+	for _, entry := range cyclicDependsList {
+		first, second, found := strings.Cut(entry, cyclicDependencySeparator)
+		if !found {
+			continue
+		}
+		from, fromExists := addressesByName[strings.TrimSpace(first)]
+		to, toExists := addressesByName[strings.TrimSpace(second)]
+		if fromExists && toExists {
+			pairs = append(pairs, [2]string{from, to})
+		}
+	}
+	return pairs
+}
+
+// copyCyclicDependsList returns a copy of the cyclic dependencies found by the dependent consumers lookup
+func (g *GenesysCloudResourceExporter) copyCyclicDependsList() []string {
+	g.cyclicDependsListMutex.Lock()
+	defer g.cyclicDependsListMutex.Unlock()
+	return append([]string(nil), g.cyclicDependsList...)
+}
+
+// copyAttributesDecoded returns a copy of the content of the attributes exported with jsonencode by UID
+func (g *GenesysCloudResourceExporter) copyAttributesDecoded() map[string]string {
+	g.attributesDecodedMutex.Lock()
+	defer g.attributesDecodedMutex.Unlock()
+	decodedAttributes := make(map[string]string, len(attributesDecoded))
+	for uid, decoded := range attributesDecoded {
+		decodedAttributes[uid] = decoded
+	}
+	return decodedAttributes
+}
+
+// writeDependencyGraph writes the dependency graph of the export in every format of dependency_graph_formats
+func (g *GenesysCloudResourceExporter) writeDependencyGraph() diag.Diagnostics {
+	formats, _ := g.d.Get("dependency_graph_formats").([]interface{})
+	if len(formats) == 0 {
+		return nil
+	}
+
+	graph := g.buildExportDependencyGraph()
+	if cycleEdges := graph.cycleEdgeCount(); cycleEdges > 0 {
+		tflog.Warn(g.ctx, fmt.Sprintf("The dependency graph has %d references that are part of a cycle", cycleEdges))
+	}
+
+	var diags diag.Diagnostics
+	for _, format := range lists.InterfaceListToStrings(formats) {
+		var content string
+		switch strings.ToLower(format) {
+		case dependencyGraphFormatDOT:
+			content = graph.toDOT()
+		case dependencyGraphFormatMermaid:
+			content = graph.toMermaid()
+		case dependencyGraphFormatGraphML:
+			graphMLContent, err := graph.toGraphML()
+			if err != nil {
+				return append(diags, diag.Errorf("Failed to build the GraphML dependency graph: %v", err)...)
+			}
+			content = graphMLContent
+		default:
+			return append(diags, diag.Errorf("Unsupported dependency graph format %s", format)...)
+		}
+		graphPath := filepath.Join(g.exportDirPath, dependencyGraphFileName+dependencyGraphFileExtensions[strings.ToLower(format)])
+		diags = append(diags, files.WriteToFile([]byte(content), graphPath)...)
+		if diags.HasError() {
+			return diags
+		}
+		tflog.Info(g.ctx, fmt.Sprintf("Wrote the dependency graph of %d resources to %s", len(graph.Nodes), graphPath))
+	}
+	return diags
+}
@@ -0,0 +1,153 @@
+package tfexporter
+
+import (
+	"encoding/xml"
+	"strings"
+	"testing"
+
+	"github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/util"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testDependencyGraph() *dependencyGraph {
+	resourceTypesMaps := map[string]ResourceJSONMaps{
+		"genesyscloud_flow": {
+			"inbound": util.JsonMap{
+				"name":       "Inbound",
+				"depends_on": []string{"$dep$genesyscloud_routing_queue.support$dep$"},
+			},
+		},
+		"genesyscloud_routing_queue": {
+			"support": util.JsonMap{
+				"name":           "Support",
+				"division_id":    "${data.genesyscloud_auth_division.home.id}",
+				"queue_flow_id":  "${genesyscloud_flow.inbound.id}",
+				"wrapup_codes":   []interface{}{"${genesyscloud_routing_wrapupcode.resolved.id}", "${genesyscloud_routing_wrapupcode.resolved.id}"},
+				"members":        []interface{}{map[string]interface{}{"user_id": "${genesyscloud_user.unknown.id}"}},
+				"default_script": "uid-1",
+			},
+		},
+		"genesyscloud_routing_wrapupcode": {
+			"resolved": util.JsonMap{"name": "Resolved"},
+		},
+		"genesyscloud_script": {
+			"default": util.JsonMap{"name": "Default"},
+		},
+	}
+	dataSourceTypesMaps := map[string]ResourceJSONMaps{
+		"genesyscloud_auth_division": {
+			"home": util.JsonMap{"name": "Home"},
+		},
+	}
+	decoded := map[string]string{"uid-1": `jsonencode({"scriptId": "${genesyscloud_script.default.id}"})`}
+	ids := map[string]string{"genesyscloud_routing_queue.support": "queue-1"}
+	return buildDependencyGraph(resourceTypesMaps, dataSourceTypesMaps, decoded, ids, nil)
+}
+
+// TestUnitBuildDependencyGraph asserts that references are found in nested attributes, depends_on entries and
+// jsonencode attributes, that references to resources outside the export are dropped, and that cycles are marked
+func TestUnitBuildDependencyGraph(t *testing.T) {
+	graph := testDependencyGraph()
+
+	addresses := make([]string, 0, len(graph.Nodes))
+	for _, node := range graph.Nodes {
+		addresses = append(addresses, node.Address)
+	}
+	assert.Equal(t, []string{
+		"data.genesyscloud_auth_division.home",
+		"genesyscloud_flow.inbound",
+		"genesyscloud_routing_queue.support",
+		"genesyscloud_routing_wrapupcode.resolved",
+		"genesyscloud_script.default",
+	}, addresses)
+	assert.Equal(t, "queue-1", graph.Nodes[2].ID)
+
+	edges := make(map[string]*dependencyGraphEdge)
+	for _, edge := range graph.Edges {
+		edges[edge.From+" -> "+edge.To] = edge
+	}
+	require.Len(t, edges, 5)
+	assert.Equal(t, []string{"depends_on"}, edges["genesyscloud_flow.inbound -> genesyscloud_routing_queue.support"].Attributes)
+	assert.Equal(t, []string{"wrapup_codes"}, edges["genesyscloud_routing_queue.support -> genesyscloud_routing_wrapupcode.resolved"].Attributes)
+	assert.Equal(t, []string{"default_script"}, edges["genesyscloud_routing_queue.support -> genesyscloud_script.default"].Attributes)
+	assert.NotNil(t, edges["genesyscloud_routing_queue.support -> data.genesyscloud_auth_division.home"])
+
+	assert.True(t, edges["genesyscloud_flow.inbound -> genesyscloud_routing_queue.support"].InCycle)
+	assert.True(t, edges["genesyscloud_routing_queue.support -> genesyscloud_flow.inbound"].InCycle)
+	assert.False(t, edges["genesyscloud_routing_queue.support -> genesyscloud_script.default"].InCycle)
+	assert.Equal(t, 2, graph.cycleEdgeCount())
+}
+
+// TestUnitDependencyGraphFormats asserts that the graph renders in each format with the cycles highlighted
+func TestUnitDependencyGraphFormats(t *testing.T) {
+	graph := testDependencyGraph()
+
+	dot := graph.toDOT()
+	assert.Contains(t, dot, `"genesyscloud_flow.inbound" -> "genesyscloud_routing_queue.support" [label="depends_on", color=red, penwidth=2];`)
+	assert.Contains(t, dot, `"data.genesyscloud_auth_division.home" [label="data.genesyscloud_auth_division.home", style=dashed];`)
+
+	mermaid := graph.toMermaid()
+	assert.True(t, strings.HasPrefix(mermaid, "flowchart LR\n"))
+	assert.Contains(t, mermaid, `n1 -->|"depends_on"| n2`)
+	assert.Contains(t, mermaid, "class n1 cycle\n")
+	assert.Contains(t, mermaid, "linkStyle 0,2 stroke:#d00,stroke-width:2px\n")
+
+	graphMLContent, err := graph.toGraphML()
+	require.NoError(t, err)
+	var document graphML
+	require.NoError(t, xml.Unmarshal([]byte(graphMLContent), &document))
+	assert.Len(t, document.Graph.Nodes, 5)
+	assert.Len(t, document.Graph.Edges, 5)
+}
+
+// TestUnitDependencyGraphFlowCycles asserts that the flow cycles found by the dependent consumers lookup, whose
+// depends_on entries ignore_cyclic_deps leaves out, are added as cycle edges and highlighted in each format
+func TestUnitDependencyGraphFlowCycles(t *testing.T) {
+	resourceTypesMaps := map[string]ResourceJSONMaps{
+		"genesyscloud_flow": {
+			"parent":  util.JsonMap{"name": "Parent Flow"},
+			"child":   util.JsonMap{"name": "Child Flow"},
+			"unbound": util.JsonMap{"name": "Unbound Flow"},
+		},
+	}
+	addressesByName := map[string]string{
+		"genesyscloud_flow.parent":       "genesyscloud_flow.parent",
+		"genesyscloud_flow.child":        "genesyscloud_flow.child",
+		"genesyscloud_flow.unbound":      "genesyscloud_flow.unbound",
+		"genesyscloud_flow.Child Flow":   "genesyscloud_flow.child",
+		"genesyscloud_flow.Unbound Flow": "genesyscloud_flow.unbound",
+	}
+	cyclicDependencies := resolveCyclicDependencies([]string{
+		"genesyscloud_flow.Child Flow , genesyscloud_flow.parent",
+		"genesyscloud_flow.Missing Flow , genesyscloud_flow.parent",
+	}, addressesByName)
+	require.Equal(t, [][2]string{{"genesyscloud_flow.child", "genesyscloud_flow.parent"}}, cyclicDependencies)
+
+	graph := buildDependencyGraph(resourceTypesMaps, nil, nil, nil, cyclicDependencies)
+
+	edges := make(map[string]*dependencyGraphEdge)
+	for _, edge := range graph.Edges {
+		edges[edge.From+" -> "+edge.To] = edge
+	}
+	require.Len(t, edges, 2)
+	for _, address := range []string{"genesyscloud_flow.child -> genesyscloud_flow.parent", "genesyscloud_flow.parent -> genesyscloud_flow.child"} {
+		require.NotNil(t, edges[address])
+		assert.Equal(t, []string{cyclicDependencyAttribute}, edges[address].Attributes)
+		assert.True(t, edges[address].InCycle)
+	}
+	assert.Equal(t, 2, graph.cycleEdgeCount())
+
+	assert.Contains(t, graph.toDOT(), `"genesyscloud_flow.child" -> "genesyscloud_flow.parent" [label="depends_on (cyclic)", color=red, penwidth=2];`)
+	assert.Contains(t, graph.toMermaid(), "linkStyle 0,1 stroke:#d00,stroke-width:2px\n")
+
+	graphMLContent, err := graph.toGraphML()
+	require.NoError(t, err)
+	var document graphML
+	require.NoError(t, xml.Unmarshal([]byte(graphMLContent), &document))
+	require.Len(t, document.Graph.Edges, 2)
+	for _, edge := range document.Graph.Edges {
+		assert.Contains(t, edge.Data, graphMLData{Key: "edge_cycle", Value: "true"})
+	}
+}
@@ -2,7 +2,6 @@ package tfexporter
 
 import (
 	"fmt"
-	"io"
 	"os"
 	"path/filepath"
 	"regexp"
@@ -313,19 +312,28 @@ func getDirPath(d *schema.ResourceData) (string, diag.Diagnostics) {
 	return directory, nil
 }
 
-// Checks if a directory path is empty
+// preservedExportFileNames lists the files and directories of the export directory that outlive the export, for the
+// next export to the same directory. They are kept when the export is destroyed and ignored when checking if the export
+// directory is empty.
+var preservedExportFileNames = []string{gitDirName, labelLockFileName, exportMetricsFileName}
+
+// isPreservedExportFile returns true if a file or directory of the export directory outlives the export
+func isPreservedExportFile(name string) bool {
+	return lists.ItemInSlice(name, preservedExportFileNames)
+}
+
+// isDirEmpty returns true if the directory has no files other than those that outlive the export
 func isDirEmpty(path string) (bool, diag.Diagnostics) {
-	f, err := os.Open(path)
+	entries, err := os.ReadDir(path)
 	if err != nil {
 		return false, diag.FromErr(err)
 	}
-	defer f.Close()
-
-	_, err = f.Readdirnames(1)
-	if err == io.EOF {
-		return true, nil
+	for _, entry := range entries {
+		if !isPreservedExportFile(entry.Name()) {
+			return false, nil
+		}
 	}
-	return false, diag.FromErr(err)
+	return true, nil
 }
 
 func createUnresolvedAttrKey(attr unresolvableAttributeInfo) string {
@@ -0,0 +1,426 @@
+package tfexporter
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+)
+
+/*
+The export_git.go file commits the exported files to a local git repository when git_commit is set, so that nightly
+exports leave an audit trail of the changes made to the organization. The export directory is committed to the git
+repository it is in, and a new repository is initialized in the export directory when it is in none. Git is driven
+through go-git, so no git binary is needed.
+
+The exporter commits either once per run, or once per resource type followed by one commit for the provider, variables
+and other files of the export. The message of each commit lists the resources and data sources it creates, changes
+and removes, found by comparing the blocks of the exported files with those of the last commit.
+*/
+
+const (
+	gitCommitPerRun          = "run"
+	gitCommitPerResourceType = "resource_type"
+
+	gitDirName = ".git"
+
+	// maxCommitMessageAddresses caps the number of addresses listed per section of a commit message
+	maxCommitMessageAddresses = 100
+)
+
+// exportChanges holds the addresses of the resources and data sources created, changed and removed by an export
+type exportChanges struct {
+	Created []string
+	Changed []string
+	Removed []string
+}
+
+// commitMessage returns a commit message with the counts of the changes in its subject and their addresses in its body
+func (c exportChanges) commitMessage(subject string) string {
+	var message strings.Builder
+	message.WriteString(fmt.Sprintf("%s: %d created, %d changed, %d removed\n", subject, len(c.Created), len(c.Changed), len(c.Removed)))
+
+	sections := []struct {
+		title     string
+		addresses []string
+	}{
+		{"Created", c.Created},
+		{"Changed", c.Changed},
+		{"Removed", c.Removed},
+	}
+	for _, section := range sections {
+		if len(section.addresses) == 0 {
+			continue
+		}
+		message.WriteString(fmt.Sprintf("\n%s:\n", section.title))
+		for i, address := range section.addresses {
+			if i == maxCommitMessageAddresses {
+				message.WriteString(fmt.Sprintf("- ... and %d more\n", len(section.addresses)-i))
+				break
+			}
+			message.WriteString(fmt.Sprintf("- %s\n", address))
+		}
+	}
+	return message.String()
+}
+
+// diffExportedBlocks compares the blocks of two versions of the exported files by address
+func diffExportedBlocks(previous, current map[string]string) exportChanges {
+	var changes exportChanges
+	for address, content := range current {
+		previousContent, ok := previous[address]
+		if !ok {
+			changes.Created = append(changes.Created, address)
+		} else if previousContent != content {
+			changes.Changed = append(changes.Changed, address)
+		}
+	}
+	for address := range previous {
+		if _, ok := current[address]; !ok {
+			changes.Removed = append(changes.Removed, address)
+		}
+	}
+	sort.Strings(changes.Created)
+	sort.Strings(changes.Changed)
+	sort.Strings(changes.Removed)
+	return changes
+}
+
+// exportedBlocks returns the content of every resource and data source block of an exported configuration file by
+// address. Files that are not HCL or JSON configuration files have no blocks.
+func exportedBlocks(fileName string, content []byte) (map[string]string, error) {
+	blocks := make(map[string]string)
+	switch {
+	case strings.HasSuffix(fileName, "."+resourceJSONFileExt):
+		// Only the resource and data blocks are read, as the other top level keys hold values of other shapes
+		var root map[string]json.RawMessage
+		if err := json.Unmarshal(content, &root); err != nil {
+			return nil, fmt.Errorf("failed to parse %s: %w", fileName, err)
+		}
+		for _, mode := range []string{"resource", "data"} {
+			if root[mode] == nil {
+				continue
+			}
+			var types map[string]map[string]interface{}
+			if err := json.Unmarshal(root[mode], &types); err != nil {
+				return nil, fmt.Errorf("failed to parse the %s blocks of %s: %w", mode, fileName, err)
+			}
+			for resourceType, labels := range types {
+				for label, block := range labels {
+					// Encoding the block again sorts its keys, so that only changes of content are found
+					normalized, err := json.Marshal(block)
+					if err != nil {
+						return nil, err
+					}
+					blocks[exportedBlockAddress(mode, resourceType, label)] = string(normalized)
+				}
+			}
+		}
+	case strings.HasSuffix(fileName, "."+resourceHCLFileExt):
+		file, diags := hclwrite.ParseConfig(content, fileName, hcl.InitialPos)
+		if diags.HasErrors() {
+			return nil, fmt.Errorf("failed to parse %s: %s", fileName, diags.Error())
+		}
+		for _, block := range file.Body().Blocks() {
+			labels := block.Labels()
+			if (block.Type() != "resource" && block.Type() != "data") || len(labels) != 2 {
+				continue
+			}
+			blocks[exportedBlockAddress(block.Type(), labels[0], labels[1])] = string(block.Body().BuildTokens(nil).Bytes())
+		}
+	}
+	return blocks, nil
+}
+
+// exportedBlockAddress returns the address of a resource or data source block
+func exportedBlockAddress(mode, resourceType, label string) string {
+	if mode == "data" {
+		return fmt.Sprintf("data.%s.%s", resourceType, label)
+	}
+	return fmt.Sprintf("%s.%s", resourceType, label)
+}
+
+// resourceTypeOfFile returns the resource type whose blocks are written to a file of an export split by resource type,
+// or an empty string for the provider, variables and other files of the export
+func resourceTypeOfFile(fileName string) string {
+	name := path.Base(fileName)
+	for _, ext := range []string{"." + resourceJSONFileExt, "." + resourceHCLFileExt} {
+		if strings.HasSuffix(name, ext) {
+			name = strings.TrimPrefix(strings.TrimSuffix(name, ext), "data_")
+			if strings.HasPrefix(name, "genesyscloud_") {
+				return name
+			}
+			return ""
+		}
+	}
+	return ""
+}
+
+// exportGitRepository is the git repository the exported files are committed to
+type exportGitRepository struct {
+	repository *git.Repository
+	worktree   *git.Worktree
+	// dir is the path of the export directory relative to the root of the working tree, with slashes
+	dir    string
+	author *object.Signature
+}
+
+// openExportGitRepository opens the git repository the export directory is in, or initializes one in the export
+// directory. Without an author, the author set in the git config is used.
+func openExportGitRepository(exportDirPath string, author *object.Signature) (*exportGitRepository, error) {
+	exportDirPath, err := filepath.Abs(exportDirPath)
+	if err != nil {
+		return nil, err
+	}
+	if err := os.MkdirAll(exportDirPath, 0755); err != nil {
+		return nil, err
+	}
+	if resolved, err := filepath.EvalSymlinks(exportDirPath); err == nil {
+		exportDirPath = resolved
+	}
+
+	repository, err := git.PlainOpenWithOptions(exportDirPath, &git.PlainOpenOptions{DetectDotGit: true})
+	if errors.Is(err, git.ErrRepositoryNotExists) {
+		repository, err = git.PlainInit(exportDirPath, false)
+	}
+	if err != nil {
+		return nil, err
+	}
+	worktree, err := repository.Worktree()
+	if err != nil {
+		return nil, fmt.Errorf("the git repository of %s has no working tree: %w", exportDirPath, err)
+	}
+
+	root := worktree.Filesystem.Root()
+	if resolved, err := filepath.EvalSymlinks(root); err == nil {
+		root = resolved
+	}
+	dir, err := filepath.Rel(root, exportDirPath)
+	if err != nil {
+		return nil, err
+	}
+	return &exportGitRepository{repository: repository, worktree: worktree, dir: filepath.ToSlash(dir), author: author}, nil
+}
+
+// inExportDir returns true if a path of the working tree is in the export directory
+func (r *exportGitRepository) inExportDir(filePath string) bool {
+	return r.dir == "." || strings.HasPrefix(filePath, r.dir+"/")
+}
+
+// changedFiles returns the sorted paths of the files of the export directory that differ from the last commit. It
+// fails if changes outside the export directory are staged, as they would be committed with the export.
+func (r *exportGitRepository) changedFiles() ([]string, error) {
+	status, err := r.worktree.Status()
+	if err != nil {
+		return nil, err
+	}
+	var changed []string
+	for filePath, fileStatus := range status {
+		if !r.inExportDir(filePath) {
+			if fileStatus.Staging != git.Unmodified && fileStatus.Staging != git.Untracked {
+				return nil, fmt.Errorf("%s is staged outside the export directory %s", filePath, r.dir)
+			}
+			continue
+		}
+		if fileStatus.Worktree != git.Unmodified || fileStatus.Staging != git.Unmodified {
+			changed = append(changed, filePath)
+		}
+	}
+	sort.Strings(changed)
+	return changed, nil
+}
+
+// headTree returns the tree of the last commit, or nil if nothing was committed yet
+func (r *exportGitRepository) headTree() (*object.Tree, error) {
+	head, err := r.repository.Head()
+	if errors.Is(err, plumbing.ErrReferenceNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	commit, err := r.repository.CommitObject(head.Hash())
+	if err != nil {
+		return nil, err
+	}
+	return commit.Tree()
+}
+
+// changes returns the resources and data sources created, changed and removed in the given files since the last commit
+func (r *exportGitRepository) changes(filePaths []string) (exportChanges, error) {
+	tree, err := r.headTree()
+	if err != nil {
+		return exportChanges{}, err
+	}
+
+	previous := make(map[string]string)
+	current := make(map[string]string)
+	for _, filePath := range filePaths {
+		if tree != nil {
+			file, err := tree.File(filePath)
+			if err != nil && !errors.Is(err, object.ErrFileNotFound) {
+				return exportChanges{}, err
+			}
+			if file != nil {
+				content, err := file.Contents()
+				if err != nil {
+					return exportChanges{}, err
+				}
+				if err := addExportedBlocks(previous, filePath, []byte(content)); err != nil {
+					return exportChanges{}, err
+				}
+			}
+		}
+
+		content, err := os.ReadFile(filepath.Join(r.worktree.Filesystem.Root(), filepath.FromSlash(filePath)))
+		if err != nil && !errors.Is(err, os.ErrNotExist) {
+			return exportChanges{}, err
+		}
+		if err == nil {
+			if err := addExportedBlocks(current, filePath, content); err != nil {
+				return exportChanges{}, err
+			}
+		}
+	}
+	return diffExportedBlocks(previous, current), nil
+}
+
+// addExportedBlocks adds the blocks of a file to blocks. The blocks of a resource written in both HCL and JSON are
+// joined, so that a change is found in either format.
+func addExportedBlocks(blocks map[string]string, filePath string, content []byte) error {
+	fileBlocks, err := exportedBlocks(filePath, content)
+	if err != nil {
+		return err
+	}
+	for address, block := range fileBlocks {
+		blocks[address] += block
+	}
+	return nil
+}
+
+// commit stages the given files, including deletions, and commits them
+func (r *exportGitRepository) commit(filePaths []string, message string) (plumbing.Hash, error) {
+	for _, filePath := range filePaths {
+		if err := r.worktree.AddWithOptions(&git.AddOptions{Path: filePath, SkipStatus: true}); err != nil {
+			return plumbing.ZeroHash, fmt.Errorf("failed to stage %s: %w", filePath, err)
+		}
+	}
+	options := &git.CommitOptions{}
+	if r.author != nil {
+		author := *r.author
+		author.When = time.Now()
+		options.Author = &author
+	}
+	hash, err := r.worktree.Commit(message, options)
+	if errors.Is(err, git.ErrMissingAuthor) {
+		return plumbing.ZeroHash, fmt.Errorf("set git_author_name and git_author_email, or the user of the git config: %w", err)
+	}
+	return hash, err
+}
+
+// commitExport commits the changed files of the export directory once, or once per resource type followed by one
+// commit for the other files. It returns the hashes of the commits made, none if nothing changed.
+func commitExport(repository *exportGitRepository, mode string) ([]plumbing.Hash, error) {
+	changed, err := repository.changedFiles()
+	if err != nil {
+		return nil, err
+	}
+	if len(changed) == 0 {
+		return nil, nil
+	}
+
+	type commitGroup struct {
+		subject string
+		files   []string
+	}
+	var groups []commitGroup
+	if mode == gitCommitPerResourceType {
+		filesByType := make(map[string][]string)
+		var otherFiles []string
+		for _, filePath := range changed {
+			if resourceType := resourceTypeOfFile(filePath); resourceType != "" {
+				filesByType[resourceType] = append(filesByType[resourceType], filePath)
+			} else {
+				otherFiles = append(otherFiles, filePath)
+			}
+		}
+		resourceTypes := make([]string, 0, len(filesByType))
+		for resourceType := range filesByType {
+			resourceTypes = append(resourceTypes, resourceType)
+		}
+		sort.Strings(resourceTypes)
+		for _, resourceType := range resourceTypes {
+			groups = append(groups, commitGroup{subject: "Export " + resourceType, files: filesByType[resourceType]})
+		}
+		if len(otherFiles) > 0 {
+			groups = append(groups, commitGroup{subject: "Export the provider, variables and other files", files: otherFiles})
+		}
+	} else {
+		groups = append(groups, commitGroup{subject: "Export Genesys Cloud configuration", files: changed})
+	}
+
+	var hashes []plumbing.Hash
+	for _, group := range groups {
+		changes, err := repository.changes(group.files)
+		if err != nil {
+			return hashes, err
+		}
+		hash, err := repository.commit(group.files, changes.commitMessage(group.subject))
+		if err != nil {
+			return hashes, err
+		}
+		hashes = append(hashes, hash)
+	}
+	return hashes, nil
+}
+
+// validateGitCommit returns an error if git_commit cannot be used with the other settings of the export
+func (g *GenesysCloudResourceExporter) validateGitCommit() diag.Diagnostics {
+	mode, _ := g.d.Get("git_commit").(string)
+	if mode == gitCommitPerResourceType && !g.splitFilesByResource {
+		return diag.Errorf("git_commit %s needs split_files_by_resource or stream_output, so that each resource type is written to its own file", gitCommitPerResourceType)
+	}
+	return nil
+}
+
+// commitExportToGit commits the exported files to the git repository of the export directory when git_commit is set
+func (g *GenesysCloudResourceExporter) commitExportToGit() diag.Diagnostics {
+	mode, _ := g.d.Get("git_commit").(string)
+	if mode == "" {
+		return nil
+	}
+
+	var author *object.Signature
+	if name, _ := g.d.Get("git_author_name").(string); name != "" {
+		email, _ := g.d.Get("git_author_email").(string)
+		author = &object.Signature{Name: name, Email: email}
+	}
+	repository, err := openExportGitRepository(g.exportDirPath, author)
+	if err != nil {
+		return diag.Errorf("Failed to open the git repository of %s: %v", g.exportDirPath, err)
+	}
+
+	hashes, err := commitExport(repository, mode)
+	if err != nil {
+		return diag.Errorf("Failed to commit the export to the git repository of %s: %v", g.exportDirPath, err)
+	}
+	if len(hashes) == 0 {
+		tflog.Info(g.ctx, "No exported files changed since the last commit")
+		return nil
+	}
+	tflog.Info(g.ctx, fmt.Sprintf("Committed the export to the git repository of %s in %d commits, the last one being %s", g.exportDirPath, len(hashes), hashes[len(hashes)-1]))
+	return nil
+}
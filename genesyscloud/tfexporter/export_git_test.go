@@ -0,0 +1,200 @@
+package tfexporter
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testGitUserHCL = `resource "genesyscloud_user" "jane" {
+  name  = "Jane"
+  email = "jane@example.com"
+}
+
+resource "genesyscloud_user" "john" {
+  name  = "John"
+  email = "john@example.com"
+}
+`
+	testGitQueueJSON = `{
+  "resource": {
+    "genesyscloud_routing_queue": {
+      "support": {"name": "Support", "description": "Support queue"}
+    }
+  },
+  "data": {
+    "genesyscloud_routing_skill": {
+      "english": {"name": "English"}
+    }
+  }
+}`
+)
+
+// TestUnitExportedBlocks asserts that the resource and data source blocks of HCL and JSON files are found by address
+func TestUnitExportedBlocks(t *testing.T) {
+	blocks, err := exportedBlocks("genesyscloud_user.tf", []byte(testGitUserHCL+`
+provider "genesyscloud" {
+  sdk_debug = false
+}
+`))
+	require.NoError(t, err)
+	assert.Len(t, blocks, 2)
+	assert.Contains(t, blocks["genesyscloud_user.jane"], `"jane@example.com"`)
+
+	blocks, err = exportedBlocks("genesyscloud.tf.json", []byte(testGitQueueJSON))
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{
+		"genesyscloud_routing_queue.support":      `{"description":"Support queue","name":"Support"}`,
+		"data.genesyscloud_routing_skill.english": `{"name":"English"}`,
+	}, blocks)
+
+	blocks, err = exportedBlocks("terraform.tfvars", []byte(`genesyscloud_user_jane_password = ""`))
+	require.NoError(t, err)
+	assert.Empty(t, blocks)
+
+	assert.Equal(t, "genesyscloud_routing_skill", resourceTypeOfFile("export/data_genesyscloud_routing_skill.tf.json"))
+	assert.Equal(t, "genesyscloud_user", resourceTypeOfFile("genesyscloud_user.tf"))
+	assert.Equal(t, "", resourceTypeOfFile("export/genesyscloud.tf"))
+	assert.Equal(t, "", resourceTypeOfFile("provider.tf"))
+	assert.Equal(t, "", resourceTypeOfFile("export_metrics.json"))
+}
+
+// TestUnitCommitExport asserts that an export is committed once per run or once per resource type, with the created,
+// changed and removed resources in the commit messages, and that nothing is committed when nothing changed
+func TestUnitCommitExport(t *testing.T) {
+	root := t.TempDir()
+	exportDir := filepath.Join(root, "genesyscloud")
+	author := &object.Signature{Name: "Exporter", Email: "exporter@example.com"}
+
+	writeFile := func(name, content string) {
+		require.NoError(t, os.WriteFile(filepath.Join(exportDir, name), []byte(content), 0644))
+	}
+
+	// The export directory is in no repository, so one is initialized in it
+	repository, err := openExportGitRepository(exportDir, author)
+	require.NoError(t, err)
+	assert.Equal(t, ".", repository.dir)
+	assert.DirExists(t, filepath.Join(exportDir, gitDirName))
+
+	writeFile("genesyscloud_user.tf", testGitUserHCL)
+	writeFile("provider.tf", `provider "genesyscloud" {}`)
+	hashes, err := commitExport(repository, gitCommitPerRun)
+	require.NoError(t, err)
+	require.Len(t, hashes, 1)
+
+	commit, err := repository.repository.CommitObject(hashes[0])
+	require.NoError(t, err)
+	assert.Equal(t, "Exporter", commit.Author.Name)
+	assert.True(t, strings.HasPrefix(commit.Message, "Export Genesys Cloud configuration: 2 created, 0 changed, 0 removed\n"))
+	assert.Contains(t, commit.Message, "- genesyscloud_user.jane\n- genesyscloud_user.john\n")
+
+	hashes, err = commitExport(repository, gitCommitPerRun)
+	require.NoError(t, err)
+	assert.Empty(t, hashes, "nothing changed since the last commit")
+
+	// Jane changes, John is removed, and the queues and skills are exported for the first time
+	writeFile("genesyscloud_user.tf", strings.Replace(strings.Split(testGitUserHCL, "\n\n")[0], "Jane", "Jane Doe", 1)+"\n")
+	writeFile("genesyscloud_routing_queue.tf.json", testGitQueueJSON)
+	writeFile("export_metrics.json", `{}`)
+	require.NoError(t, os.Remove(filepath.Join(exportDir, "provider.tf")))
+
+	hashes, err = commitExport(repository, gitCommitPerResourceType)
+	require.NoError(t, err)
+	require.Len(t, hashes, 3)
+
+	var messages []string
+	for _, hash := range hashes {
+		commit, err := repository.repository.CommitObject(hash)
+		require.NoError(t, err)
+		messages = append(messages, commit.Message)
+	}
+	assert.Equal(t, "Export genesyscloud_routing_queue: 2 created, 0 changed, 0 removed\n\nCreated:\n- data.genesyscloud_routing_skill.english\n- genesyscloud_routing_queue.support\n", messages[0])
+	assert.Equal(t, "Export genesyscloud_user: 0 created, 1 changed, 1 removed\n\nChanged:\n- genesyscloud_user.jane\n\nRemoved:\n- genesyscloud_user.john\n", messages[1])
+	assert.Equal(t, "Export the provider, variables and other files: 0 created, 0 changed, 0 removed\n", messages[2])
+
+	worktree, err := repository.repository.Worktree()
+	require.NoError(t, err)
+	status, err := worktree.Status()
+	require.NoError(t, err)
+	assert.True(t, status.IsClean(), "the deletion of provider.tf was committed")
+}
+
+// TestUnitCommitExportInParentRepository asserts that only the export directory of a larger repository is committed,
+// and that changes staged outside of it stop the commit
+func TestUnitCommitExportInParentRepository(t *testing.T) {
+	root := t.TempDir()
+	exportDir := filepath.Join(root, "exports", "genesyscloud")
+	require.NoError(t, os.MkdirAll(exportDir, 0755))
+	_, err := git.PlainInit(root, false)
+	require.NoError(t, err)
+
+	repository, err := openExportGitRepository(exportDir, &object.Signature{Name: "Exporter"})
+	require.NoError(t, err)
+	assert.Equal(t, "exports/genesyscloud", repository.dir)
+	assert.NoDirExists(t, filepath.Join(exportDir, gitDirName))
+
+	require.NoError(t, os.WriteFile(filepath.Join(exportDir, "genesyscloud.tf"), []byte(testGitUserHCL), 0644))
+	require.NoError(t, os.WriteFile(filepath.Join(root, "README.md"), []byte("Nightly exports"), 0644))
+	hashes, err := commitExport(repository, gitCommitPerRun)
+	require.NoError(t, err)
+	require.Len(t, hashes, 1)
+
+	commit, err := repository.repository.CommitObject(hashes[0])
+	require.NoError(t, err)
+	tree, err := commit.Tree()
+	require.NoError(t, err)
+	_, err = tree.File("exports/genesyscloud/genesyscloud.tf")
+	assert.NoError(t, err)
+	_, err = tree.File("README.md")
+	assert.ErrorIs(t, err, object.ErrFileNotFound, "files outside the export directory are not committed")
+
+	require.NoError(t, repository.worktree.AddWithOptions(&git.AddOptions{Path: "README.md"}))
+	require.NoError(t, os.WriteFile(filepath.Join(exportDir, "genesyscloud.tf"), []byte(`resource "genesyscloud_user" "jane" {}`), 0644))
+	_, err = commitExport(repository, gitCommitPerRun)
+	assert.ErrorContains(t, err, "README.md is staged outside the export directory")
+}
+
+// TestUnitPreservedExportFiles asserts that a directory holding only the git repository, the label lock file and the
+// export metrics counts as empty, and that destroying the export keeps them
+func TestUnitPreservedExportFiles(t *testing.T) {
+	exportDir := t.TempDir()
+	require.NoError(t, os.Mkdir(filepath.Join(exportDir, gitDirName), os.ModePerm))
+	require.NoError(t, os.WriteFile(filepath.Join(exportDir, gitDirName, "HEAD"), []byte("ref: refs/heads/main\n"), 0644))
+	require.NoError(t, os.WriteFile(filepath.Join(exportDir, labelLockFileName), []byte("{}"), 0644))
+
+	isEmpty, diags := isDirEmpty(exportDir)
+	require.False(t, diags.HasError())
+	assert.True(t, isEmpty, "a directory that only holds the git repository and the label lock file should count as empty")
+
+	require.NoError(t, os.WriteFile(filepath.Join(exportDir, exportMetricsFileName), []byte("{}"), 0644))
+	isEmpty, diags = isDirEmpty(exportDir)
+	require.False(t, diags.HasError())
+	assert.True(t, isEmpty, "the export metrics also outlive the export")
+
+	require.NoError(t, os.WriteFile(filepath.Join(exportDir, defaultTfHCLFile), []byte(testGitUserHCL), 0644))
+	isEmpty, diags = isDirEmpty(exportDir)
+	require.False(t, diags.HasError())
+	assert.False(t, isEmpty)
+
+	d := schema.TestResourceDataRaw(t, ResourceTfExport().Schema, map[string]interface{}{})
+	d.SetId(exportDir)
+	require.False(t, deleteTfExport(context.Background(), d, nil).HasError())
+
+	entries, err := os.ReadDir(exportDir)
+	require.NoError(t, err)
+	names := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		names = append(names, entry.Name())
+	}
+	assert.ElementsMatch(t, []string{gitDirName, labelLockFileName, exportMetricsFileName}, names)
+	assert.FileExists(t, filepath.Join(exportDir, gitDirName, "HEAD"))
+}
@@ -0,0 +1,593 @@
+package tfexporter
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/provider"
+	resourceExporter "github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/resource_exporter"
+	"github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/util"
+	"github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/util/files"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+/*
+The export_scheduler.go file reads the state of the exported instances of every resource type with one pool of workers.
+
+The instances of all the resource types are queued at once, ordered so that the resource types that are expected to
+take the longest are read first. Each worker has its own queue, and a worker whose queue is empty steals half of the
+longest queue of the other workers, so a slow resource type is read by every worker instead of holding up the end of
+the export. The number of workers is the concurrency budget of the export: max_concurrent_threads, capped at the size
+of the SDK client pool so that no worker waits for a client.
+
+The time taken by each resource type is recorded in the export metrics. When include_export_metrics is set they are
+written to export_metrics.json, and the next export to the same directory uses them to order the resource types.
+*/
+
+const (
+	exportMetricsFileName = "export_metrics.json"
+
+	defaultMaxConcurrentOps = 10
+)
+
+// exportTask is the read of the state of one exported instance
+type exportTask struct {
+	resType string
+	id      string
+	resMeta *resourceExporter.ResourceMeta
+}
+
+// exportTaskQueue is the queue of a worker. The worker takes tasks from the front, and other workers steal from the
+// back.
+type exportTaskQueue struct {
+	mutex sync.Mutex
+	tasks []exportTask
+}
+
+func (q *exportTaskQueue) push(tasks ...exportTask) {
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+	q.tasks = append(q.tasks, tasks...)
+}
+
+func (q *exportTaskQueue) pop() (exportTask, bool) {
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+	if len(q.tasks) == 0 {
+		return exportTask{}, false
+	}
+	task := q.tasks[0]
+	q.tasks = q.tasks[1:]
+	return task, true
+}
+
+// stealHalf removes the back half of the queue, rounded up, and returns it
+func (q *exportTaskQueue) stealHalf() []exportTask {
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+	if len(q.tasks) == 0 {
+		return nil
+	}
+	keep := len(q.tasks) / 2
+	stolen := append([]exportTask{}, q.tasks[keep:]...)
+	q.tasks = q.tasks[:keep]
+	return stolen
+}
+
+func (q *exportTaskQueue) len() int {
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+	return len(q.tasks)
+}
+
+// workStealingScheduler runs a fixed set of tasks on a fixed number of workers
+type workStealingScheduler struct {
+	queues []*exportTaskQueue
+	steals int64
+}
+
+// newWorkStealingScheduler deals the tasks to the queues of the workers in turn, so that every worker starts with
+// the first tasks
+func newWorkStealingScheduler(workers int, tasks []exportTask) *workStealingScheduler {
+	if workers <= 0 {
+		workers = 1
+	}
+	if workers > len(tasks) && len(tasks) > 0 {
+		workers = len(tasks)
+	}
+	s := &workStealingScheduler{queues: make([]*exportTaskQueue, workers)}
+	for i := range s.queues {
+		s.queues[i] = &exportTaskQueue{}
+	}
+	for i, task := range tasks {
+		s.queues[i%workers].push(task)
+	}
+	return s
+}
+
+// run calls work for every task and returns once they are all done or the context is cancelled
+func (s *workStealingScheduler) run(ctx context.Context, work func(worker int, task exportTask)) {
+	var wg sync.WaitGroup
+	for worker := range s.queues {
+		wg.Add(1)
+		go func(worker int) {
+			defer wg.Done()
+			for ctx.Err() == nil {
+				task, ok := s.queues[worker].pop()
+				if !ok {
+					if !s.steal(worker) {
+						return
+					}
+					continue
+				}
+				work(worker, task)
+			}
+		}(worker)
+	}
+	wg.Wait()
+}
+
+// steal moves half of the longest queue of the other workers to the queue of a worker. It returns false when there
+// is nothing left to steal, as no tasks are added once the scheduler runs.
+func (s *workStealingScheduler) steal(worker int) bool {
+	for {
+		victim, longest := -1, 0
+		for i, queue := range s.queues {
+			if i == worker {
+				continue
+			}
+			if length := queue.len(); length > longest {
+				victim, longest = i, length
+			}
+		}
+		if victim < 0 {
+			return false
+		}
+		// The victim may have emptied its queue since it was measured, in which case look again
+		if stolen := s.queues[victim].stealHalf(); len(stolen) > 0 {
+			s.queues[worker].push(stolen...)
+			atomic.AddInt64(&s.steals, 1)
+			return true
+		}
+	}
+}
+
+// prioritizeExportTypes orders resource types by their expected read time, longest first. The expected read time is
+// the number of instances times the average read time of the type in the previous export, or the average of all the
+// types of the previous export for a type it did not have.
+func prioritizeExportTypes(instanceCounts map[string]int, previousAverages map[string]time.Duration) []string {
+	var fallback time.Duration
+	for _, average := range previousAverages {
+		fallback += average
+	}
+	if len(previousAverages) > 0 {
+		fallback /= time.Duration(len(previousAverages))
+	}
+	if fallback <= 0 {
+		fallback = time.Millisecond
+	}
+
+	expected := make(map[string]time.Duration, len(instanceCounts))
+	resTypes := make([]string, 0, len(instanceCounts))
+	for resType, count := range instanceCounts {
+		average, ok := previousAverages[resType]
+		if !ok || average <= 0 {
+			average = fallback
+		}
+		expected[resType] = time.Duration(count) * average
+		resTypes = append(resTypes, resType)
+	}
+	sort.Slice(resTypes, func(i, j int) bool {
+		if expected[resTypes[i]] != expected[resTypes[j]] {
+			return expected[resTypes[i]] > expected[resTypes[j]]
+		}
+		return resTypes[i] < resTypes[j]
+	})
+	return resTypes
+}
+
+// exportTypeMetrics are the metrics of the instances of one resource type
+type exportTypeMetrics struct {
+	ResourceType string `json:"resource_type"`
+	Priority     int    `json:"priority"`
+	Instances    int    `json:"instances"`
+	Exported     int    `json:"exported"`
+	Removed      int    `json:"removed"`
+	Failed       int    `json:"failed"`
+	// ReadDurationMs is the time spent reading the instances, summed over all workers
+	ReadDurationMs    int64   `json:"read_duration_ms"`
+	AverageReadMs     float64 `json:"average_read_ms"`
+	FirstStartedAtMs  int64   `json:"first_started_at_ms"`
+	LastFinishedAtMs  int64   `json:"last_finished_at_ms"`
+	firstStartedAtSet bool
+}
+
+// exportMetrics are the metrics of the reads of the exported instances. Times are relative to the start of the reads.
+type exportMetrics struct {
+	Workers        int                           `json:"workers"`
+	ClientPoolSize int                           `json:"client_pool_size"`
+	Instances      int                           `json:"instances"`
+	Steals         int64                         `json:"steals"`
+	DurationMs     int64                         `json:"duration_ms"`
+	ResourceTypes  map[string]*exportTypeMetrics `json:"resource_types"`
+}
+
+// record adds the read of one instance to the metrics of its type
+func (m *exportTypeMetrics) record(startedAt, finishedAt time.Duration) {
+	if !m.firstStartedAtSet || startedAt.Milliseconds() < m.FirstStartedAtMs {
+		m.FirstStartedAtMs = startedAt.Milliseconds()
+		m.firstStartedAtSet = true
+	}
+	if finishedAt.Milliseconds() > m.LastFinishedAtMs {
+		m.LastFinishedAtMs = finishedAt.Milliseconds()
+	}
+	m.ReadDurationMs += (finishedAt - startedAt).Milliseconds()
+	m.AverageReadMs = float64(m.ReadDurationMs) / float64(m.Exported+m.Removed+m.Failed)
+}
+
+// merge adds the metrics of another run, for the resource types read by dependency resolution
+func (m *exportMetrics) merge(run *exportMetrics) {
+	if run.Workers > m.Workers {
+		m.Workers = run.Workers
+	}
+	if run.ClientPoolSize > m.ClientPoolSize {
+		m.ClientPoolSize = run.ClientPoolSize
+	}
+	m.Instances += run.Instances
+	m.Steals += run.Steals
+	m.DurationMs += run.DurationMs
+	if m.ResourceTypes == nil {
+		m.ResourceTypes = make(map[string]*exportTypeMetrics)
+	}
+	for resType, typeMetrics := range run.ResourceTypes {
+		existing := m.ResourceTypes[resType]
+		if existing == nil {
+			m.ResourceTypes[resType] = typeMetrics
+			continue
+		}
+		existing.Instances += typeMetrics.Instances
+		existing.Exported += typeMetrics.Exported
+		existing.Removed += typeMetrics.Removed
+		existing.Failed += typeMetrics.Failed
+		existing.ReadDurationMs += typeMetrics.ReadDurationMs
+		if reads := existing.Exported + existing.Removed + existing.Failed; reads > 0 {
+			existing.AverageReadMs = float64(existing.ReadDurationMs) / float64(reads)
+		}
+	}
+}
+
+// averageReadDurations returns the average read time of the instances of each resource type
+func (m *exportMetrics) averageReadDurations() map[string]time.Duration {
+	averages := make(map[string]time.Duration)
+	for resType, typeMetrics := range m.ResourceTypes {
+		if typeMetrics.AverageReadMs > 0 {
+			averages[resType] = time.Duration(typeMetrics.AverageReadMs * float64(time.Millisecond))
+		}
+	}
+	return averages
+}
+
+// exportInstanceResult is the outcome of the read of one instance: the resource, the instance no longer existing,
+// or an error. All are empty when the read was cancelled.
+type exportInstanceResult struct {
+	resource *resourceExporter.ResourceInfo
+	removed  bool
+	err      *ResourceErrorInfo
+}
+
+// concurrencyBudget returns the number of instances read at once. It is max_concurrent_threads, capped at the size of
+// the SDK client pool, so that the workers never wait for a client.
+func (g *GenesysCloudResourceExporter) concurrencyBudget() (workers int, poolSize int) {
+	workers = g.maxConcurrentOps
+	if workers <= 0 {
+		workers = defaultMaxConcurrentOps
+	}
+	if provider.SdkClientPool != nil {
+		poolSize = provider.SdkClientPool.GetMaxClients()
+		if poolSize > 0 && workers > poolSize {
+			workers = poolSize
+		}
+	}
+	return workers, poolSize
+}
+
+// readResourceInstances reads the state of the instances of the resource types with one work-stealing scheduler and
+// returns the resources of each type. Instances that no longer exist are removed from the exporters, and instances
+// that failed are recorded in the resource errors of the export.
+func (g *GenesysCloudResourceExporter) readResourceInstances(exporters map[string]*resourceExporter.ResourceExporter, schemaProvider *schema.Provider, meta interface{}) (map[string][]resourceExporter.ResourceInfo, diag.Diagnostics) {
+	instanceCounts := make(map[string]int)
+	resourceMaps := make(map[string]resourceExporter.ResourceIDMetaMap)
+	schemas := make(map[string]*schema.Resource)
+	for resType, exporter := range exporters {
+		// Use thread-safe method to get a copy of the resource map
+		resourceMap := exporter.GetSanitizedResourceMap()
+		tflog.Info(g.ctx, fmt.Sprintf("Found %d resources for type %s", len(resourceMap), resType))
+		if len(resourceMap) == 0 {
+			continue
+		}
+		res := schemaProvider.ResourcesMap[resType]
+		if res == nil {
+			tflog.Error(g.ctx, fmt.Sprintf("Resource type %v not defined in schema provider", resType))
+			return nil, diag.Errorf("Resource type %v not defined", resType)
+		}
+		instanceCounts[resType] = len(resourceMap)
+		resourceMaps[resType] = resourceMap
+		schemas[resType] = res
+	}
+
+	priorities := prioritizeExportTypes(instanceCounts, g.previousReadDurations)
+	var tasks []exportTask
+	run := &exportMetrics{ResourceTypes: make(map[string]*exportTypeMetrics)}
+	for priority, resType := range priorities {
+		ids := make([]string, 0, len(resourceMaps[resType]))
+		for id := range resourceMaps[resType] {
+			ids = append(ids, id)
+		}
+		sort.Strings(ids)
+		for _, id := range ids {
+			tasks = append(tasks, exportTask{resType: resType, id: id, resMeta: resourceMaps[resType][id]})
+		}
+		run.ResourceTypes[resType] = &exportTypeMetrics{ResourceType: resType, Priority: priority + 1, Instances: len(ids)}
+	}
+	run.Instances = len(tasks)
+	run.Workers, run.ClientPoolSize = g.concurrencyBudget()
+	tflog.Info(g.ctx, fmt.Sprintf("Reading %d instances of %d resource types with %d workers, in the order %s", len(tasks), len(priorities), run.Workers, strings.Join(priorities, ", ")))
+
+	ctx, cancel := context.WithCancel(g.ctx)
+	defer cancel()
+
+	var resultsMutex sync.Mutex
+	resources := make(map[string][]resourceExporter.ResourceInfo)
+	removed := make(map[string][]string)
+	erroredResources := make(map[string][]ResourceErrorInfo)
+
+	scheduler := newWorkStealingScheduler(run.Workers, tasks)
+	start := time.Now()
+	scheduler.run(ctx, func(worker int, task exportTask) {
+		startedAt := time.Since(start)
+		result := g.readResourceInstance(ctx, task.resType, schemas[task.resType], schemaProvider, exporters[task.resType], task.id, task.resMeta, meta)
+		finishedAt := time.Since(start)
+
+		resultsMutex.Lock()
+		defer resultsMutex.Unlock()
+		typeMetrics := run.ResourceTypes[task.resType]
+		switch {
+		case result.resource != nil:
+			resources[task.resType] = append(resources[task.resType], *result.resource)
+			typeMetrics.Exported++
+		case result.removed:
+			removed[task.resType] = append(removed[task.resType], task.id)
+			typeMetrics.Removed++
+		case result.err != nil:
+			erroredResources[task.resType] = append(erroredResources[task.resType], *result.err)
+			typeMetrics.Failed++
+		default:
+			return
+		}
+		typeMetrics.record(startedAt, finishedAt)
+	})
+	run.DurationMs = time.Since(start).Milliseconds()
+	run.Steals = atomic.LoadInt64(&scheduler.steals)
+
+	for _, resType := range priorities {
+		exporter := exporters[resType]
+
+		// Remove resources that weren't found using thread-safe method
+		for _, id := range removed[resType] {
+			tflog.Debug(g.ctx, fmt.Sprintf("Removing resource %v from export map", id))
+			exporter.RemoveFromSanitizedResourceMap(id)
+		}
+
+		// Store errored resources in the exporter for later reporting
+		if len(erroredResources[resType]) > 0 {
+			g.resourceErrorsMutex.Lock()
+			g.resourceErrors[resType] = erroredResources[resType]
+			g.resourceErrorsMutex.Unlock()
+			tflog.Warn(g.ctx, fmt.Sprintf("Export completed for %s with %d errors out of %d resources", resType, len(erroredResources[resType]), instanceCounts[resType]))
+		} else {
+			tflog.Info(g.ctx, fmt.Sprintf("Export completed successfully for %s: %d resources successfully exported", resType, len(resources[resType])))
+		}
+
+		typeMetrics := run.ResourceTypes[resType]
+		tflog.Debug(g.ctx, fmt.Sprintf("Read %d instances of %s in %dms, between %dms and %dms", typeMetrics.Instances, resType, typeMetrics.ReadDurationMs, typeMetrics.FirstStartedAtMs, typeMetrics.LastFinishedAtMs))
+	}
+	tflog.Info(g.ctx, fmt.Sprintf("Read %d instances in %dms with %d workers and %d steals", run.Instances, run.DurationMs, run.Workers, run.Steals))
+
+	g.exportMetricsMutex.Lock()
+	if g.exportMetrics == nil {
+		g.exportMetrics = &exportMetrics{}
+	}
+	g.exportMetrics.merge(run)
+	g.exportMetricsMutex.Unlock()
+
+	return resources, nil
+}
+
+// readResourceInstance reads the state of one instance, retrying timeouts with exponential backoff
+func (g *GenesysCloudResourceExporter) readResourceInstance(ctx context.Context, resType string, res *schema.Resource, schemaProvider *schema.Provider, exporter *resourceExporter.ResourceExporter, id string, resMeta *resourceExporter.ResourceMeta, meta interface{}) (result exportInstanceResult) {
+	tflog.Trace(g.ctx, fmt.Sprintf("Started processing for resource: %s.%s (%s)", resType, resMeta.BlockLabel, id))
+
+	fetchResourceState := func() error {
+		resourceCtx, resourceCancel := context.WithCancel(ctx)
+		defer resourceCancel()
+
+		ctyType := res.CoreConfigSchema().ImpliedType()
+		tflog.Trace(g.ctx, fmt.Sprintf("Retrieved CTY type for resource ctyType: %v", ctyType))
+
+		tflog.Trace(g.ctx, fmt.Sprintf("Calling getResourceState for resource ID: %s", id))
+		instanceState, err := g.getResourceState(resourceCtx, res, id, resMeta, meta, resType)
+		if err != nil {
+			tflog.Error(g.ctx, fmt.Sprintf("Error while fetching read context type %s and instance %s : %v", resType, id, err))
+			return fmt.Errorf("Failed to get state for %s instance %s: %v", resType, id, err)
+		}
+
+		if instanceState == nil {
+			tflog.Warn(g.ctx, fmt.Sprintf("Resource %s no longer exists. Skipping.", resMeta.BlockLabel))
+			result.removed = true
+			return nil
+		}
+		tflog.Info(g.ctx, fmt.Sprintf("Successfully retrieved instance state for resource ID: %s", id))
+
+		// Export the resource as a data resource
+		if exporter.ExportAsDataFunc != nil {
+			tflog.Trace(g.ctx, fmt.Sprintf("Checking if resource should be exported as data source for ID: %s", id))
+			sdkConfig := g.meta.(*provider.ProviderMeta).ClientConfig
+			exportAsData, err := exporter.ExportAsDataFunc(g.ctx, sdkConfig, instanceState.Attributes)
+			if err != nil {
+				tflog.Error(g.ctx, fmt.Sprintf("Error in ExportAsDataFunc for resource ID %s: %v", id, err))
+				return fmt.Errorf("an error has occurred while trying to export as a data resource block for %s::%s : %v", resType, resMeta.BlockLabel, err)
+			}
+			if exportAsData {
+				tflog.Debug(g.ctx, fmt.Sprintf("Resource ID %s will be exported as data source", id))
+				g.addReplaceWithDatasource(resType + "::" + resMeta.BlockLabel)
+			}
+		}
+
+		blockType := ""
+		if g.isDataSource(resType, resMeta.BlockLabel, resMeta.OriginalLabel) {
+			attributes := make(map[string]string)
+			g.exMutex.Lock()
+			resData := schemaProvider.DataSourcesMap[resType]
+			g.exMutex.Unlock()
+
+			if resData == nil {
+				return fmt.Errorf("DataSource type %v not defined", resType)
+			}
+
+			ctyType = resData.CoreConfigSchema().ImpliedType()
+			for attr := range resData.SchemaMap() {
+				key, val := exporter.DataResolver(instanceState, attr)
+				attributes[key] = val
+			}
+			instanceState.Attributes = attributes
+			blockType = "data"
+		}
+
+		result.resource = &resourceExporter.ResourceInfo{
+			State:         instanceState,
+			BlockLabel:    resMeta.BlockLabel,
+			Type:          resType,
+			CtyType:       ctyType,
+			BlockType:     blockType,
+			OriginalLabel: resMeta.OriginalLabel,
+		}
+		return nil
+	}
+
+	// Allows retries up to three times before reporting error
+	maxRetries := 3
+	var lastErr error
+	for attempt := 0; attempt < maxRetries; attempt++ {
+		tflog.Debug(g.ctx, fmt.Sprintf("Attempt %d/%d for resource ID: %s", attempt+1, maxRetries, id))
+		if ctx.Err() != nil {
+			tflog.Warn(g.ctx, fmt.Sprintf("Context cancelled during retry attempt %d for resource ID: %s", attempt+1, id))
+			return exportInstanceResult{}
+		}
+
+		err := fetchResourceState()
+		if err == nil {
+			tflog.Info(g.ctx, fmt.Sprintf("Successfully processed resource ID: %s on attempt %d", id, attempt+1))
+			return result
+		}
+		lastErr = err
+		tflog.Error(g.ctx, fmt.Sprintf("Error on attempt %d for resource ID %s: %v", attempt+1, id, err))
+
+		if !util.IsTimeoutError(err) {
+			return exportInstanceResult{err: &ResourceErrorInfo{
+				ResourceType:  resType,
+				ResourceID:    id,
+				ResourceLabel: resMeta.BlockLabel,
+				ErrorMessage:  fmt.Sprintf("Non-retryable error: %v", err),
+				IsTimeout:     false,
+			}}
+		}
+
+		// On timeout errors, try to add new client connections to the existing pool
+		if attempt == 0 {
+			version := "1.0.0" // Default version
+			if providerMeta, ok := g.meta.(*provider.ProviderMeta); ok && providerMeta.Version != "" {
+				version = providerMeta.Version
+			}
+			if provider.SdkClientPool != nil {
+				provider.SdkClientPool.AdjustPoolForTimeout(version)
+			}
+
+			// Add a small delay to allow the new clients to be available
+			select {
+			case <-time.After(5 * time.Second):
+			case <-ctx.Done():
+				tflog.Warn(g.ctx, fmt.Sprintf("Context cancelled during pool adjustment delay for resource ID: %s", id))
+				return exportInstanceResult{}
+			}
+		}
+		// Exponential backoff for retryable errors
+		if attempt < maxRetries-1 {
+			backoffDuration := time.Duration(1<<attempt) * time.Second
+			tflog.Info(g.ctx, fmt.Sprintf("Retrying resource %s (attempt %d/%d) after %v backoff", id, attempt+1, maxRetries, backoffDuration))
+			select {
+			case <-time.After(backoffDuration):
+			case <-ctx.Done():
+				tflog.Warn(g.ctx, fmt.Sprintf("Context cancelled during backoff for resource ID: %s", id))
+				return exportInstanceResult{}
+			}
+		}
+	}
+
+	// If we get here, all retries failed
+	return exportInstanceResult{err: &ResourceErrorInfo{
+		ResourceType:  resType,
+		ResourceID:    id,
+		ResourceLabel: resMeta.BlockLabel,
+		ErrorMessage:  fmt.Sprintf("Failed after %d retries: %v", maxRetries, lastErr),
+		IsTimeout:     util.IsTimeoutError(lastErr),
+	}}
+}
+
+// loadPreviousExportMetrics reads the metrics of the previous export to the export directory, if any, to order the
+// resource types by their read time. It runs before anything is written to the export directory.
+func (g *GenesysCloudResourceExporter) loadPreviousExportMetrics() {
+	content, err := os.ReadFile(filepath.Join(g.exportDirPath, exportMetricsFileName))
+	if err != nil {
+		return
+	}
+	var previous exportMetrics
+	if err := json.Unmarshal(content, &previous); err != nil {
+		tflog.Warn(g.ctx, fmt.Sprintf("Ignoring the metrics of the previous export: %v", err))
+		return
+	}
+	g.previousReadDurations = previous.averageReadDurations()
+	tflog.Info(g.ctx, fmt.Sprintf("Loaded the read times of %d resource types from the previous export", len(g.previousReadDurations)))
+}
+
+// writeExportMetrics writes the export metrics when include_export_metrics is set
+func (g *GenesysCloudResourceExporter) writeExportMetrics() diag.Diagnostics {
+	if include, _ := g.d.Get("include_export_metrics").(bool); !include {
+		return nil
+	}
+
+	g.exportMetricsMutex.Lock()
+	metrics := g.exportMetrics
+	if metrics == nil {
+		metrics = &exportMetrics{ResourceTypes: make(map[string]*exportTypeMetrics)}
+	}
+	content, err := json.MarshalIndent(metrics, "", "  ")
+	g.exportMetricsMutex.Unlock()
+	if err != nil {
+		return diag.Errorf("Failed to build the export metrics: %v", err)
+	}
+
+	metricsPath := filepath.Join(g.exportDirPath, exportMetricsFileName)
+	tflog.Info(g.ctx, fmt.Sprintf("Writing the export metrics to %s", metricsPath))
+	return files.WriteToFile(content, metricsPath)
+}
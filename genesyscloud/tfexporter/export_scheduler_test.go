@@ -0,0 +1,128 @@
+package tfexporter
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestUnitWorkStealingSchedulerRunsEveryTaskOnce asserts that every task runs exactly once, and that the workers
+// steal the tasks of a worker held up by a slow task
+func TestUnitWorkStealingSchedulerRunsEveryTaskOnce(t *testing.T) {
+	var tasks []exportTask
+	for i := 0; i < 200; i++ {
+		tasks = append(tasks, exportTask{resType: "genesyscloud_user", id: fmt.Sprintf("user-%03d", i)})
+	}
+
+	scheduler := newWorkStealingScheduler(4, tasks)
+	require.Len(t, scheduler.queues, 4)
+
+	var mutex sync.Mutex
+	runs := make(map[string]int)
+	workers := make(map[int]int)
+	scheduler.run(context.Background(), func(worker int, task exportTask) {
+		// The first task of the first worker takes long enough for the others to run out of tasks
+		if task.id == "user-000" {
+			time.Sleep(100 * time.Millisecond)
+		}
+		mutex.Lock()
+		defer mutex.Unlock()
+		runs[task.id]++
+		workers[worker]++
+	})
+
+	require.Len(t, runs, len(tasks))
+	for id, count := range runs {
+		assert.Equal(t, 1, count, "task %s", id)
+	}
+	assert.Positive(t, scheduler.steals)
+	assert.Less(t, workers[0], len(tasks)/4, "the tasks of the held up worker were stolen")
+}
+
+// TestUnitWorkStealingSchedulerCancel asserts that no task starts once the context is cancelled
+func TestUnitWorkStealingSchedulerCancel(t *testing.T) {
+	var tasks []exportTask
+	for i := 0; i < 50; i++ {
+		tasks = append(tasks, exportTask{id: fmt.Sprintf("%d", i)})
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	var mutex sync.Mutex
+	runs := 0
+	newWorkStealingScheduler(2, tasks).run(ctx, func(worker int, task exportTask) {
+		mutex.Lock()
+		defer mutex.Unlock()
+		runs++
+		cancel()
+	})
+	assert.LessOrEqual(t, runs, 2)
+
+	assert.Len(t, newWorkStealingScheduler(8, tasks[:3]).queues, 3, "there are no more workers than tasks")
+	assert.Len(t, newWorkStealingScheduler(0, nil).queues, 1)
+}
+
+// TestUnitPrioritizeExportTypes asserts that the types expected to take the longest are read first, using the read
+// times of the previous export when there are some
+func TestUnitPrioritizeExportTypes(t *testing.T) {
+	counts := map[string]int{
+		"genesyscloud_user":           500,
+		"genesyscloud_routing_queue":  100,
+		"genesyscloud_flow":           100,
+		"genesyscloud_routing_skill":  20,
+		"genesyscloud_routing_wrapup": 20,
+	}
+
+	assert.Equal(t, []string{
+		"genesyscloud_user",
+		"genesyscloud_flow",
+		"genesyscloud_routing_queue",
+		"genesyscloud_routing_skill",
+		"genesyscloud_routing_wrapup",
+	}, prioritizeExportTypes(counts, nil), "without read times, the types with the most instances come first")
+
+	previous := map[string]time.Duration{
+		"genesyscloud_user":          100 * time.Millisecond,
+		"genesyscloud_flow":          2 * time.Second,
+		"genesyscloud_routing_queue": 300 * time.Millisecond,
+	}
+	// The types missing from the previous export are expected to take 800ms, the average of the others
+	assert.Equal(t, []string{
+		"genesyscloud_flow",
+		"genesyscloud_user",
+		"genesyscloud_routing_queue",
+		"genesyscloud_routing_skill",
+		"genesyscloud_routing_wrapup",
+	}, prioritizeExportTypes(counts, previous))
+}
+
+// TestUnitExportMetricsMerge asserts that the metrics of the reads made for dependency resolution are added to the
+// metrics of the export, and that their averages are the read times used by the next export
+func TestUnitExportMetricsMerge(t *testing.T) {
+	users := &exportTypeMetrics{ResourceType: "genesyscloud_user", Instances: 2, Exported: 2}
+	users.record(0, 100*time.Millisecond)
+	users.record(50*time.Millisecond, 350*time.Millisecond)
+	assert.Equal(t, int64(400), users.ReadDurationMs)
+	assert.Equal(t, 200.0, users.AverageReadMs)
+	assert.Equal(t, int64(0), users.FirstStartedAtMs)
+	assert.Equal(t, int64(350), users.LastFinishedAtMs)
+
+	metrics := &exportMetrics{}
+	metrics.merge(&exportMetrics{Workers: 4, Instances: 2, Steals: 1, DurationMs: 350, ResourceTypes: map[string]*exportTypeMetrics{"genesyscloud_user": users}})
+
+	moreUsers := &exportTypeMetrics{ResourceType: "genesyscloud_user", Instances: 1, Failed: 1}
+	moreUsers.record(0, 500*time.Millisecond)
+	metrics.merge(&exportMetrics{Workers: 2, Instances: 1, DurationMs: 500, ResourceTypes: map[string]*exportTypeMetrics{"genesyscloud_user": moreUsers}})
+
+	assert.Equal(t, 4, metrics.Workers)
+	assert.Equal(t, 3, metrics.Instances)
+	assert.Equal(t, int64(850), metrics.DurationMs)
+	assert.Equal(t, 3, metrics.ResourceTypes["genesyscloud_user"].Instances)
+	assert.Equal(t, 1, metrics.ResourceTypes["genesyscloud_user"].Failed)
+	assert.Equal(t, 300.0, metrics.ResourceTypes["genesyscloud_user"].AverageReadMs)
+	assert.Equal(t, map[string]time.Duration{"genesyscloud_user": 300 * time.Millisecond}, metrics.averageReadDurations())
+}
@@ -0,0 +1,172 @@
+package tfexporter
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	resourceExporter "github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/resource_exporter"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+/*
+The export_stream.go file implements stream_output, which exports one resource type at a time to cap the memory used
+by very large exports. The states and config maps of a resource type are written to the type's file as soon as they
+are sanitized and resolved, and dropped before the next type is read. Only the index of the exported resources is kept
+for the whole export: the ID and label of every resource in the sanitized resource maps of the exporters, which is
+what references to other resource types are resolved with, and the ID, label and type of every exported resource,
+which is what the moved blocks are written from.
+
+The resource types that can decide to export an instance as a data source are read before any type is written, so
+that references to those instances are written as references to data sources.
+
+Streaming writes one file per resource type, as split_files_by_resource does. The settings that need all the exported
+resources in memory at once cannot be used with it.
+*/
+
+// validateStreamOutput returns an error naming the settings that cannot be used with stream_output
+func (g *GenesysCloudResourceExporter) validateStreamOutput() diag.Diagnostics {
+	var conflicts []string
+	if g.includeStateFile {
+		conflicts = append(conflicts, "include_state_file")
+	}
+	if g.addDependsOn {
+		conflicts = append(conflicts, "enable_dependency_resolution")
+	}
+	if formats, _ := g.d.Get("dependency_graph_formats").([]interface{}); len(formats) > 0 {
+		conflicts = append(conflicts, "dependency_graph_formats")
+	}
+	if templates, _ := g.d.Get("label_templates").(map[string]interface{}); len(templates) > 0 {
+		conflicts = append(conflicts, "label_templates")
+	}
+	if useLock, _ := g.d.Get("use_label_lock_file").(bool); useLock {
+		conflicts = append(conflicts, "use_label_lock_file")
+	}
+	if g.writesInventory() {
+		conflicts = append(conflicts, fmt.Sprintf("export_format %s", g.exportFormat))
+	}
+	if len(conflicts) > 0 {
+		return diag.Errorf("stream_output cannot be used with %s, as they need all the exported resources in memory at once", strings.Join(conflicts, ", "))
+	}
+	return nil
+}
+
+// streamGenesysCloudObjectInstances reads, sanitizes and writes the exported resources one resource type at a time. It
+// replaces the retrieval of all the instances and the building of all the config maps, and leaves only the index of
+// the exported resources in memory for the files written once all the types are done.
+func (g *GenesysCloudResourceExporter) streamGenesysCloudObjectInstances() diag.Diagnostics {
+	g.exportersMutex.RLock()
+	exportersCopy := make(map[string]*resourceExporter.ResourceExporter)
+	for k, v := range *g.exporters {
+		exportersCopy[k] = v
+	}
+	g.exportersMutex.RUnlock()
+
+	resTypes := make([]string, 0, len(exportersCopy))
+	exportAsDataExporters := make(map[string]*resourceExporter.ResourceExporter)
+	for resType, exporter := range exportersCopy {
+		resTypes = append(resTypes, resType)
+		if exporter.ExportAsDataFunc != nil {
+			exportAsDataExporters[resType] = exporter
+		}
+	}
+	sort.Strings(resTypes)
+
+	// Read the types that can be exported as data sources first, so that every reference to them is resolved correctly
+	readAhead, diagErr := g.readResourceInstances(exportAsDataExporters, g.provider, g.meta)
+	if diagErr.HasError() {
+		return diagErr
+	}
+
+	var index []resourceExporter.ResourceInfo
+	for _, resType := range resTypes {
+		resources, ok := readAhead[resType]
+		if ok {
+			delete(readAhead, resType)
+		} else if exportAsDataExporters[resType] == nil {
+			resourcesByType, diagErr := g.readResourceInstances(map[string]*resourceExporter.ResourceExporter{resType: exportersCopy[resType]}, g.provider, g.meta)
+			if diagErr.HasError() {
+				return diagErr
+			}
+			resources = resourcesByType[resType]
+		}
+		if len(resources) == 0 {
+			tflog.Warn(g.ctx, fmt.Sprintf("No resources found for type %s", resType))
+			continue
+		}
+
+		diagErr := g.writeStreamedResourceType(resType, resources)
+		if diagErr.HasError() {
+			return diagErr
+		}
+		index = append(index, g.streamedResourceIndex()...)
+	}
+
+	g.setResources(index)
+	g.setResourceTypesMaps(make(map[string]ResourceJSONMaps))
+	g.setDataSourceTypesMaps(make(map[string]ResourceJSONMaps))
+	tflog.Info(g.ctx, fmt.Sprintf("Streamed %d resources of %d resource types", len(index), len(resTypes)))
+	return nil
+}
+
+// writeStreamedResourceType builds the config maps of the resources of one type and writes them to the files of the
+// type
+func (g *GenesysCloudResourceExporter) writeStreamedResourceType(resType string, resources []resourceExporter.ResourceInfo) diag.Diagnostics {
+	tflog.Info(g.ctx, fmt.Sprintf("Streaming %d resources of type %s", len(resources), resType))
+	g.setResources(resources)
+
+	diagErr := g.buildResourceConfigMap()
+	if diagErr.HasError() {
+		return diagErr
+	}
+	diagErr = append(diagErr, g.removeUserDefinedExcludedAttributesFromConfigMaps()...)
+	if diagErr.HasError() {
+		return diagErr
+	}
+
+	resourceTypesMaps := g.getResourceTypesMaps()
+	dataSourceTypesMaps := g.getDataSourceTypesMaps()
+	if g.matchesExportFormat(formatHCL, formatJSONHCL) {
+		hclExporter := NewHClExporter(resourceTypesMaps, dataSourceTypesMaps, nil, g.providerRegistry, g.version, g.exportDirPath, true, false)
+		diagErr = append(diagErr, hclExporter.exportHCLResourceFiles()...)
+	}
+	if g.matchesExportFormat(formatJSON, formatJSONHCL) {
+		jsonExporter := NewJsonExporter(resourceTypesMaps, dataSourceTypesMaps, nil, g.providerRegistry, g.version, g.exportDirPath, true, false)
+		diagErr = append(diagErr, jsonExporter.exportJSONResourceFiles()...)
+	}
+
+	// The attributes exported with jsonencode are only referenced from the files of their own type
+	g.clearAttributesDecoded()
+	return diagErr
+}
+
+// streamedResourceIndex returns the resources of the type that was just streamed, keeping only their ID and labels
+func (g *GenesysCloudResourceExporter) streamedResourceIndex() []resourceExporter.ResourceInfo {
+	resources := g.getResources()
+	index := make([]resourceExporter.ResourceInfo, 0, len(resources))
+	for _, resource := range resources {
+		if resource.State == nil {
+			continue
+		}
+		index = append(index, resourceExporter.ResourceInfo{
+			State:         &terraform.InstanceState{ID: resource.State.ID},
+			BlockLabel:    resource.BlockLabel,
+			Type:          resource.Type,
+			BlockType:     resource.BlockType,
+			OriginalLabel: resource.OriginalLabel,
+		})
+	}
+	return index
+}
+
+// clearAttributesDecoded drops the content of the attributes exported with jsonencode once they are written
+func (g *GenesysCloudResourceExporter) clearAttributesDecoded() {
+	g.attributesDecodedMutex.Lock()
+	defer g.attributesDecodedMutex.Unlock()
+	for uid := range attributesDecoded {
+		delete(attributesDecoded, uid)
+	}
+}
@@ -0,0 +1,61 @@
+package tfexporter
+
+import (
+	"testing"
+
+	resourceExporter "github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/resource_exporter"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestUnitValidateStreamOutput asserts that the settings needing all the exported resources in memory are rejected
+// with stream_output
+func TestUnitValidateStreamOutput(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, ResourceTfExport().Schema, map[string]interface{}{
+		"directory":     "./genesyscloud",
+		"export_format": "hcl",
+		"stream_output": true,
+	})
+	g := &GenesysCloudResourceExporter{d: d, exportFormat: "hcl", streamOutput: true}
+	assert.False(t, g.validateStreamOutput().HasError())
+
+	d = schema.TestResourceDataRaw(t, ResourceTfExport().Schema, map[string]interface{}{
+		"directory":           "./genesyscloud",
+		"export_format":       "hcl_sqlite",
+		"stream_output":       true,
+		"use_label_lock_file": true,
+	})
+	g = &GenesysCloudResourceExporter{d: d, exportFormat: "hcl_sqlite", streamOutput: true, includeStateFile: true}
+	diagErr := g.validateStreamOutput()
+	require.True(t, diagErr.HasError())
+	assert.Contains(t, diagErr[0].Summary, "include_state_file, use_label_lock_file, export_format hcl_sqlite")
+}
+
+// TestUnitStreamedResourceIndex asserts that only the IDs and labels of the streamed resources are kept
+func TestUnitStreamedResourceIndex(t *testing.T) {
+	g := &GenesysCloudResourceExporter{}
+	g.setResources([]resourceExporter.ResourceInfo{
+		{
+			State: &terraform.InstanceState{
+				ID:         "queue-1",
+				Attributes: map[string]string{"name": "Support", "description": "Support queue"},
+			},
+			BlockLabel:    "Support",
+			OriginalLabel: "Support ",
+			Type:          "genesyscloud_routing_queue",
+			BlockType:     "resource",
+		},
+		{BlockLabel: "missing", Type: "genesyscloud_routing_queue"},
+	})
+
+	index := g.streamedResourceIndex()
+	require.Len(t, index, 1)
+	assert.Equal(t, "queue-1", index[0].State.ID)
+	assert.Empty(t, index[0].State.Attributes)
+	assert.Equal(t, "Support", index[0].BlockLabel)
+	assert.Equal(t, "Support ", index[0].OriginalLabel)
+	assert.Equal(t, "genesyscloud_routing_queue", index[0].Type)
+}
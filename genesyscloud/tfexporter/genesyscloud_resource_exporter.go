@@ -14,11 +14,14 @@ import (
 	"path/filepath"
 	"reflect"
 	"regexp"
+	"sort"
 	"strconv"
 	"strings"
 	"sync"
 	"time"
 
+	architectDatatable "github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/architect_datatable"
+	architectDatatableRow "github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/architect_datatable_row"
 	architectFlow "github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/architect_flow"
 	dependentconsumers "github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/dependent_consumers"
 	"github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/mrmo"
@@ -69,6 +72,8 @@ type unresolvableAttributeInfo struct {
 	ResourceLabel string
 	Name          string
 	Schema        *schema.Schema
+	// HasValue is set when the exported state holds a value that must be read from the secret store
+	HasValue bool
 }
 
 const (
@@ -99,10 +104,17 @@ type GenesysCloudResourceExporter struct {
 	d                   *schema.ResourceData
 	dataSourceTypesMaps map[string]ResourceJSONMaps
 	dependsList         map[string][]string
+	exportMetrics       *exportMetrics
 	exporters           *map[string]*resourceExporter.ResourceExporter
 	filterList          *[]string
 	filterType          ExporterFilterType
 	flowResourcesList   []string
+	labeler             *blockLabeler
+	previousLabels      map[string]map[string]string
+	secretStore         *secretStore
+
+	// previousReadDurations holds the average read time of the instances of each resource type in the previous export
+	previousReadDurations map[string]time.Duration
 
 	// resourceExportedForMrMo stores the schema.ResourceData object of the resource that was exported to Mr Mo
 	resourceExportedForMrMo  *schema.ResourceData
@@ -131,6 +143,7 @@ type GenesysCloudResourceExporter struct {
 	dataSourceTypesMapsMutex   sync.RWMutex
 	dependsListMutex           sync.RWMutex
 	exMutex                    sync.RWMutex
+	exportMetricsMutex         sync.Mutex
 	exportersMutex             sync.RWMutex
 	filterListMutex            sync.RWMutex
 	flowResourcesListMutex     sync.RWMutex
@@ -155,6 +168,7 @@ type GenesysCloudResourceExporter struct {
 	includeStateFile         bool
 	logPermissionErrors      bool
 	splitFilesByResource     bool
+	streamOutput             bool
 }
 
 func configureExporterType(ctx context.Context, d *schema.ResourceData, gre *GenesysCloudResourceExporter, filterType ExporterFilterType) {
@@ -208,7 +222,8 @@ func NewGenesysCloudResourceExporter(ctx context.Context, d *schema.ResourceData
 	}
 	gre := &GenesysCloudResourceExporter{
 		exportFormat:             identifyExportFormat(d),
-		splitFilesByResource:     d.Get("split_files_by_resource").(bool),
+		splitFilesByResource:     d.Get("split_files_by_resource").(bool) || d.Get("stream_output").(bool),
+		streamOutput:             d.Get("stream_output").(bool),
 		logPermissionErrors:      d.Get("log_permission_errors").(bool),
 		exportComputed:           d.Get("export_computed").(bool),
 		exportDeprecated:         d.Get("export_deprecated").(bool),
@@ -311,6 +326,24 @@ func (g *GenesysCloudResourceExporter) Export() (diagErr diag.Diagnostics) {
 		tflog.Error(g.ctx, fmt.Sprintf("Failed to retrieve exporters: %v", diagErr))
 		return diagErr
 	}
+
+	// Step #1.5 Load the label templates and the label lock file used to keep block labels stable between exports,
+	// the labels of the previous export used to write moved blocks, the secret store settings, and the export metrics
+	// of the previous export, whose read times decide which resource types the scheduler reads first in step #3
+	diagErr = append(diagErr, g.validateGitCommit()...)
+	if diagErr.HasError() {
+		return diagErr
+	}
+	diagErr = append(diagErr, g.setupBlockLabeler()...)
+	if diagErr.HasError() {
+		return diagErr
+	}
+	diagErr = append(diagErr, g.loadPreviousLabels()...)
+	if diagErr.HasError() {
+		return diagErr
+	}
+	g.setupSecretStore()
+	g.loadPreviousExportMetrics()
 	// Step #2 Retrieve all the individual resources we are going to export
 	diagErr = append(diagErr, g.retrieveSanitizedResourceMaps()...)
 	if diagErr.HasError() {
@@ -329,8 +362,19 @@ func (g *GenesysCloudResourceExporter) Export() (diagErr diag.Diagnostics) {
 		}
 	}
 
-	// Step #3 Retrieve the individual genesys cloud object instances
-	diagErr = append(diagErr, g.retrieveGenesysCloudObjectInstances()...)
+	// Step #3 Retrieve the individual genesys cloud object instances. The instances of every resource type are read by
+	// one pool of workers that steal work from each other, starting with the resource types expected to take the
+	// longest (see export_scheduler.go). With stream_output, they are instead read, converted and written one resource
+	// type at a time (steps #5 and #6.5), so that only the index of the resources stays in memory.
+	if g.streamOutput {
+		diagErr = append(diagErr, g.validateStreamOutput()...)
+		if diagErr.HasError() {
+			return diagErr
+		}
+		diagErr = append(diagErr, g.streamGenesysCloudObjectInstances()...)
+	} else {
+		diagErr = append(diagErr, g.retrieveGenesysCloudObjectInstances()...)
+	}
 	if diagErr.HasError() {
 		return diagErr
 	}
@@ -342,9 +386,11 @@ func (g *GenesysCloudResourceExporter) Export() (diagErr diag.Diagnostics) {
 	}
 
 	// Step #5 Convert the Genesys Cloud resources to neutral format (e.g. map of maps)
-	diagErr = append(diagErr, g.buildResourceConfigMap()...)
-	if diagErr.HasError() {
-		return diagErr
+	if !g.streamOutput {
+		diagErr = append(diagErr, g.buildResourceConfigMap()...)
+		if diagErr.HasError() {
+			return diagErr
+		}
 	}
 
 	// Step #6 export dependents for other resources
@@ -360,7 +406,8 @@ func (g *GenesysCloudResourceExporter) Export() (diagErr diag.Diagnostics) {
 	// were never applied to the exporter instances used during sanitization.
 	diagErr = append(diagErr, g.removeUserDefinedExcludedAttributesFromConfigMaps()...)
 
-	// Step #7 Write the terraform state file along with either the HCL or JSON
+	// Step #7 Write the terraform state file along with either the HCL or JSON, and the export metrics recorded by
+	// the scheduler when include_export_metrics is set
 	diagErr = append(diagErr, g.generateOutputFiles()...)
 	if diagErr.HasError() {
 		return diagErr
@@ -433,6 +480,9 @@ func (g *GenesysCloudResourceExporter) Export() (diagErr diag.Diagnostics) {
 	} else {
 		tflog.Info(g.ctx, "Export completed successfully with no errors")
 	}
+
+	// step #10 Commit the exported files to the git repository of the export directory
+	diagErr = append(diagErr, g.commitExportToGit()...)
 	return diagErr
 }
 
@@ -473,6 +523,13 @@ func (g *GenesysCloudResourceExporter) retrieveExporters() (diagErr diag.Diagnos
 		exports = g.resourceTypeFilter(exports, *filterList)
 	}
 
+	// The rows of the exported datatables are exported either as CSV files or as one resource per row, never both, as
+	// the two would manage the same rows
+	if rowsAsCsv, _ := g.d.Get("export_datatable_rows_as_csv").(bool); rowsAsCsv && exports[architectDatatable.ResourceType] != nil {
+		exports[architectDatatable.ResourceType] = architectDatatable.ArchitectDatatableRowsFileExporter()
+		delete(exports, architectDatatableRow.ResourceType)
+	}
+
 	// Thread-safe update of exporters
 	g.exportersMutex.Lock()
 	g.exporters = &exports
@@ -541,7 +598,8 @@ func (g *GenesysCloudResourceExporter) retrieveSanitizedResourceMaps() (diagErr
 	return diagErr
 }
 
-// retrieveGenesysCloudObjectInstances will take a list of exporters and then return the actual terraform Genesys Cloud data
+// retrieveGenesysCloudObjectInstances will take a list of exporters and then return the actual terraform Genesys Cloud data.
+// The instances of all the resource types are read by one work-stealing scheduler (see export_scheduler.go).
 func (g *GenesysCloudResourceExporter) retrieveGenesysCloudObjectInstances() diag.Diagnostics {
 	tflog.Info(g.ctx, "Starting to retrieve Genesys Cloud objects from Genesys Cloud")
 
@@ -551,125 +609,50 @@ func (g *GenesysCloudResourceExporter) retrieveGenesysCloudObjectInstances() dia
 	for k, v := range *g.exporters {
 		exportersCopy[k] = v
 	}
-	exportersLen := len(*g.exporters)
 	g.exportersMutex.RUnlock()
 
-	tflog.Info(g.ctx, fmt.Sprintf("Number of exporters to process: %d", exportersLen))
+	tflog.Info(g.ctx, fmt.Sprintf("Number of exporters to process: %d", len(exportersCopy)))
 
-	// Log all exporter types being processed
-	for resType, exporter := range exportersCopy {
-		tflog.Debug(g.ctx, fmt.Sprintf("Exporter for %s has %d resources in SanitizedResourceMap", resType, len(exporter.SanitizedResourceMap)))
+	resourcesByType, err := g.readResourceInstances(exportersCopy, g.provider, g.meta)
+	if err != nil {
+		tflog.Error(g.ctx, fmt.Sprintf("Returning error retrieving cloud object instances: %v", err))
+		return err
 	}
 
-	// Retrieves data on each individual Genesys Cloud object from each registered exporter
-
-	// Buffer error channel to prevent goroutine leaks or deadlocks
-	errorChan := make(chan diag.Diagnostics, exportersLen)
-	wgDone := make(chan bool)
-	var wg sync.WaitGroup
-
-	ctx, cancel := context.WithCancel(g.ctx)
-	defer cancel()
-	tflog.Trace(g.ctx, "Created context with cancellation")
-
 	// Track successful and failed resource types
 	var successfulTypes []string
 	var failedTypes []string
-	var statsMutex sync.Mutex
-
-	maxConcurrentOps := g.maxConcurrentOps
-	if maxConcurrentOps <= 0 {
-		maxConcurrentOps = 10
+	resTypes := make([]string, 0, len(exportersCopy))
+	for resType := range exportersCopy {
+		resTypes = append(resTypes, resType)
+	}
+	sort.Strings(resTypes)
+	for _, resType := range resTypes {
+		typeResources := resourcesByType[resType]
+		if len(typeResources) == 0 {
+			tflog.Warn(g.ctx, fmt.Sprintf("No resources found for type %s", resType))
+			continue
+		}
+		// Use thread-safe method to add resources
+		g.addResources(typeResources)
+		successfulTypes = append(successfulTypes, resType)
+		tflog.Debug(g.ctx, fmt.Sprintf("Successfully added %d resources for type %s to global resources list", len(typeResources), resType))
 	}
-	sem := make(chan struct{}, maxConcurrentOps)
-
-	// We use concurrency here to spin off each exporter type and getting the data
-	for resType, exporter := range exportersCopy {
-		tflog.Debug(g.ctx, fmt.Sprintf("Starting processing for resource type: %s", resType))
-		wg.Add(1)
-		go func(resType string, exporter *resourceExporter.ResourceExporter) {
-			defer wg.Done()
-			tflog.Trace(g.ctx, fmt.Sprintf("Starting goroutine for resource type: %s", resType))
-
-			// Acquire semaphore
-			select {
-			case sem <- struct{}{}:
-				defer func() { <-sem }()
-			case <-ctx.Done():
-				tflog.Warn(g.ctx, fmt.Sprintf("Context cancelled while acquiring semaphore for resource type: %s", resType))
-				return
-			}
-
-			tflog.Debug(g.ctx, fmt.Sprintf("Getting exported resources for [%s]", resType))
-			typeResources, err := g.getResourcesForType(resType, g.provider, exporter, g.meta)
-
-			if err != nil {
-				tflog.Error(g.ctx, fmt.Sprintf("Error getting resources for type %s: %v", resType, err))
-
-				// Track failed resource type
-				statsMutex.Lock()
-				failedTypes = append(failedTypes, resType)
-				statsMutex.Unlock()
-
-				select {
-				case <-ctx.Done():
-					tflog.Warn(g.ctx, fmt.Sprintf("Context cancelled while handling error for %s", resType))
-				case errorChan <- err:
-					tflog.Trace(g.ctx, fmt.Sprintf("Successfully sent error to channel for resource type: %s", resType))
-				default:
-					tflog.Error(g.ctx, fmt.Sprintf("Could not send error to channel for resource type: %s (channel full)", resType))
-				}
-				cancel()
-				return
-			}
-
-			tflog.Info(g.ctx, fmt.Sprintf("Successfully retrieved %d resources for type %s", len(typeResources), resType))
-
-			// Use thread-safe method to add resources
-			if len(typeResources) > 0 {
-				g.addResources(typeResources)
-				tflog.Debug(g.ctx, fmt.Sprintf("Successfully added %d resources for type %s to global resources list", len(typeResources), resType))
-
-				// Track successful resource type
-				statsMutex.Lock()
-				successfulTypes = append(successfulTypes, resType)
-				statsMutex.Unlock()
-			} else {
-				tflog.Warn(g.ctx, fmt.Sprintf("No resources found for type %s", resType))
-			}
-
-			tflog.Debug(g.ctx, fmt.Sprintf("Completed processing for resource type: %s", resType))
-		}(resType, exporter)
+	g.resourceErrorsMutex.RLock()
+	for _, resType := range resTypes {
+		if len(g.resourceErrors[resType]) > 0 && len(resourcesByType[resType]) == 0 {
+			failedTypes = append(failedTypes, resType)
+		}
 	}
+	g.resourceErrorsMutex.RUnlock()
 
-	tflog.Trace(g.ctx, "Started all goroutines, waiting for completion")
-
-	go func() {
-		wg.Wait()
-		tflog.Info(g.ctx, "All resource type exports completed")
-		close(wgDone)
-	}()
-
-	// Wait until either WaitGroup is done or an error is received
-	select {
-	case <-wgDone:
-		tflog.Info(g.ctx, "Successfully retrieved all Genesys Cloud object instances")
-		tflog.Info(g.ctx, fmt.Sprintf("Summary - Successful types: %v", successfulTypes))
-		tflog.Info(g.ctx, fmt.Sprintf("Summary - Failed types: %v", failedTypes))
-		tflog.Info(g.ctx, fmt.Sprintf("Summary - Total successful: %d, Total failed: %d", len(successfulTypes), len(failedTypes)))
-		return nil
-	case err := <-errorChan:
-		tflog.Error(g.ctx, fmt.Sprintf("Error received from channel: %v", err))
-
-		// Give other goroutines a chance to clean up
-		go func() {
-			<-wgDone // Wait for all goroutines to finish
-			tflog.Trace(g.ctx, "All goroutines finished after error")
-		}()
+	tflog.Info(g.ctx, "Successfully retrieved all Genesys Cloud object instances")
+	tflog.Info(g.ctx, fmt.Sprintf("Summary - Successful types: %v", successfulTypes))
+	tflog.Info(g.ctx, fmt.Sprintf("Summary - Failed types: %v", failedTypes))
+	tflog.Info(g.ctx, fmt.Sprintf("Summary - Total successful: %d, Total failed: %d", len(successfulTypes), len(failedTypes)))
 
-		tflog.Error(g.ctx, fmt.Sprintf("Returning error retrieving cloud object instances: %v", err))
-		return err
-	}
+	// Labels depend on the state of the resources, so they can only be assigned once all of them are retrieved
+	return g.applyBlockLabels()
 }
 
 // buildResourceConfigMap Builds a map of all the Terraform resources data returned for each resource
@@ -683,6 +666,12 @@ func (g *GenesysCloudResourceExporter) buildResourceConfigMap() (diagnostics dia
 	// Get resources using thread-safe method
 	resources := g.getResources()
 
+	// The objects that flows reference by name are looked up in an index of the exported resources built once
+	var flowReferences *flowReferenceIndex
+	if g.addDependsOn {
+		flowReferences = g.buildFlowReferenceIndex(resources)
+	}
+
 	// Initialize channels for results and errors
 	type resourceResult struct {
 		resource     resourceExporter.ResourceInfo
@@ -757,6 +746,9 @@ func (g *GenesysCloudResourceExporter) buildResourceConfigMap() (diagnostics dia
 			if len(unresolvableAttrs) > 0 {
 				g.addUnresolvedAttrs(unresolvableAttrs)
 			}
+			if !result.isDataSource {
+				g.addSensitiveSecretAttrs(resource, configMap)
+			}
 
 			// 6. Handle custom write attributes (i.e. exporting files like prompts, flows, scripts, etc)
 			if !result.isDataSource {
@@ -766,6 +758,11 @@ func (g *GenesysCloudResourceExporter) buildResourceConfigMap() (diagnostics dia
 				}
 			}
 
+			// 6.5. Add depends_on for the objects referenced by name in exported flow files
+			if g.addDependsOn && !result.isDataSource && resource.Type == architectFlow.ResourceType {
+				g.addFlowReferencedObjectsDependsOn(resource, configMap, flowReferences)
+			}
+
 			// 6. Set resulting configMap and return on the results channel
 			result.configMap = configMap
 			select {
@@ -953,12 +950,12 @@ func (g *GenesysCloudResourceExporter) generateOutputFiles() (diags diag.Diagnos
 	}
 
 	if g.matchesExportFormat(formatHCL, formatJSONHCL) {
-		hclExporter := NewHClExporter(g.resourceTypesMaps, g.dataSourceTypesMaps, g.unresolvedAttrs, g.providerRegistry, g.version, g.exportDirPath, g.splitFilesByResource)
+		hclExporter := NewHClExporter(g.resourceTypesMaps, g.dataSourceTypesMaps, g.secretStore.variableAttrs(g.unresolvedAttrs), g.providerRegistry, g.version, g.exportDirPath, g.splitFilesByResource, g.secretStore == nil)
 		diags = append(diags, hclExporter.exportHCLConfig()...)
 	}
 
 	if g.matchesExportFormat(formatJSON, formatJSONHCL) {
-		jsonExporter := NewJsonExporter(g.resourceTypesMaps, g.dataSourceTypesMaps, g.unresolvedAttrs, g.providerRegistry, g.version, g.exportDirPath, g.splitFilesByResource)
+		jsonExporter := NewJsonExporter(g.resourceTypesMaps, g.dataSourceTypesMaps, g.secretStore.variableAttrs(g.unresolvedAttrs), g.providerRegistry, g.version, g.exportDirPath, g.splitFilesByResource, g.secretStore == nil)
 		diags = append(diags, jsonExporter.exportJSONConfig()...)
 	}
 
@@ -982,6 +979,36 @@ func (g *GenesysCloudResourceExporter) generateOutputFiles() (diags diag.Diagnos
 		}
 	}
 
+	diags = append(diags, g.writeSecretStoreFiles()...)
+	if diags.HasError() {
+		return diags
+	}
+
+	diags = append(diags, g.writeMovedBlocks()...)
+	if diags.HasError() {
+		return diags
+	}
+
+	diags = append(diags, g.writeDependencyGraph()...)
+	if diags.HasError() {
+		return diags
+	}
+
+	diags = append(diags, g.writeInventory()...)
+	if diags.HasError() {
+		return diags
+	}
+
+	diags = append(diags, g.writeExportMetrics()...)
+	if diags.HasError() {
+		return diags
+	}
+
+	diags = append(diags, g.writeLabelLockFile()...)
+	if diags.HasError() {
+		return diags
+	}
+
 	diags = append(diags, g.generateZipForExporter()...)
 	return diags
 }
@@ -1759,324 +1786,18 @@ func addLogAttrInfoToErrorSummary(err diag.Diagnostics) diag.Diagnostics {
 	return err
 }
 
+// getResourcesForType reads the state of the instances of one resource type
 func (g *GenesysCloudResourceExporter) getResourcesForType(resType string, schemaProvider *schema.Provider, exporter *resourceExporter.ResourceExporter, meta interface{}) ([]resourceExporter.ResourceInfo, diag.Diagnostics) {
 	tflog.Debug(g.ctx, fmt.Sprintf("Starting export for resource type: %s", resType))
 
-	// Use thread-safe method to get resource map size
-	lenResources := exporter.GetSanitizedResourceMapSize()
-	tflog.Info(g.ctx, fmt.Sprintf("Found %d resources for type %s", lenResources, resType))
-
-	if lenResources == 0 {
-		tflog.Debug(g.ctx, fmt.Sprintf("No resources found for type %s, returning empty slice", resType))
-		return []resourceExporter.ResourceInfo{}, nil
-	}
-
-	// Buffer channels to prevent goroutine leaks
-	errorsChan := make(chan ResourceErrorInfo, lenResources)
-	resourceChan := make(chan resourceExporter.ResourceInfo, lenResources)
-	tflog.Trace(g.ctx, fmt.Sprintf("Created buffered channels for %d resources", lenResources))
-
-	res := schemaProvider.ResourcesMap[resType]
-	if res == nil {
-		tflog.Error(g.ctx, fmt.Sprintf("Resource type %v not defined in schema provider", resType))
-		return nil, diag.Errorf("Resource type %v not defined", resType)
-	}
-	tflog.Trace(g.ctx, fmt.Sprintf("Successfully retrieved resource schema for type %s", resType))
-
-	exportComputed := g.exportComputed
-	tflog.Debug(g.ctx, fmt.Sprintf("Export computed setting: %t", exportComputed))
-
-	// Create a context with cancellation for this operation
-	ctx, cancel := context.WithCancel(g.ctx)
-	defer cancel()
-	tflog.Trace(g.ctx, fmt.Sprintf("Created context with cancellation for resource type %s", resType))
-
-	var wg sync.WaitGroup
-	wg.Add(lenResources)
-	tflog.Trace(g.ctx, fmt.Sprintf("Initialized WaitGroup with %d resources", lenResources))
-
-	// Track resources to remove to avoid race conditions
-	var toRemove []string
-	var toRemoveMutex sync.Mutex
-	tflog.Trace(g.ctx, fmt.Sprintf("Initialized resource removal tracking for type %s", resType))
-
-	// Get a copy of the resource map to avoid race conditions during iteration
-	resourceMap := exporter.GetSanitizedResourceMap()
-	tflog.Trace(g.ctx, fmt.Sprintf("Retrieved sanitized resource map with %d entries for type %s", len(resourceMap), resType))
-
-	// Create a semaphore to limit concurrent operations
-	// Use a default value if maxConcurrentOps is not set (0)
-	maxConcurrentOps := g.maxConcurrentOps
-	if maxConcurrentOps <= 0 {
-		maxConcurrentOps = 10 // Default to 10 concurrent operations
-	}
-	sem := make(chan struct{}, maxConcurrentOps)
-	tflog.Trace(g.ctx, fmt.Sprintf("Using semaphore to limit concurrent operations to %d", maxConcurrentOps))
-
-	for id, resMeta := range resourceMap {
-		tflog.Trace(g.ctx, fmt.Sprintf("Starting goroutine for resource ID: %s, BlockLabel: %s", id, resMeta.BlockLabel))
-
-		go func(id string, resMeta *resourceExporter.ResourceMeta) {
-			defer wg.Done()
-			tflog.Debug(g.ctx, fmt.Sprintf("Starting processing for resource ID: %s, BlockLabel: %s", id, resMeta.BlockLabel))
-
-			// Acquire semaphore slot or return if context is cancelled or timeout
-			select {
-			case sem <- struct{}{}:
-				defer func() { <-sem }() // Release semaphore when done
-			case <-ctx.Done():
-				tflog.Trace(g.ctx, fmt.Sprintf("Context cancelled for resource ID: %s", id))
-				return
-			}
-
-			tflog.Trace(g.ctx, fmt.Sprintf("Started processing for resource: %s.%s (%s)", resType, resMeta.BlockLabel, id))
-
-			fetchResourceState := func() error {
-				tflog.Trace(g.ctx, fmt.Sprintf("Starting fetchResourceState for resource ID: %s", id))
-
-				resourceCtx, resourceCancel := context.WithCancel(ctx)
-				defer resourceCancel()
-				tflog.Trace(g.ctx, fmt.Sprintf("Created resource context for ID: %s", id))
-
-				ctyType := res.CoreConfigSchema().ImpliedType()
-				tflog.Trace(g.ctx, fmt.Sprintf("Retrieved CTY type for resource ctyType: %v", ctyType))
-
-				tflog.Trace(g.ctx, fmt.Sprintf("Calling getResourceState for resource ID: %s", id))
-				instanceState, err := g.getResourceState(resourceCtx, res, id, resMeta, meta, resType)
-
-				if err != nil {
-					tflog.Error(g.ctx, fmt.Sprintf("Error while fetching read context type %s and instance %s : %v", resType, id, err))
-					return fmt.Errorf("Failed to get state for %s instance %s: %v", resType, id, err)
-				}
-
-				if instanceState == nil {
-					tflog.Warn(g.ctx, fmt.Sprintf("Resource %s no longer exists. Skipping.", resMeta.BlockLabel))
-					toRemoveMutex.Lock()
-					toRemove = append(toRemove, id)
-					toRemoveMutex.Unlock()
-					tflog.Debug(g.ctx, fmt.Sprintf("Added resource ID %s to removal list", id))
-					return nil
-				}
-				tflog.Info(g.ctx, fmt.Sprintf("Successfully retrieved instance state for resource ID: %s", id))
-
-				// Export the resource as a data resource
-				if exporter.ExportAsDataFunc != nil {
-					tflog.Trace(g.ctx, fmt.Sprintf("Checking if resource should be exported as data source for ID: %s", id))
-					sdkConfig := g.meta.(*provider.ProviderMeta).ClientConfig
-					exportAsData, err := exporter.ExportAsDataFunc(g.ctx, sdkConfig, instanceState.Attributes)
-					if err != nil {
-						tflog.Error(g.ctx, fmt.Sprintf("Error in ExportAsDataFunc for resource ID %s: %v", id, err))
-						return fmt.Errorf("an error has occurred while trying to export as a data resource block for %s::%s : %v", resType, resMeta.BlockLabel, err)
-					} else {
-						if exportAsData {
-							tflog.Debug(g.ctx, fmt.Sprintf("Resource ID %s will be exported as data source", id))
-							g.addReplaceWithDatasource(resType + "::" + resMeta.BlockLabel)
-						} else {
-							tflog.Debug(g.ctx, fmt.Sprintf("Resource ID %s will NOT be exported as data source", id))
-						}
-					}
-				}
-
-				blockType := ""
-
-				if g.isDataSource(resType, resMeta.BlockLabel, resMeta.OriginalLabel) {
-					attributes := make(map[string]string)
-					g.exMutex.Lock()
-					resData := schemaProvider.DataSourcesMap[resType]
-					g.exMutex.Unlock()
-
-					if resData == nil {
-						return fmt.Errorf("DataSource type %v not defined", resType)
-					}
-
-					ctyType = resData.CoreConfigSchema().ImpliedType()
-					for attr, _ := range resData.SchemaMap() {
-						key, val := exporter.DataResolver(instanceState, attr)
-						attributes[key] = val
-					}
-					instanceState.Attributes = attributes
-					blockType = "data"
-				}
-				tflog.Debug(g.ctx, fmt.Sprintf("Finished processing schema attributes for resource ID: %s", id))
-
-				tflog.Trace(g.ctx, fmt.Sprintf("Creating ResourceInfo for resource ID: %s", id))
-				select {
-				case resourceChan <- resourceExporter.ResourceInfo{
-					State:         instanceState,
-					BlockLabel:    resMeta.BlockLabel,
-					Type:          resType,
-					CtyType:       ctyType,
-					BlockType:     blockType,
-					OriginalLabel: resMeta.OriginalLabel,
-				}:
-					tflog.Trace(g.ctx, fmt.Sprintf("Successfully sent ResourceInfo to channel for resource ID: %s", id))
-				case <-ctx.Done():
-					tflog.Warn(g.ctx, fmt.Sprintf("Context canceled while sending ResourceInfo for resource ID: %s", id))
-					return fmt.Errorf("context cancelled")
-				}
-				tflog.Trace(g.ctx, fmt.Sprintf("Successfully sent ResourceInfo to channel for resource ID: %s", id))
-
-				return nil
-			}
-
-			// Improved retry logic with exponential backoff
-			// Allows retries up to three times before reporting error
-			maxRetries := 3
-			var lastErr error
-			tflog.Debug(g.ctx, fmt.Sprintf("Starting retry logic for resource ID: %s (max retries: %d)", id, maxRetries))
-
-			for attempt := 0; attempt < maxRetries; attempt++ {
-				tflog.Debug(g.ctx, fmt.Sprintf("Attempt %d/%d for resource ID: %s", attempt+1, maxRetries, id))
-
-				select {
-				case <-ctx.Done():
-					tflog.Warn(g.ctx, fmt.Sprintf("Context cancelled during retry attempt %d for resource ID: %s", attempt+1, id))
-					return
-				default:
-				}
-
-				err := fetchResourceState()
-
-				// Return immediately if no errors
-				if err == nil {
-					tflog.Info(g.ctx, fmt.Sprintf("Successfully processed resource ID: %s on attempt %d", id, attempt+1))
-					return // Success
-				}
-
-				lastErr = err
-
-				tflog.Error(g.ctx, fmt.Sprintf("Error on attempt %d for resource ID %s: %v", attempt+1, id, err))
-
-				if util.IsTimeoutError(err) {
-					tflog.Debug(g.ctx, fmt.Sprintf("Timeout error detected for resource ID %s on attempt %d", id, attempt+1))
-
-					// On timeout errors, try to add new client connections to the existing pool
-					if attempt == 0 {
-						tflog.Debug(g.ctx, fmt.Sprintf("Attempting to add new client connections to pool for resource ID: %s", id))
-
-						// Get the current version from provider meta
-						version := "1.0.0" // Default version
-						if providerMeta, ok := g.meta.(*provider.ProviderMeta); ok && providerMeta.Version != "" {
-							version = providerMeta.Version
-						}
-
-						// Call the pool's adjustment method if pool is initialized
-						if provider.SdkClientPool != nil {
-							provider.SdkClientPool.AdjustPoolForTimeout(version)
-						}
-
-						// Add a small delay to allow the new clients to be available
-						tflog.Debug(g.ctx, fmt.Sprintf("Waiting 5 seconds for new clients to be available for resource ID: %s", id))
-						select {
-						case <-time.After(5 * time.Second):
-							tflog.Debug(g.ctx, fmt.Sprintf("Pool adjustment delay completed for resource ID: %s", id))
-						case <-ctx.Done():
-							tflog.Warn(g.ctx, fmt.Sprintf("[getResourcesForType] Context cancelled during pool adjustment delay for resource ID: %s", id))
-							return
-						}
-					}
-					// Exponential backoff for retryable errors
-					if attempt < maxRetries-1 {
-						backoffDuration := time.Duration(1<<attempt) * time.Second
-						tflog.Info(g.ctx, fmt.Sprintf("Retrying resource %s (attempt %d/%d) after %v backoff", id, attempt+1, maxRetries, backoffDuration))
-
-						select {
-						case <-time.After(backoffDuration):
-							tflog.Debug(g.ctx, fmt.Sprintf("Backoff completed for resource ID: %s", id))
-						case <-ctx.Done():
-							tflog.Warn(g.ctx, fmt.Sprintf("Context cancelled during backoff for resource ID: %s", id))
-							return
-						}
-					}
-				} else {
-					tflog.Error(g.ctx, fmt.Sprintf("Non-retryable error for resource ID %s, sending to error channel", id))
-					// Non-retryable error, send to error channel
-					select {
-					case errorsChan <- ResourceErrorInfo{
-						ResourceType:  resType,
-						ResourceID:    id,
-						ResourceLabel: resMeta.BlockLabel,
-						ErrorMessage:  fmt.Sprintf("Non-retryable error: %v", err),
-						IsTimeout:     false,
-					}:
-						tflog.Trace(g.ctx, fmt.Sprintf("Successfully sent error to channel for resource ID: %s", id))
-					case <-ctx.Done():
-						tflog.Warn(g.ctx, fmt.Sprintf("Context cancelled while sending error for resource ID: %s", id))
-						return
-					}
-					return
-				}
-			}
-
-			// If we get here, all retries failed
-			if lastErr != nil {
-				tflog.Trace(g.ctx, fmt.Sprintf("All retries failed for resource ID %s, sending final error to channel", id))
-
-				select {
-				case errorsChan <- ResourceErrorInfo{
-					ResourceType:  resType,
-					ResourceID:    id,
-					ResourceLabel: resMeta.BlockLabel,
-					ErrorMessage:  fmt.Sprintf("Failed after %d retries: %v", maxRetries, lastErr),
-					IsTimeout:     util.IsTimeoutError(lastErr),
-				}:
-					tflog.Trace(g.ctx, fmt.Sprintf("Successfully sent final error to channel for resource ID: %s", id))
-				case <-ctx.Done():
-					tflog.Warn(g.ctx, fmt.Sprintf("Context cancelled while sending final error for resource ID: %s", id))
-					return
-				}
-
-			}
-
-		}(id, resMeta)
-	}
-
-	tflog.Trace(g.ctx, fmt.Sprintf("Started all goroutines for resource type %s, waiting for completion", resType))
-
-	// Wait for all goroutines to complete with timeout
-	wg.Wait()
-	tflog.Trace(g.ctx, fmt.Sprintf("All goroutines completed for resource type %s", resType))
-
-	close(resourceChan)
-	close(errorsChan)
-	tflog.Trace(g.ctx, fmt.Sprintf("Closed resource and error channels for resource type %s", resType))
-
-	// Collect all resources
-	var resources []resourceExporter.ResourceInfo
-	tflog.Trace(g.ctx, fmt.Sprintf("Collecting resources from channel for resource type %s", resType))
-	for r := range resourceChan {
-		tflog.Trace(g.ctx, fmt.Sprintf("Collected resource: Type=%s, BlockLabel=%s, ID=%s", r.Type, r.BlockLabel, r.State.ID))
-		resources = append(resources, r)
-	}
-	tflog.Trace(g.ctx, fmt.Sprintf("Collected %d resources from channel for resource type %s", len(resources), resType))
-
-	// Remove resources that weren't found using thread-safe method
-	tflog.Debug(g.ctx, fmt.Sprintf("Processing %d resources for removal", len(toRemove)))
-	for _, id := range toRemove {
-		tflog.Debug(g.ctx, fmt.Sprintf("Removing resource %v from export map", id))
-		exporter.RemoveFromSanitizedResourceMap(id)
-	}
-
-	// Track resources that errored
-	tflog.Trace(g.ctx, fmt.Sprintf("Collecting errors from channel for resource type %s", resType))
-	var erroredResources []ResourceErrorInfo
-	for erroredResource := range errorsChan {
-		tflog.Debug(g.ctx, fmt.Sprintf("Collected error: %v", erroredResource))
-		erroredResources = append(erroredResources, erroredResource)
+	resourcesByType, err := g.readResourceInstances(map[string]*resourceExporter.ResourceExporter{resType: exporter}, schemaProvider, meta)
+	if err != nil {
+		return nil, err
 	}
-
-	tflog.Warn(g.ctx, fmt.Sprintf("Collected %d errors for resource type %s", len(erroredResources), resType))
-	// Store errored resources in the exporter for later reporting
-	if len(erroredResources) > 0 {
-		g.resourceErrorsMutex.Lock()
-		g.resourceErrors[resType] = erroredResources
-		g.resourceErrorsMutex.Unlock()
-		tflog.Warn(g.ctx, fmt.Sprintf("Export completed for %s with %d errors out of %d resources", resType, len(erroredResources), lenResources))
-	} else {
-		tflog.Info(g.ctx, fmt.Sprintf("Export completed successfully for %s: %d resources successfully exported", resType, len(resources)))
+	if resourcesByType[resType] == nil {
+		return []resourceExporter.ResourceInfo{}, nil
 	}
-
-	return resources, nil
+	return resourcesByType[resType], nil
 }
 
 // collectSchemaBasedExcludedAttributes handles determining if any attributes should be excluded based on schema characteristics (i.e. computed, deprecated, etc)
@@ -2366,22 +2087,14 @@ func (g *GenesysCloudResourceExporter) sanitizeConfigMap(
 
 		if attr, ok := attrInUnResolvableAttrs(attributeConfigKey, exporter.UnResolvableAttributes); ok {
 			if resourceBlockType != "data" {
-				varReference := fmt.Sprintf("%s_%s_%s", resourceType, resourceLabel, attributeConfigKey)
-				unresolvableAttrs = append(unresolvableAttrs, unresolvableAttributeInfo{
+				unresolvableAttr := unresolvableAttributeInfo{
 					ResourceType:  resourceType,
 					ResourceLabel: resourceLabel,
 					Name:          attributeConfigKey,
 					Schema:        attr,
-				})
-				if properties, ok := attr.Elem.(*schema.Resource); ok {
-					propertiesMap := make(map[string]interface{})
-					for k := range properties.Schema {
-						propertiesMap[k] = fmt.Sprintf("${var.%s.%s}", varReference, k)
-					}
-					configMap[attributeConfigKey] = propertiesMap
-				} else {
-					configMap[attributeConfigKey] = fmt.Sprintf("${var.%s}", varReference)
 				}
+				unresolvableAttrs = append(unresolvableAttrs, unresolvableAttr)
+				configMap[attributeConfigKey] = g.secretStore.secretAttributeValue(unresolvableAttr)
 			}
 		}
 
@@ -2575,6 +2288,78 @@ func (g *GenesysCloudResourceExporter) addDependsOnValues(key string, configMap
 	}
 }
 
+// flowReferenceIndex indexes the exported resources by type and name, for the objects flows reference by name. Names
+// that more than one exported resource of a type share are ambiguous and left out.
+type flowReferenceIndex struct {
+	resources map[architectFlow.ReferencedObject]resourceExporter.ResourceInfo
+	ambiguous map[architectFlow.ReferencedObject]bool
+}
+
+// buildFlowReferenceIndex indexes the exported resources by type and name
+func (g *GenesysCloudResourceExporter) buildFlowReferenceIndex(resources []resourceExporter.ResourceInfo) *flowReferenceIndex {
+	index := &flowReferenceIndex{
+		resources: make(map[architectFlow.ReferencedObject]resourceExporter.ResourceInfo, len(resources)),
+		ambiguous: make(map[architectFlow.ReferencedObject]bool),
+	}
+	for _, resource := range resources {
+		if resource.State == nil || resource.State.Attributes["name"] == "" {
+			continue
+		}
+		key := architectFlow.ReferencedObject{Type: resource.Type, Name: resource.State.Attributes["name"]}
+		if index.ambiguous[key] {
+			continue
+		}
+		if _, exists := index.resources[key]; exists {
+			tflog.Warn(g.ctx, fmt.Sprintf("[buildFlowReferenceIndex] More than one exported %s is named %q, so flows that reference it by name get no depends_on for it", key.Type, key.Name))
+			delete(index.resources, key)
+			index.ambiguous[key] = true
+			continue
+		}
+		index.resources[key] = resource
+	}
+	return index
+}
+
+// addFlowReferencedObjectsDependsOn adds depends_on entries for the objects a flow references by name in its exported
+// YAML file. The references are matched by type and name against the index of the exported resources, so no API call is
+// needed.
+func (g *GenesysCloudResourceExporter) addFlowReferencedObjectsDependsOn(resource resourceExporter.ResourceInfo, configMap util.JsonMap, index *flowReferenceIndex) {
+	if index == nil {
+		return
+	}
+	referencedObjects := architectFlow.GetReferencedObjectsFromStateAttributes(resource.State.Attributes)
+	if len(referencedObjects) == 0 {
+		return
+	}
+
+	dependsOn, _ := configMap["depends_on"].([]string)
+	for _, object := range referencedObjects {
+		if index.ambiguous[object] {
+			tflog.Debug(g.ctx, fmt.Sprintf("[addFlowReferencedObjectsDependsOn] Flow %s references %s %q whose name is ambiguous", resource.State.ID, object.Type, object.Name))
+			continue
+		}
+		dependency, found := index.resources[object]
+		if !found || dependency.State.ID == resource.State.ID {
+			tflog.Debug(g.ctx, fmt.Sprintf("[addFlowReferencedObjectsDependsOn] Flow %s references %s %q which is not part of the export", resource.State.ID, object.Type, object.Name))
+			continue
+		}
+
+		resourceName := fmt.Sprintf("%s.%s", dependency.Type, dependency.BlockLabel)
+		if g.isDataSource(dependency.Type, dependency.BlockLabel, dependency.OriginalLabel) {
+			resourceName = "data." + resourceName
+		}
+		dependsOnString := fmt.Sprintf("$dep$%s$dep$", resourceName)
+		if !lists.ItemInSlice(dependsOnString, dependsOn) {
+			dependsOn = append(dependsOn, dependsOnString)
+		}
+	}
+
+	if len(dependsOn) > 0 {
+		configMap["depends_on"] = dependsOn
+		tflog.Debug(g.ctx, fmt.Sprintf("[addFlowReferencedObjectsDependsOn] Flow %s depends_on: %v", resource.State.ID, dependsOn))
+	}
+}
+
 func escapeString(strValue string) string {
 	// Check for any '${' or '%{' in the exported string and escape them
 	// https://www.terraform.io/docs/language/expressions/strings.html#escape-sequences
@@ -2908,8 +2693,8 @@ func (g *GenesysCloudResourceExporter) verifyTerraformState() diag.Diagnostics {
 }
 
 func (g *GenesysCloudResourceExporter) matchesExportFormat(formats ...string) bool {
-	// Normalize format first
-	exportFormat := g.exportFormat
+	// Normalize format first. The inventory database is written alongside the configuration.
+	exportFormat := strings.TrimSuffix(g.exportFormat, formatSQLiteSuffix)
 	if exportFormat == formatHCLJSON {
 		exportFormat = formatJSONHCL
 	}
@@ -2982,6 +2767,12 @@ func (g *GenesysCloudResourceExporter) getResources() []resourceExporter.Resourc
 	return g.resources
 }
 
+func (g *GenesysCloudResourceExporter) setResources(resources []resourceExporter.ResourceInfo) {
+	g.resourcesMutex.Lock()
+	defer g.resourcesMutex.Unlock()
+	g.resources = resources
+}
+
 func (g *GenesysCloudResourceExporter) getUnresolvedAttrs() []unresolvableAttributeInfo {
 	g.unresolvedAttrsMutex.Lock()
 	defer g.unresolvedAttrsMutex.Unlock()
@@ -20,6 +20,7 @@ import (
 
 	"testing"
 
+	architectFlow "github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/architect_flow"
 	dependentconsumers "github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/dependent_consumers"
 )
 
@@ -930,6 +931,18 @@ func TestUnitMatchesFormat(t *testing.T) {
 			formats:      []string{formatJSONHCL},
 			expected:     true,
 		},
+		{
+			name:         "Inventory suffix is ignored",
+			exportFormat: "hcl_json_sqlite",
+			formats:      []string{formatJSONHCL},
+			expected:     true,
+		},
+		{
+			name:         "Inventory suffix does not match other formats",
+			exportFormat: "hcl_sqlite",
+			formats:      []string{formatJSON, formatJSONHCL},
+			expected:     false,
+		},
 		{
 			name:         "Mix of exact and regex patterns",
 			exportFormat: "json_hcl",
@@ -2212,3 +2225,39 @@ func TestUnitGenesysCloudResourceExporter_buildResourceConfigMap_InstanceStateEr
 	assert.Len(t, resourceMaps, 0)
 	assert.Len(t, dataSourceMaps, 0)
 }
+
+// TestUnitAddFlowReferencedObjectsDependsOn asserts that the objects a flow references by name resolve to depends_on
+// entries through an index built once per export, and that names shared by several exported resources are skipped
+func TestUnitAddFlowReferencedObjectsDependsOn(t *testing.T) {
+	resource := func(resourceType, id, label string, attributes map[string]string) resourceExporter.ResourceInfo {
+		return resourceExporter.ResourceInfo{
+			State:      &terraform.InstanceState{ID: id, Attributes: attributes},
+			Type:       resourceType,
+			BlockLabel: label,
+		}
+	}
+	flow := resource(architectFlow.ResourceType, "flow-1", "inbound", map[string]string{
+		"name":                      "Inbound",
+		"referenced_objects.#":      "3",
+		"referenced_objects.0.type": "genesyscloud_routing_queue",
+		"referenced_objects.0.name": "Support",
+		"referenced_objects.1.type": "genesyscloud_routing_skill",
+		"referenced_objects.1.name": "Billing",
+		"referenced_objects.2.type": architectFlow.ResourceType,
+		"referenced_objects.2.name": "Inbound",
+	})
+	resources := []resourceExporter.ResourceInfo{
+		flow,
+		resource("genesyscloud_routing_queue", "queue-1", "support", map[string]string{"name": "Support"}),
+		resource("genesyscloud_routing_skill", "skill-1", "billing", map[string]string{"name": "Billing"}),
+		resource("genesyscloud_routing_skill", "skill-2", "billing_2", map[string]string{"name": "Billing"}),
+	}
+
+	g := &GenesysCloudResourceExporter{ctx: context.Background()}
+	index := g.buildFlowReferenceIndex(resources)
+	assert.True(t, index.ambiguous[architectFlow.ReferencedObject{Type: "genesyscloud_routing_skill", Name: "Billing"}])
+
+	configMap := util.JsonMap{"depends_on": []string{"$dep$genesyscloud_script.default$dep$"}}
+	g.addFlowReferencedObjectsDependsOn(flow, configMap, index)
+	assert.Equal(t, []string{"$dep$genesyscloud_script.default$dep$", "$dep$genesyscloud_routing_queue.support$dep$"}, configMap["depends_on"])
+}
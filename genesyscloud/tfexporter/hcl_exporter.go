@@ -8,9 +8,11 @@ import (
 	"sort"
 	"strings"
 
+	"github.com/hashicorp/hcl/v2/hclsyntax"
 	"github.com/hashicorp/hcl/v2/hclwrite"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	zclconfCty "github.com/zclconf/go-cty/cty"
+	zclconfCtyJson "github.com/zclconf/go-cty/cty/json"
 )
 
 /*
@@ -27,9 +29,10 @@ type HCLExporter struct {
 	version               string
 	dirPath               string
 	splitFilesByResource  bool
+	writeTfVars           bool
 }
 
-func NewHClExporter(resourceTypesJSONMaps map[string]ResourceJSONMaps, dataSourceTypesMaps map[string]ResourceJSONMaps, unresolvedAttrs []unresolvableAttributeInfo, providerRegistry string, version string, dirPath string, splitFilesByResource bool) *HCLExporter {
+func NewHClExporter(resourceTypesJSONMaps map[string]ResourceJSONMaps, dataSourceTypesMaps map[string]ResourceJSONMaps, unresolvedAttrs []unresolvableAttributeInfo, providerRegistry string, version string, dirPath string, splitFilesByResource bool, writeTfVars bool) *HCLExporter {
 	hclExporter := &HCLExporter{
 		resourceTypesJSONMaps: resourceTypesJSONMaps,
 		dataSourceTypesMaps:   dataSourceTypesMaps,
@@ -38,6 +41,7 @@ func NewHClExporter(resourceTypesJSONMaps map[string]ResourceJSONMaps, dataSourc
 		version:               version,
 		dirPath:               dirPath,
 		splitFilesByResource:  splitFilesByResource,
+		writeTfVars:           writeTfVars,
 	}
 	return hclExporter
 }
@@ -45,40 +49,7 @@ func NewHClExporter(resourceTypesJSONMaps map[string]ResourceJSONMaps, dataSourc
 func (h *HCLExporter) exportHCLConfig() diag.Diagnostics {
 	providerBlock := createHCLProviderBlock(h.providerRegistry, h.version)
 	variablesBlock := createHCLVariablesBlock(h.unresolvedAttrs)
-
-	hclBlocks := make(map[string][][]byte, 0)
-
-	// Data resources
-	for resDataType, dataJSONMap := range h.dataSourceTypesMaps {
-
-		// Output the data resources in a sorted fashion
-		blockLabels := make([]string, 0)
-		for resDataLabel, _ := range dataJSONMap {
-			blockLabels = append(blockLabels, resDataLabel)
-		}
-		sort.Strings(blockLabels)
-		for _, blockLabel := range blockLabels {
-			resDataJson := dataJSONMap[blockLabel]
-			hclBlock := instanceStateToHCLBlock(resDataType, blockLabel, resDataJson, true)
-			hclBlocks[resDataType] = append(hclBlocks[resDataType], hclBlock)
-		}
-	}
-
-	// Resources
-	for resType, resJSONMap := range h.resourceTypesJSONMaps {
-
-		// Output the resources in a sorted fashion
-		blockLabels := make([]string, 0)
-		for resLabel, _ := range resJSONMap {
-			blockLabels = append(blockLabels, resLabel)
-		}
-		sort.Strings(blockLabels)
-		for _, resLabel := range blockLabels {
-			resJson := resJSONMap[resLabel]
-			hclBlock := instanceStateToHCLBlock(resType, resLabel, resJson, false)
-			hclBlocks[resType] = append(hclBlocks[resType], hclBlock)
-		}
-	}
+	hclBlocks := h.buildHCLBlocks()
 
 	if h.splitFilesByResource {
 		// Provider file
@@ -100,14 +71,8 @@ func (h *HCLExporter) exportHCLConfig() diag.Diagnostics {
 		}
 
 		// Resources files
-		for resType, hclContent := range hclBlocks {
-			resourceHCLFilePath := filepath.Join(h.dirPath, fmt.Sprintf("%s.%s", resType, resourceHCLFileExt))
-			if resourceHCLFilePath == "" {
-				return diag.Errorf("Failed to create file path %s", resourceHCLFilePath)
-			}
-			if diagErr := writeHCLToFile(hclContent, resourceHCLFilePath); diagErr != nil {
-				return diagErr
-			}
+		if diagErr := h.writeHCLResourceFiles(hclBlocks); diagErr != nil {
+			return diagErr
 		}
 
 	} else {
@@ -137,8 +102,8 @@ func (h *HCLExporter) exportHCLConfig() diag.Diagnostics {
 		}
 	}
 
-	// Optional tfvars file creation for unresolved attributes
-	if len(h.unresolvedAttrs) > 0 {
+	// Optional tfvars file creation for unresolved attributes, unless they are read from a secret store
+	if h.writeTfVars && len(h.unresolvedAttrs) > 0 {
 		tfVars := make(map[string]interface{})
 		keys := make(map[string]string)
 		for _, attr := range h.unresolvedAttrs {
@@ -163,6 +128,62 @@ func (h *HCLExporter) exportHCLConfig() diag.Diagnostics {
 	return nil
 }
 
+// exportHCLResourceFiles writes one file per resource type, without the provider, variables and tfvars files
+func (h *HCLExporter) exportHCLResourceFiles() diag.Diagnostics {
+	return h.writeHCLResourceFiles(h.buildHCLBlocks())
+}
+
+// buildHCLBlocks returns the HCL blocks of the data sources and resources of each type, sorted by label
+func (h *HCLExporter) buildHCLBlocks() map[string][][]byte {
+	hclBlocks := make(map[string][][]byte, 0)
+
+	// Data resources
+	for resDataType, dataJSONMap := range h.dataSourceTypesMaps {
+
+		// Output the data resources in a sorted fashion
+		blockLabels := make([]string, 0)
+		for resDataLabel, _ := range dataJSONMap {
+			blockLabels = append(blockLabels, resDataLabel)
+		}
+		sort.Strings(blockLabels)
+		for _, blockLabel := range blockLabels {
+			resDataJson := dataJSONMap[blockLabel]
+			hclBlock := instanceStateToHCLBlock(resDataType, blockLabel, resDataJson, true)
+			hclBlocks[resDataType] = append(hclBlocks[resDataType], hclBlock)
+		}
+	}
+
+	// Resources
+	for resType, resJSONMap := range h.resourceTypesJSONMaps {
+
+		// Output the resources in a sorted fashion
+		blockLabels := make([]string, 0)
+		for resLabel, _ := range resJSONMap {
+			blockLabels = append(blockLabels, resLabel)
+		}
+		sort.Strings(blockLabels)
+		for _, resLabel := range blockLabels {
+			resJson := resJSONMap[resLabel]
+			hclBlock := instanceStateToHCLBlock(resType, resLabel, resJson, false)
+			hclBlocks[resType] = append(hclBlocks[resType], hclBlock)
+		}
+	}
+	return hclBlocks
+}
+
+func (h *HCLExporter) writeHCLResourceFiles(hclBlocks map[string][][]byte) diag.Diagnostics {
+	for resType, hclContent := range hclBlocks {
+		resourceHCLFilePath := filepath.Join(h.dirPath, fmt.Sprintf("%s.%s", resType, resourceHCLFileExt))
+		if resourceHCLFilePath == "" {
+			return diag.Errorf("Failed to create file path %s", resourceHCLFilePath)
+		}
+		if diagErr := writeHCLToFile(hclContent, resourceHCLFilePath); diagErr != nil {
+			return diagErr
+		}
+	}
+	return nil
+}
+
 // Create the  HCL block for terraform and the genesyscloud provider
 func createHCLProviderBlock(providerRegistry string, version string) []byte {
 	rootFile := hclwrite.NewEmptyFile()
@@ -276,6 +297,8 @@ func addBody(body *hclwrite.Body, json util.JsonMap) {
 func addValue(body *hclwrite.Body, k string, v interface{}) {
 	if vInter, ok := v.([]interface{}); ok {
 		handleInterfaceArray(body, k, vInter)
+	} else if tokens := stringValueTokens(v); tokens != nil {
+		body.SetAttributeRaw(k, tokens)
 	} else {
 		ctyVal := getCtyValue(v)
 		if ctyVal != zclconfCty.NilVal {
@@ -284,6 +307,85 @@ func addValue(body *hclwrite.Body, k string, v interface{}) {
 	}
 }
 
+// stringValueTokens renders a string attribute holding a JSON object or array as a jsonencode call, and a multi-line
+// string attribute, e.g. YAML or indented JSON, as a heredoc. Either expression must evaluate to the exact string read
+// from the API, so that the export plans no changes. JSON that jsonencode would reformat is therefore written as a
+// heredoc when it spans several lines, and YAML is never written as a yamlencode call, which normalizes its formatting.
+// It returns nil for any other value, which is written as a quoted string.
+func stringValueTokens(v interface{}) hclwrite.Tokens {
+	vStr, ok := v.(string)
+	if !ok {
+		return nil
+	}
+	if tokens := jsonEncodeTokens(vStr); tokens != nil {
+		return tokens
+	}
+	return heredocTokens(vStr)
+}
+
+// jsonEncodeTokens returns a jsonencode call for a non-empty JSON object or array, provided that jsonencode returns
+// the string unchanged, e.g. the string has no whitespace and its keys are sorted
+func jsonEncodeTokens(v string) hclwrite.Tokens {
+	if len(v) < 3 || (v[0] != '{' && v[0] != '[') {
+		return nil
+	}
+	ty, err := zclconfCtyJson.ImpliedType([]byte(v))
+	if err != nil {
+		return nil
+	}
+	val, err := zclconfCtyJson.Unmarshal([]byte(v), ty)
+	if err != nil {
+		return nil
+	}
+	encoded, err := zclconfCtyJson.Marshal(val, val.Type())
+	if err != nil || string(encoded) != v {
+		return nil
+	}
+	return hclwrite.TokensForFunctionCall("jsonencode", hclwrite.TokensForValue(val))
+}
+
+// heredocTokens returns a heredoc for a multi-line string. A heredoc always ends with a newline, so strings without a
+// trailing newline are wrapped in a chomp call. The string was already escaped by escapeString, and its content is
+// written as it is, so literal template sequences stay escaped once. Escaped interpolations get one more "$", as
+// instanceStateToHCLBlock turns every "$${" of the block back into "${" to keep the references resolved by the
+// exporter live.
+func heredocTokens(v string) hclwrite.Tokens {
+	if !strings.Contains(v, "\n") || strings.Contains(v, "\r") {
+		return nil
+	}
+	marker := heredocMarker(v)
+	content := strings.ReplaceAll(v, "$${", "$$${")
+	chomp := !strings.HasSuffix(content, "\n")
+	if chomp {
+		content += "\n"
+	}
+
+	tokens := hclwrite.Tokens{
+		{Type: hclsyntax.TokenOHeredoc, Bytes: []byte("<<" + marker + "\n")},
+		{Type: hclsyntax.TokenStringLit, Bytes: []byte(content)},
+		{Type: hclsyntax.TokenCHeredoc, Bytes: []byte(marker)},
+	}
+	if !chomp {
+		return tokens
+	}
+	// The closing marker of a heredoc must be alone on its line
+	tokens = append(tokens, &hclwrite.Token{Type: hclsyntax.TokenNewline, Bytes: []byte("\n")})
+	return hclwrite.TokensForFunctionCall("chomp", tokens)
+}
+
+// heredocMarker returns a heredoc marker that does not appear as a line of the string
+func heredocMarker(v string) string {
+	lines := make(map[string]bool)
+	for _, line := range strings.Split(v, "\n") {
+		lines[strings.TrimSpace(line)] = true
+	}
+	marker := "EOT"
+	for i := 1; lines[marker]; i++ {
+		marker = fmt.Sprintf("EOT%d", i)
+	}
+	return marker
+}
+
 func getCtyValue(v interface{}) zclconfCty.Value {
 	var value zclconfCty.Value
 	if vStr, ok := v.(string); ok {
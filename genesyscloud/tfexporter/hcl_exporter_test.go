@@ -0,0 +1,88 @@
+package tfexporter
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/util"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	zclconfCty "github.com/zclconf/go-cty/cty"
+	"github.com/zclconf/go-cty/cty/function"
+	"github.com/zclconf/go-cty/cty/function/stdlib"
+)
+
+// evaluateHCLBlockAttributes parses an exported block and evaluates its attributes with the functions used by the exporter
+func evaluateHCLBlockAttributes(t *testing.T, content []byte) map[string]zclconfCty.Value {
+	file, diags := hclsyntax.ParseConfig(content, "test.tf", hcl.InitialPos)
+	require.False(t, diags.HasErrors(), diags.Error())
+	blocks := file.Body.(*hclsyntax.Body).Blocks
+	require.Len(t, blocks, 1)
+
+	evalCtx := &hcl.EvalContext{Functions: map[string]function.Function{
+		"chomp":      stdlib.ChompFunc,
+		"jsonencode": stdlib.JSONEncodeFunc,
+	}}
+	values := make(map[string]zclconfCty.Value)
+	for name, attr := range blocks[0].Body.Attributes {
+		value, diags := attr.Expr.Value(evalCtx)
+		require.False(t, diags.HasErrors(), diags.Error())
+		values[name] = value
+	}
+	return values
+}
+
+// TestUnitInstanceStateToHCLBlockStringValues asserts that JSON and multi-line strings are written as jsonencode calls
+// and heredocs that evaluate to the exact exported string, and that other strings stay quoted
+func TestUnitInstanceStateToHCLBlockStringValues(t *testing.T) {
+	values := util.JsonMap{
+		"name":            "Queue",
+		"compact_json":    `{"inputs":[{"name":"a","required":true}],"title":"Contract"}`,
+		"unsorted_json":   `{"title":"Contract","inputs":[]}`,
+		"empty_json":      "{}",
+		"indented_json":   "{\n  \"title\": \"Contract\"\n}",
+		"yaml":            "rules:\n  - name: first\n    weight: 1\n",
+		"multi_line":      "first line\nEOT\n\n%{ not a directive }",
+		"template_script": "echo ${HOME}\nprintf '%{x}'\n",
+		"crlf":            "first line\r\nsecond line",
+	}
+
+	// The exporter escapes the strings read from the API, and resolves references to unescaped interpolations
+	exported := util.JsonMap{
+		"queue_reference":  "${genesyscloud_routing_queue.support.id}",
+		"script_reference": "{\"queueId\":\"${genesyscloud_routing_queue.support.id}\"}",
+	}
+	for name, value := range values {
+		exported[name] = escapeString(value.(string))
+	}
+
+	content := instanceStateToHCLBlock("genesyscloud_integration_action", "action", exported, false)
+
+	// Attributes are aligned on their equals signs, so any number of spaces may precede them
+	expected := map[string]string{
+		"compact_json":     "jsonencode({\n    inputs = [{\n",
+		"unsorted_json":    `"{\"title\":\"Contract\",\"inputs\":[]}"`,
+		"empty_json":       `"{}"`,
+		"indented_json":    "chomp(<<EOT\n{\n  \"title\": \"Contract\"\n}\nEOT\n  )",
+		"yaml":             "<<EOT\nrules:\n  - name: first\n    weight: 1\nEOT\n",
+		"multi_line":       "chomp(<<EOT1\nfirst line\nEOT\n\n%%{ not a directive }\nEOT1\n  )",
+		"template_script":  "<<EOT\necho $${HOME}\nprintf '%%{x}'\nEOT\n",
+		"crlf":             `"first line\r\nsecond line"`,
+		"queue_reference":  `"${genesyscloud_routing_queue.support.id}"`,
+		"script_reference": "jsonencode({\n    queueId = \"${genesyscloud_routing_queue.support.id}\"\n  })",
+	}
+	for name, expression := range expected {
+		assert.Regexp(t, regexp.MustCompile(`(?m)^  `+name+` +=  ?`+regexp.QuoteMeta(expression)), string(content))
+	}
+
+	// References cannot be evaluated, so only the attributes holding literal strings are compared
+	delete(exported, "queue_reference")
+	delete(exported, "script_reference")
+	content = instanceStateToHCLBlock("genesyscloud_integration_action", "action", exported, false)
+	for name, value := range evaluateHCLBlockAttributes(t, content) {
+		assert.Equal(t, values[name], value.AsString(), "attribute %s must evaluate to the exported string", name)
+	}
+}
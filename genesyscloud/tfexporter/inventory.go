@@ -0,0 +1,257 @@
+package tfexporter
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/util"
+	"github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/util/sqlite"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+)
+
+/*
+The inventory.go file writes the inventory database of an export: a SQLite file that can be queried and audited
+without Terraform. It is written when export_format ends with _sqlite, next to the HCL or JSON configuration.
+
+Each exported resource type has a table with one row per resource and data source of that type. The columns starting
+with an underscore hold the ID, address and labels of the row, and the other columns hold the flattened attributes of
+the exported configuration: nested blocks are flattened to dotted column names, and lists are stored as JSON text. The
+edges table holds the references between the exported resources, as found for the dependency graph.
+*/
+
+const (
+	formatSQLiteSuffix = "_sqlite"
+
+	inventoryFileName   = "inventory.sqlite"
+	inventoryEdgesTable = "edges"
+
+	inventoryModeManaged = "managed"
+	inventoryModeData    = "data"
+)
+
+var inventoryMetadataColumns = []sqlite.Column{
+	{Name: "_id", Type: "TEXT"},
+	{Name: "_address", Type: "TEXT"},
+	{Name: "_mode", Type: "TEXT"},
+	{Name: "_block_label", Type: "TEXT"},
+	{Name: "_original_label", Type: "TEXT"},
+}
+
+var inventoryEdgeColumns = []sqlite.Column{
+	{Name: "from_address", Type: "TEXT"},
+	{Name: "from_type", Type: "TEXT"},
+	{Name: "from_id", Type: "TEXT"},
+	{Name: "to_address", Type: "TEXT"},
+	{Name: "to_type", Type: "TEXT"},
+	{Name: "to_id", Type: "TEXT"},
+	{Name: "attributes", Type: "TEXT"},
+	{Name: "in_cycle", Type: "INTEGER"},
+}
+
+// writesInventory returns true if the export format asks for the inventory database
+func (g *GenesysCloudResourceExporter) writesInventory() bool {
+	return strings.HasSuffix(g.exportFormat, formatSQLiteSuffix)
+}
+
+// buildInventory builds the inventory database of the exported resources. The rows follow the nodes of the dependency
+// graph, and originalLabels holds the label of each resource by address before it was sanitized or relabelled.
+func buildInventory(graph *dependencyGraph, resourceTypesMaps, dataSourceTypesMaps map[string]ResourceJSONMaps, decodedAttributes map[string]string, originalLabels map[string]string) (*sqlite.Database, error) {
+	type inventoryRow struct {
+		node       *dependencyGraphNode
+		attributes map[string]interface{}
+	}
+
+	rowsByType := make(map[string][]inventoryRow)
+	columnsByType := make(map[string]map[string]bool)
+	for _, node := range graph.Nodes {
+		typeMaps := resourceTypesMaps
+		if node.IsData {
+			typeMaps = dataSourceTypesMaps
+		}
+
+		attributes := make(map[string]interface{})
+		flattenInventoryAttributes(map[string]interface{}(typeMaps[node.Type][node.Label]), "", decodedAttributes, attributes)
+		rowsByType[node.Type] = append(rowsByType[node.Type], inventoryRow{node: node, attributes: attributes})
+
+		if columnsByType[node.Type] == nil {
+			columnsByType[node.Type] = make(map[string]bool)
+		}
+		for column := range attributes {
+			columnsByType[node.Type][column] = true
+		}
+	}
+
+	resourceTypes := make([]string, 0, len(rowsByType))
+	for resourceType := range rowsByType {
+		resourceTypes = append(resourceTypes, resourceType)
+	}
+	sort.Strings(resourceTypes)
+
+	db := sqlite.New()
+	for _, resourceType := range resourceTypes {
+		attributeColumns := make([]string, 0, len(columnsByType[resourceType]))
+		for column := range columnsByType[resourceType] {
+			attributeColumns = append(attributeColumns, column)
+		}
+		sort.Strings(attributeColumns)
+
+		// Attribute columns have no type, so that every value keeps the type it was exported with
+		columns := append([]sqlite.Column{}, inventoryMetadataColumns...)
+		for _, column := range attributeColumns {
+			columns = append(columns, sqlite.Column{Name: column})
+		}
+		table, err := db.CreateTable(resourceType, columns...)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, row := range rowsByType[resourceType] {
+			mode := inventoryModeManaged
+			if row.node.IsData {
+				mode = inventoryModeData
+			}
+			values := []interface{}{row.node.ID, row.node.Address, mode, row.node.Label, originalLabels[row.node.Address]}
+			for _, column := range attributeColumns {
+				values = append(values, row.attributes[column])
+			}
+			if err := table.Insert(values...); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	edges, err := db.CreateTable(inventoryEdgesTable, inventoryEdgeColumns...)
+	if err != nil {
+		return nil, err
+	}
+	nodes := make(map[string]*dependencyGraphNode, len(graph.Nodes))
+	for _, node := range graph.Nodes {
+		nodes[node.Address] = node
+	}
+	for _, edge := range graph.Edges {
+		from, to := nodes[edge.From], nodes[edge.To]
+		if err := edges.Insert(edge.From, from.Type, from.ID, edge.To, to.Type, to.ID, strings.Join(edge.Attributes, ", "), edge.InCycle); err != nil {
+			return nil, err
+		}
+	}
+
+	return db, nil
+}
+
+// flattenInventoryAttributes adds the attributes of a config map to columns, with nested blocks flattened to dotted
+// column names and lists stored as JSON text
+func flattenInventoryAttributes(configMap map[string]interface{}, prefix string, decodedAttributes map[string]string, columns map[string]interface{}) {
+	for key, value := range configMap {
+		if prefix == "" && key == "depends_on" {
+			// depends_on entries are stored in the edges table
+			continue
+		}
+		column := prefix + key
+
+		switch v := value.(type) {
+		case util.JsonMap:
+			flattenInventoryAttributes(v, column+".", decodedAttributes, columns)
+		case map[string]interface{}:
+			flattenInventoryAttributes(v, column+".", decodedAttributes, columns)
+		case []interface{}, []string:
+			content, err := json.Marshal(resolveInventoryValue(v, decodedAttributes))
+			if err != nil {
+				columns[column] = fmt.Sprintf("%v", v)
+				continue
+			}
+			columns[column] = string(content)
+		default:
+			columns[column] = inventoryColumnValue(resolveInventoryValue(v, decodedAttributes))
+		}
+	}
+}
+
+// resolveInventoryValue replaces the placeholders of attributes exported with jsonencode by their JSON content
+func resolveInventoryValue(value interface{}, decodedAttributes map[string]string) interface{} {
+	switch v := value.(type) {
+	case string:
+		decoded, ok := decodedAttributes[v]
+		if !ok {
+			return v
+		}
+		content := strings.TrimSuffix(strings.TrimPrefix(decoded, "jsonencode("), ")")
+		var compacted bytes.Buffer
+		if err := json.Compact(&compacted, []byte(content)); err == nil {
+			return compacted.String()
+		}
+		return content
+	case []interface{}:
+		resolved := make([]interface{}, 0, len(v))
+		for _, item := range v {
+			resolved = append(resolved, resolveInventoryValue(item, decodedAttributes))
+		}
+		return resolved
+	case util.JsonMap:
+		return resolveInventoryValue(map[string]interface{}(v), decodedAttributes)
+	case map[string]interface{}:
+		resolved := make(map[string]interface{}, len(v))
+		for key, item := range v {
+			resolved[key] = resolveInventoryValue(item, decodedAttributes)
+		}
+		return resolved
+	}
+	return value
+}
+
+// inventoryColumnValue converts a scalar config value to a value the database can store
+func inventoryColumnValue(value interface{}) interface{} {
+	switch v := value.(type) {
+	case nil, bool, int64, float64, string:
+		return v
+	case int:
+		return int64(v)
+	case int32:
+		return int64(v)
+	case float32:
+		return float64(v)
+	case json.Number:
+		if i, err := v.Int64(); err == nil {
+			return i
+		}
+		if f, err := v.Float64(); err == nil {
+			return f
+		}
+		return v.String()
+	}
+	return fmt.Sprintf("%v", value)
+}
+
+// writeInventory writes the inventory database of the export when the export format ends with _sqlite
+func (g *GenesysCloudResourceExporter) writeInventory() diag.Diagnostics {
+	if !g.writesInventory() {
+		return nil
+	}
+
+	originalLabels := make(map[string]string)
+	for _, resource := range g.getResources() {
+		address := resource.Type + "." + resource.BlockLabel
+		if g.isDataSource(resource.Type, resource.BlockLabel, resource.OriginalLabel) {
+			address = "data." + address
+		}
+		originalLabels[address] = resource.OriginalLabel
+	}
+
+	graph := g.buildExportDependencyGraph()
+	db, err := buildInventory(graph, g.getResourceTypesMaps(), g.getDataSourceTypesMaps(), g.copyAttributesDecoded(), originalLabels)
+	if err != nil {
+		return diag.Errorf("Failed to build the inventory database: %v", err)
+	}
+
+	inventoryPath := filepath.Join(g.exportDirPath, inventoryFileName)
+	if err := db.WriteFile(inventoryPath); err != nil {
+		return diag.Errorf("Failed to write the inventory database %s: %v", inventoryPath, err)
+	}
+	tflog.Info(g.ctx, fmt.Sprintf("Wrote the inventory of %d resources and %d references to %s", len(graph.Nodes), len(graph.Edges), inventoryPath))
+	return nil
+}
@@ -0,0 +1,112 @@
+package tfexporter
+
+import (
+	"testing"
+
+	"github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/util"
+	"github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/util/sqlite"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// inventoryTableRows returns the rows of a table by the value of a column
+func inventoryTableRows(t *testing.T, table *sqlite.Table, keyColumn string) map[string]map[string]interface{} {
+	rows := make(map[string]map[string]interface{})
+	for _, values := range table.Rows() {
+		row := make(map[string]interface{})
+		for i, column := range table.Columns {
+			row[column.Name] = values[i]
+		}
+		key, ok := row[keyColumn].(string)
+		require.True(t, ok, "column %s of table %s is not text", keyColumn, table.Name)
+		rows[key] = row
+	}
+	return rows
+}
+
+// TestUnitBuildInventory asserts that the inventory has a table per resource type with the flattened attributes and
+// labels of its resources and data sources, and an edges table with the references between them
+func TestUnitBuildInventory(t *testing.T) {
+	resourceTypesMaps := map[string]ResourceJSONMaps{
+		"genesyscloud_routing_queue": {
+			"support": util.JsonMap{
+				"name":              "Support",
+				"enable_transcript": true,
+				"skill_groups":      []interface{}{"${genesyscloud_routing_skill_group.tier_1.id}"},
+				"media_settings_call": []interface{}{
+					map[string]interface{}{"alerting_timeout_sec": 8},
+				},
+				"queue_flow_id": "${genesyscloud_flow.inbound.id}",
+				"depends_on":    []string{"$dep$genesyscloud_flow.inbound$dep$"},
+			},
+		},
+		"genesyscloud_flow": {
+			"inbound": util.JsonMap{
+				"name":          "Inbound",
+				"configuration": "uid-1",
+				"settings":      map[string]interface{}{"timeout": 30.5, "language": nil},
+			},
+		},
+		"genesyscloud_routing_skill_group": {
+			"tier_1": util.JsonMap{"name": "Tier 1"},
+		},
+	}
+	dataSourceTypesMaps := map[string]ResourceJSONMaps{
+		"genesyscloud_flow": {
+			"outbound": util.JsonMap{"name": "Outbound"},
+		},
+	}
+	decoded := map[string]string{"uid-1": "jsonencode({\n\t\t\"queueId\": \"${genesyscloud_routing_queue.support.id}\"\n\t})"}
+	ids := map[string]string{
+		"genesyscloud_routing_queue.support":      "queue-1",
+		"genesyscloud_flow.inbound":               "flow-1",
+		"data.genesyscloud_flow.outbound":         "flow-2",
+		"genesyscloud_routing_skill_group.tier_1": "group-1",
+	}
+	originalLabels := map[string]string{"genesyscloud_routing_queue.support": "Support Queue"}
+
+	graph := buildDependencyGraph(resourceTypesMaps, dataSourceTypesMaps, decoded, ids, nil)
+	db, err := buildInventory(graph, resourceTypesMaps, dataSourceTypesMaps, decoded, originalLabels)
+	require.NoError(t, err)
+
+	tables := db.Tables()
+	tableNames := make([]string, 0, len(tables))
+	for _, table := range tables {
+		tableNames = append(tableNames, table.Name)
+	}
+	require.Equal(t, []string{"genesyscloud_flow", "genesyscloud_routing_queue", "genesyscloud_routing_skill_group", inventoryEdgesTable}, tableNames)
+
+	flows := inventoryTableRows(t, tables[0], "_address")
+	require.Len(t, flows, 2)
+	assert.Equal(t, "flow-1", flows["genesyscloud_flow.inbound"]["_id"])
+	assert.Equal(t, inventoryModeManaged, flows["genesyscloud_flow.inbound"]["_mode"])
+	assert.Equal(t, `{"queueId":"${genesyscloud_routing_queue.support.id}"}`, flows["genesyscloud_flow.inbound"]["configuration"])
+	assert.Equal(t, 30.5, flows["genesyscloud_flow.inbound"]["settings.timeout"])
+	assert.Nil(t, flows["genesyscloud_flow.inbound"]["settings.language"])
+	assert.Equal(t, inventoryModeData, flows["data.genesyscloud_flow.outbound"]["_mode"])
+	assert.Equal(t, "outbound", flows["data.genesyscloud_flow.outbound"]["_block_label"])
+	assert.Nil(t, flows["data.genesyscloud_flow.outbound"]["configuration"], "attributes of other resources of the type are NULL")
+
+	queues := inventoryTableRows(t, tables[1], "_address")
+	queue := queues["genesyscloud_routing_queue.support"]
+	require.NotNil(t, queue)
+	assert.Equal(t, "queue-1", queue["_id"])
+	assert.Equal(t, "support", queue["_block_label"])
+	assert.Equal(t, "Support Queue", queue["_original_label"])
+	assert.Equal(t, true, queue["enable_transcript"])
+	assert.Equal(t, `["${genesyscloud_routing_skill_group.tier_1.id}"]`, queue["skill_groups"])
+	assert.Equal(t, `[{"alerting_timeout_sec":8}]`, queue["media_settings_call"])
+	assert.NotContains(t, queue, "depends_on")
+
+	edges := tables[3]
+	assert.Equal(t, inventoryEdgeColumns, edges.Columns)
+	assert.Equal(t, [][]interface{}{
+		{"genesyscloud_flow.inbound", "genesyscloud_flow", "flow-1", "genesyscloud_routing_queue.support", "genesyscloud_routing_queue", "queue-1", "configuration", true},
+		{"genesyscloud_routing_queue.support", "genesyscloud_routing_queue", "queue-1", "genesyscloud_flow.inbound", "genesyscloud_flow", "flow-1", "depends_on, queue_flow_id", true},
+		{"genesyscloud_routing_queue.support", "genesyscloud_routing_queue", "queue-1", "genesyscloud_routing_skill_group.tier_1", "genesyscloud_routing_skill_group", "group-1", "skill_groups", false},
+	}, edges.Rows())
+
+	_, err = db.Bytes()
+	assert.NoError(t, err)
+}
@@ -28,9 +28,10 @@ type JsonExporter struct {
 	version               string
 	dirPath               string
 	splitFilesByResource  bool
+	writeTfVars           bool
 }
 
-func NewJsonExporter(resourceTypesJSONMaps map[string]ResourceJSONMaps, dataSourceTypesMaps map[string]ResourceJSONMaps, unresolvedAttrs []unresolvableAttributeInfo, providerRegistry string, version string, dirPath string, splitFilesByResource bool) *JsonExporter {
+func NewJsonExporter(resourceTypesJSONMaps map[string]ResourceJSONMaps, dataSourceTypesMaps map[string]ResourceJSONMaps, unresolvedAttrs []unresolvableAttributeInfo, providerRegistry string, version string, dirPath string, splitFilesByResource bool, writeTfVars bool) *JsonExporter {
 	jsonExporter := &JsonExporter{
 		resourceTypesJSONMaps: resourceTypesJSONMaps,
 		dataSourceTypesMaps:   dataSourceTypesMaps,
@@ -39,6 +40,7 @@ func NewJsonExporter(resourceTypesJSONMaps map[string]ResourceJSONMaps, dataSour
 		version:               version,
 		dirPath:               dirPath,
 		splitFilesByResource:  splitFilesByResource,
+		writeTfVars:           writeTfVars,
 	}
 	return jsonExporter
 }
@@ -77,44 +79,9 @@ func (j *JsonExporter) exportJSONConfig() diag.Diagnostics {
 			}
 		}
 
-		// Resource files
-		for resType, resJsonMap := range j.resourceTypesJSONMaps {
-			if len(resJsonMap) == 0 {
-				continue
-			}
-			resourceRoot := map[string]interface{}{
-				"resource": util.JsonMap{
-					resType: resJsonMap,
-				},
-			}
-
-			resourceJSONFilePath := filepath.Join(j.dirPath, fmt.Sprintf("%s.%s", resType, resourceJSONFileExt))
-			if resourceJSONFilePath == "" {
-				return diag.Errorf("Failed to create file path %s", resourceJSONFilePath)
-			}
-			if diagErr := writeConfig(resourceRoot, resourceJSONFilePath); diagErr != nil {
-				return diagErr
-			}
-		}
-
-		// DataSource files
-		for resType, resJsonMap := range j.dataSourceTypesMaps {
-			if len(resJsonMap) == 0 {
-				continue
-			}
-			resourceRoot := map[string]interface{}{
-				"data": util.JsonMap{
-					resType: resJsonMap,
-				},
-			}
-
-			resourceJSONFilePath := filepath.Join(j.dirPath, fmt.Sprintf("data_%s.%s", resType, resourceJSONFileExt))
-			if resourceJSONFilePath == "" {
-				return diag.Errorf("Failed to create file path %s", resourceJSONFilePath)
-			}
-			if diagErr := writeConfig(resourceRoot, resourceJSONFilePath); diagErr != nil {
-				return diagErr
-			}
+		// Resource and DataSource files
+		if diagErr := j.exportJSONResourceFiles(); diagErr != nil {
+			return diagErr
 		}
 
 	} else {
@@ -141,8 +108,8 @@ func (j *JsonExporter) exportJSONConfig() diag.Diagnostics {
 		writeConfig(rootJSONObject, jsonFilePath)
 	}
 
-	// Optional tfvars file creation for unresolved attributes
-	if len(j.unresolvedAttrs) > 0 {
+	// Optional tfvars file creation for unresolved attributes, unless they are read from a secret store
+	if j.writeTfVars && len(j.unresolvedAttrs) > 0 {
 		tfVars := make(map[string]interface{})
 		for _, attr := range j.unresolvedAttrs {
 			key := createUnresolvedAttrKey(attr)
@@ -162,6 +129,51 @@ func (j *JsonExporter) exportJSONConfig() diag.Diagnostics {
 	return nil
 }
 
+// exportJSONResourceFiles writes one file per resource type and one per data source type, without the provider,
+// variables and tfvars files
+func (j *JsonExporter) exportJSONResourceFiles() diag.Diagnostics {
+	// Resource files
+	for resType, resJsonMap := range j.resourceTypesJSONMaps {
+		if len(resJsonMap) == 0 {
+			continue
+		}
+		resourceRoot := map[string]interface{}{
+			"resource": util.JsonMap{
+				resType: resJsonMap,
+			},
+		}
+
+		resourceJSONFilePath := filepath.Join(j.dirPath, fmt.Sprintf("%s.%s", resType, resourceJSONFileExt))
+		if resourceJSONFilePath == "" {
+			return diag.Errorf("Failed to create file path %s", resourceJSONFilePath)
+		}
+		if diagErr := writeConfig(resourceRoot, resourceJSONFilePath); diagErr != nil {
+			return diagErr
+		}
+	}
+
+	// DataSource files
+	for resType, resJsonMap := range j.dataSourceTypesMaps {
+		if len(resJsonMap) == 0 {
+			continue
+		}
+		resourceRoot := map[string]interface{}{
+			"data": util.JsonMap{
+				resType: resJsonMap,
+			},
+		}
+
+		resourceJSONFilePath := filepath.Join(j.dirPath, fmt.Sprintf("data_%s.%s", resType, resourceJSONFileExt))
+		if resourceJSONFilePath == "" {
+			return diag.Errorf("Failed to create file path %s", resourceJSONFilePath)
+		}
+		if diagErr := writeConfig(resourceRoot, resourceJSONFilePath); diagErr != nil {
+			return diagErr
+		}
+	}
+	return nil
+}
+
 func createProviderJsonMap(providerRegistry string, version string) util.JsonMap {
 	return util.JsonMap{
 		"required_providers": util.JsonMap{
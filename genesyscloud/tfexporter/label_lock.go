@@ -0,0 +1,355 @@
+package tfexporter
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"text/template"
+
+	authDivision "github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/auth_division"
+	"github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/provider"
+	resourceExporter "github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/resource_exporter"
+	"github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/util/files"
+	"github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/util/lists"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+)
+
+/*
+The label_lock.go file keeps the block labels of exported resources stable across exports.
+
+Labels normally come from the SanitizerProvider and change whenever an object is renamed or a name collision adds a
+hash. Two settings of the genesyscloud_tf_export resource control them instead:
+
+ 1. label_templates renders the label of each new resource of a type from a Go template over its attributes,
+    e.g. {{.name}}_{{.division_name}}.
+ 2. use_label_lock_file reads labels.lock.json from the export directory, reuses the label recorded for every ID
+    found there and writes the labels of the current export back to it.
+*/
+
+const (
+	labelLockFileName    = "labels.lock.json"
+	labelLockFileVersion = 1
+)
+
+// labelLockFile is the content of labels.lock.json
+type labelLockFile struct {
+	Version int `json:"version"`
+	// Labels maps a resource type to the IDs of its exported resources and the block labels they were given
+	Labels map[string]map[string]string `json:"labels"`
+}
+
+// blockLabeler assigns the block labels of exported resources from the label lock file and the label templates
+type blockLabeler struct {
+	templates map[string]*template.Template
+	lock      *labelLockFile
+	lockPath  string
+	useLock   bool
+
+	// assigned holds, per resource type, the labels given during this export and the ID each one was given to
+	assigned map[string]map[string]string
+
+	// divisionNamesFunc returns the names of all divisions by ID. It is only called when a template needs a division
+	// name that cannot be found among the exported resources.
+	divisionNamesFunc  func() (map[string]string, diag.Diagnostics)
+	divisionNames      map[string]string
+	divisionNamesDiags diag.Diagnostics
+	divisionNamesOnce  sync.Once
+
+	mutex sync.Mutex
+}
+
+// parseLabelTemplates parses the label_templates attribute of the export resource
+func parseLabelTemplates(labelTemplates map[string]interface{}) (map[string]*template.Template, error) {
+	templates := make(map[string]*template.Template, len(labelTemplates))
+	for resourceType, text := range labelTemplates {
+		labelTemplate, err := template.New(resourceType).Option("missingkey=zero").Parse(text.(string))
+		if err != nil {
+			return nil, fmt.Errorf("invalid label template for %s: %w", resourceType, err)
+		}
+		templates[resourceType] = labelTemplate
+	}
+	return templates, nil
+}
+
+// validateLabelTemplates checks that every key of label_templates is an exportable resource type and every value
+// a valid template
+func validateLabelTemplates(v interface{}, k string) (ws []string, es []error) {
+	labelTemplates, ok := v.(map[string]interface{})
+	if !ok {
+		return nil, []error{fmt.Errorf("expected %s to be a map", k)}
+	}
+	exporterTypes := resourceExporter.GetAvailableExporterTypes()
+	for resourceType := range labelTemplates {
+		if !lists.ItemInSlice(resourceType, exporterTypes) {
+			es = append(es, fmt.Errorf("%s: %s is not an exportable resource type", k, resourceType))
+		}
+	}
+	if _, err := parseLabelTemplates(labelTemplates); err != nil {
+		es = append(es, fmt.Errorf("%s: %w", k, err))
+	}
+	return ws, es
+}
+
+// readLabelLockFile reads the label lock file at path. A missing file gives an empty lock.
+func readLabelLockFile(path string) (*labelLockFile, error) {
+	lock := &labelLockFile{Version: labelLockFileVersion, Labels: make(map[string]map[string]string)}
+	content, err := os.ReadFile(path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return lock, nil
+		}
+		return nil, err
+	}
+	if err := json.Unmarshal(content, lock); err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", path, err)
+	}
+	if lock.Version > labelLockFileVersion {
+		return nil, fmt.Errorf("%s has version %d, which is newer than the version %d supported by this provider", path, lock.Version, labelLockFileVersion)
+	}
+	if lock.Labels == nil {
+		lock.Labels = make(map[string]map[string]string)
+	}
+	lock.Version = labelLockFileVersion
+	return lock, nil
+}
+
+// setupBlockLabeler creates the labeler of the export if label_templates or use_label_lock_file is set
+func (g *GenesysCloudResourceExporter) setupBlockLabeler() diag.Diagnostics {
+	labelTemplates, _ := g.d.Get("label_templates").(map[string]interface{})
+	useLock, _ := g.d.Get("use_label_lock_file").(bool)
+	if len(labelTemplates) == 0 && !useLock {
+		return nil
+	}
+
+	templates, err := parseLabelTemplates(labelTemplates)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	labeler := &blockLabeler{
+		templates: templates,
+		lock:      &labelLockFile{Version: labelLockFileVersion, Labels: make(map[string]map[string]string)},
+		lockPath:  filepath.Join(g.exportDirPath, labelLockFileName),
+		useLock:   useLock,
+		assigned:  make(map[string]map[string]string),
+	}
+	if providerMeta, ok := g.meta.(*provider.ProviderMeta); ok {
+		labeler.divisionNamesFunc = func() (map[string]string, diag.Diagnostics) {
+			names, diagErr := authDivision.GetAuthDivisionNames(g.ctx, providerMeta.ClientConfig)
+			if diagErr.HasError() {
+				tflog.Warn(g.ctx, fmt.Sprintf("Division names are not available to the label templates: %v", diagErr))
+			}
+			return names, diagErr
+		}
+	}
+	if useLock {
+		labeler.lock, err = readLabelLockFile(labeler.lockPath)
+		if err != nil {
+			return diag.Errorf("Failed to read the label lock file: %v", err)
+		}
+		tflog.Info(g.ctx, fmt.Sprintf("Loaded %d resource types from %s", len(labeler.lock.Labels), labeler.lockPath))
+	}
+
+	g.labeler = labeler
+	return nil
+}
+
+// applyBlockLabels relabels the resources retrieved so far. It runs before the config maps are built so that
+// references to the relabeled resources are resolved with their new labels. Dependency resolution retrieves the
+// resources it adds through rebuildExports, so they are relabeled and recorded in the lock here as well.
+func (g *GenesysCloudResourceExporter) applyBlockLabels() diag.Diagnostics {
+	if g.labeler == nil {
+		return nil
+	}
+
+	g.exportersMutex.RLock()
+	exporters := make(map[string]*resourceExporter.ResourceExporter, len(*g.exporters))
+	for k, v := range *g.exporters {
+		exporters[k] = v
+	}
+	g.exportersMutex.RUnlock()
+
+	g.resourcesMutex.Lock()
+	defer g.resourcesMutex.Unlock()
+
+	indexesByType := make(map[string][]int)
+	for i, resource := range g.resources {
+		indexesByType[resource.Type] = append(indexesByType[resource.Type], i)
+	}
+
+	for resourceType, indexes := range indexesByType {
+		resources := make([]resourceExporter.ResourceInfo, 0, len(indexes))
+		for _, i := range indexes {
+			resources = append(resources, g.resources[i])
+		}
+
+		labels, err := g.labeler.assignLabels(resourceType, resources, exporters)
+		if err != nil {
+			return diag.Errorf("Failed to assign block labels for %s: %v", resourceType, err)
+		}
+
+		for _, i := range indexes {
+			id := g.resources[i].State.ID
+			label := labels[id]
+			if label == "" || label == g.resources[i].BlockLabel {
+				continue
+			}
+			tflog.Debug(g.ctx, fmt.Sprintf("Relabeling %s.%s (%s) as %s", resourceType, g.resources[i].BlockLabel, id, label))
+			g.resources[i].BlockLabel = label
+			g.updateSanitizeMap(exporters, g.resources[i])
+		}
+	}
+	return nil
+}
+
+// assignLabels returns the label of every resource of a type by ID. The label recorded in the lock wins, then the
+// label template of the type, then the label given by the sanitizer. A label that is already taken gets a suffix
+// derived from the ID, so that it does not depend on the order in which resources are exported.
+func (l *blockLabeler) assignLabels(resourceType string, resources []resourceExporter.ResourceInfo, exporters map[string]*resourceExporter.ResourceExporter) (map[string]string, error) {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	if l.assigned[resourceType] == nil {
+		l.assigned[resourceType] = make(map[string]string)
+	}
+	if l.lock.Labels[resourceType] == nil {
+		l.lock.Labels[resourceType] = make(map[string]string)
+	}
+	assigned := l.assigned[resourceType]
+	locked := l.lock.Labels[resourceType]
+
+	sort.Slice(resources, func(i, j int) bool {
+		return resources[i].State.ID < resources[j].State.ID
+	})
+
+	labels := make(map[string]string, len(resources))
+	var unlocked []resourceExporter.ResourceInfo
+	for _, resource := range resources {
+		id := resource.State.ID
+		label := locked[id]
+		if label == "" {
+			unlocked = append(unlocked, resource)
+			continue
+		}
+		if owner, taken := assigned[label]; taken && owner != id {
+			unlocked = append(unlocked, resource)
+			continue
+		}
+		assigned[label] = id
+		labels[id] = label
+	}
+
+	sanitizer := resourceExporter.NewSanitizerProvider()
+	for _, resource := range unlocked {
+		id := resource.State.ID
+		label := resource.BlockLabel
+		if labelTemplate, ok := l.templates[resourceType]; ok {
+			rendered, err := l.renderLabel(labelTemplate, resource, exporters)
+			if err != nil {
+				return nil, err
+			}
+			if sanitized := sanitizer.S.SanitizeResourceBlockLabel(rendered); sanitized != "" {
+				label = sanitized
+			}
+		}
+		if owner, taken := assigned[label]; taken && owner != id {
+			label = label + "_" + labelIdHash(id)
+		}
+		assigned[label] = id
+		locked[id] = label
+		labels[id] = label
+	}
+	return labels, nil
+}
+
+// renderLabel renders the label template of a resource. The template data holds the top level attributes of the
+// resource, its id, and for every reference attribute ending in _id the name of the referenced object under the same
+// key ending in _name, e.g. division_name for division_id.
+func (l *blockLabeler) renderLabel(labelTemplate *template.Template, resource resourceExporter.ResourceInfo, exporters map[string]*resourceExporter.ResourceExporter) (string, error) {
+	data := make(map[string]string)
+	for key, value := range resource.State.Attributes {
+		if !strings.Contains(key, ".") {
+			data[key] = value
+		}
+	}
+	data["id"] = resource.State.ID
+	if data["name"] == "" {
+		data["name"] = resource.OriginalLabel
+	}
+
+	if exporter := exporters[resource.Type]; exporter != nil {
+		for attribute, refSettings := range exporter.RefAttrs {
+			refId := data[attribute]
+			nameKey := strings.TrimSuffix(attribute, "_id") + "_name"
+			if refId == "" || !strings.HasSuffix(attribute, "_id") || data[nameKey] != "" {
+				continue
+			}
+			data[nameKey] = l.referencedName(refSettings.RefType, refId, exporters)
+		}
+	}
+
+	var label strings.Builder
+	if err := labelTemplate.Execute(&label, data); err != nil {
+		return "", fmt.Errorf("failed to render the label of %s %s: %w", resource.Type, resource.State.ID, err)
+	}
+	return label.String(), nil
+}
+
+// referencedName returns the name of the object an attribute refers to, or an empty string if it is unknown
+func (l *blockLabeler) referencedName(refType string, refId string, exporters map[string]*resourceExporter.ResourceExporter) string {
+	if exporter := exporters[refType]; exporter != nil {
+		if meta := exporter.GetSanitizedResourceMap()[refId]; meta != nil {
+			if meta.OriginalLabel != "" {
+				return meta.OriginalLabel
+			}
+			return meta.BlockLabel
+		}
+	}
+	if refType != authDivision.ResourceType || l.divisionNamesFunc == nil {
+		return ""
+	}
+
+	l.divisionNamesOnce.Do(func() {
+		l.divisionNames, l.divisionNamesDiags = l.divisionNamesFunc()
+	})
+	if l.divisionNamesDiags.HasError() {
+		return ""
+	}
+	return l.divisionNames[refId]
+}
+
+// writeLabelLockFile writes the labels of this export, along with those of earlier exports, to the label lock file
+func (g *GenesysCloudResourceExporter) writeLabelLockFile() diag.Diagnostics {
+	if g.labeler == nil || !g.labeler.useLock {
+		return nil
+	}
+
+	g.labeler.mutex.Lock()
+	defer g.labeler.mutex.Unlock()
+
+	for resourceType, labels := range g.labeler.lock.Labels {
+		if len(labels) == 0 {
+			delete(g.labeler.lock.Labels, resourceType)
+		}
+	}
+	content, err := json.MarshalIndent(g.labeler.lock, "", "  ")
+	if err != nil {
+		return diag.Errorf("Failed to encode the label lock file: %v", err)
+	}
+	return files.WriteToFile(append(content, '\n'), g.labeler.lockPath)
+}
+
+// labelIdHash returns a short hash of an ID to make a label unique
+func labelIdHash(id string) string {
+	h := sha256.Sum256([]byte(id))
+	return hex.EncodeToString(h[:5])
+}
@@ -0,0 +1,158 @@
+package tfexporter
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	resourceExporter "github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/resource_exporter"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func labelTestResource(id string, label string, attributes map[string]string) resourceExporter.ResourceInfo {
+	return resourceExporter.ResourceInfo{
+		State:         &terraform.InstanceState{ID: id, Attributes: attributes},
+		BlockLabel:    label,
+		OriginalLabel: attributes["name"],
+		Type:          "genesyscloud_routing_queue",
+	}
+}
+
+func newTestBlockLabeler(t *testing.T, labelTemplates map[string]interface{}, lock map[string]map[string]string) *blockLabeler {
+	templates, err := parseLabelTemplates(labelTemplates)
+	require.NoError(t, err)
+	if lock == nil {
+		lock = make(map[string]map[string]string)
+	}
+	return &blockLabeler{
+		templates: templates,
+		lock:      &labelLockFile{Version: labelLockFileVersion, Labels: lock},
+		assigned:  make(map[string]map[string]string),
+		divisionNamesFunc: func() (map[string]string, diag.Diagnostics) {
+			return map[string]string{"division-2": "Support"}, nil
+		},
+	}
+}
+
+// TestUnitAssignLabelsFromTemplate asserts that templates can use the names of referenced objects, whether they are
+// exported or not, and that colliding labels get a suffix derived from the ID
+func TestUnitAssignLabelsFromTemplate(t *testing.T) {
+	labeler := newTestBlockLabeler(t, map[string]interface{}{
+		"genesyscloud_routing_queue": "{{.name}}_{{.division_name}}",
+	}, nil)
+
+	divisionExporter := &resourceExporter.ResourceExporter{
+		SanitizedResourceMap: resourceExporter.ResourceIDMetaMap{
+			"division-1": {BlockLabel: "Sales", OriginalLabel: "Sales"},
+		},
+	}
+	queueExporter := &resourceExporter.ResourceExporter{
+		RefAttrs: map[string]*resourceExporter.RefAttrSettings{
+			"division_id": {RefType: "genesyscloud_auth_division"},
+		},
+	}
+	exporters := map[string]*resourceExporter.ResourceExporter{
+		"genesyscloud_auth_division": divisionExporter,
+		"genesyscloud_routing_queue": queueExporter,
+	}
+
+	labels, err := labeler.assignLabels("genesyscloud_routing_queue", []resourceExporter.ResourceInfo{
+		labelTestResource("queue-1", "Inbound", map[string]string{"name": "Inbound", "division_id": "division-1"}),
+		labelTestResource("queue-2", "Inbound_abc", map[string]string{"name": "Inbound", "division_id": "division-2"}),
+		labelTestResource("queue-3", "Inbound_def", map[string]string{"name": "Inbound", "division_id": "division-1"}),
+	}, exporters)
+	require.NoError(t, err)
+
+	assert.Equal(t, "Inbound_Sales", labels["queue-1"])
+	assert.Equal(t, "Inbound_Support", labels["queue-2"])
+	assert.Equal(t, "Inbound_Sales_"+labelIdHash("queue-3"), labels["queue-3"])
+	assert.Equal(t, labels, labeler.lock.Labels["genesyscloud_routing_queue"])
+}
+
+// TestUnitAssignLabelsFromLock asserts that locked labels are kept whatever the current name of the resource, and
+// that a new resource cannot take a locked label
+func TestUnitAssignLabelsFromLock(t *testing.T) {
+	labeler := newTestBlockLabeler(t, nil, map[string]map[string]string{
+		"genesyscloud_routing_queue": {"queue-1": "Support"},
+	})
+
+	labels, err := labeler.assignLabels("genesyscloud_routing_queue", []resourceExporter.ResourceInfo{
+		labelTestResource("queue-2", "Support", map[string]string{"name": "Support"}),
+		labelTestResource("queue-1", "Customer_Care", map[string]string{"name": "Customer Care"}),
+	}, nil)
+	require.NoError(t, err)
+
+	assert.Equal(t, "Support", labels["queue-1"])
+	assert.Equal(t, "Support_"+labelIdHash("queue-2"), labels["queue-2"])
+
+	// A second pass, e.g. when dependencies are exported, keeps the labels given by the first one
+	labels, err = labeler.assignLabels("genesyscloud_routing_queue", []resourceExporter.ResourceInfo{
+		labelTestResource("queue-2", "Support", map[string]string{"name": "Support"}),
+	}, nil)
+	require.NoError(t, err)
+	assert.Equal(t, "Support_"+labelIdHash("queue-2"), labels["queue-2"])
+}
+
+// TestUnitApplyBlockLabelsToDependencies asserts that resources retrieved again when dependency resolution rebuilds
+// the exporters are relabeled and recorded in the lock like the resources of the first retrieval
+func TestUnitApplyBlockLabelsToDependencies(t *testing.T) {
+	labeler := newTestBlockLabeler(t, map[string]interface{}{
+		"genesyscloud_routing_queue": "queue_{{.name}}",
+	}, nil)
+	newExporters := func(id string, label string) *map[string]*resourceExporter.ResourceExporter {
+		return &map[string]*resourceExporter.ResourceExporter{
+			"genesyscloud_routing_queue": {
+				SanitizedResourceMap: resourceExporter.ResourceIDMetaMap{id: {BlockLabel: label, OriginalLabel: label}},
+			},
+		}
+	}
+
+	g := &GenesysCloudResourceExporter{
+		ctx:       context.Background(),
+		labeler:   labeler,
+		exporters: newExporters("queue-1", "Inbound"),
+		resources: []resourceExporter.ResourceInfo{labelTestResource("queue-1", "Inbound", map[string]string{"name": "Inbound"})},
+	}
+	require.False(t, g.applyBlockLabels().HasError())
+	assert.Equal(t, "queue_Inbound", g.resources[0].BlockLabel)
+
+	// Dependency resolution clears the resources and rebuilds the exporters before retrieving the dependencies
+	g.exporters = newExporters("queue-2", "Outbound")
+	g.resources = []resourceExporter.ResourceInfo{labelTestResource("queue-2", "Outbound", map[string]string{"name": "Outbound"})}
+	require.False(t, g.applyBlockLabels().HasError())
+
+	assert.Equal(t, "queue_Outbound", g.resources[0].BlockLabel)
+	assert.Equal(t, "queue_Outbound", (*g.exporters)["genesyscloud_routing_queue"].SanitizedResourceMap["queue-2"].BlockLabel)
+	assert.Equal(t, map[string]string{"queue-1": "queue_Inbound", "queue-2": "queue_Outbound"}, labeler.lock.Labels["genesyscloud_routing_queue"])
+}
+
+// TestUnitLabelLockFileRoundTrip asserts that a missing lock file reads as empty and that a written lock file can be
+// read back
+func TestUnitLabelLockFileRoundTrip(t *testing.T) {
+	exportDir := t.TempDir()
+	lockPath := filepath.Join(exportDir, labelLockFileName)
+
+	lock, err := readLabelLockFile(lockPath)
+	require.NoError(t, err)
+	assert.Empty(t, lock.Labels)
+
+	g := &GenesysCloudResourceExporter{labeler: newTestBlockLabeler(t, nil, map[string]map[string]string{
+		"genesyscloud_routing_queue": {"queue-1": "Support"},
+		"genesyscloud_user":          {},
+	})}
+	g.labeler.lockPath = lockPath
+	g.labeler.useLock = true
+	require.False(t, g.writeLabelLockFile().HasError())
+
+	lock, err = readLabelLockFile(lockPath)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]map[string]string{"genesyscloud_routing_queue": {"queue-1": "Support"}}, lock.Labels)
+
+	isEmpty, diags := isDirEmpty(exportDir)
+	require.False(t, diags.HasError())
+	assert.True(t, isEmpty, "a directory that only holds the label lock file should count as empty")
+}
@@ -0,0 +1,239 @@
+package tfexporter
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/util"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+)
+
+/*
+The moved_blocks.go file turns label changes between two exports into Terraform moved blocks. The labels of the
+previous export are read by ID from its state file or label lock file. Every resource that is exported again under a
+new label gets a moved block from its previous address to its new one, so that Terraform plans a move instead of a
+destroy and create.
+*/
+
+const (
+	movedHCLFile  = "moved.tf"
+	movedJSONFile = "moved.tf.json"
+)
+
+// movedBlock moves a resource from one block label to another
+type movedBlock struct {
+	ResourceType string
+	From         string
+	To           string
+}
+
+// exportedAddress is the type, label and ID of an exported resource
+type exportedAddress struct {
+	ResourceType string
+	Label        string
+	ID           string
+}
+
+// tfStateFile holds the parts of a Terraform state file needed to find the label of each resource
+type tfStateFile struct {
+	Resources []struct {
+		Mode      string `json:"mode"`
+		Type      string `json:"type"`
+		Name      string `json:"name"`
+		Module    string `json:"module"`
+		Instances []struct {
+			IndexKey   interface{}            `json:"index_key"`
+			Attributes map[string]interface{} `json:"attributes"`
+		} `json:"instances"`
+	} `json:"resources"`
+}
+
+// readPreviousLabels reads the labels of a previous export by resource type and ID. The path is either a state file
+// or an export directory, in which case its state file and label lock file are read. Labels found in the state file
+// win over those of the lock file.
+func readPreviousLabels(path string) (map[string]map[string]string, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+	if !info.IsDir() {
+		return readStateLabels(path)
+	}
+
+	labels, err := readLabelLockFile(filepath.Join(path, labelLockFileName))
+	if err != nil {
+		return nil, err
+	}
+	stateLabels, err := readStateLabels(filepath.Join(path, defaultTfStateFile))
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return nil, err
+	}
+	for resourceType, ids := range stateLabels {
+		if labels.Labels[resourceType] == nil {
+			labels.Labels[resourceType] = make(map[string]string)
+		}
+		for id, label := range ids {
+			labels.Labels[resourceType][id] = label
+		}
+	}
+	return labels.Labels, nil
+}
+
+// readStateLabels reads the label of every managed resource of the root module of a state file by type and ID
+func readStateLabels(path string) (map[string]map[string]string, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var state tfStateFile
+	if err := json.Unmarshal(content, &state); err != nil {
+		return nil, fmt.Errorf("failed to parse state file %s: %w", path, err)
+	}
+
+	labels := make(map[string]map[string]string)
+	for _, resource := range state.Resources {
+		// Resources in modules or created with count or for_each cannot be moved by the exporter
+		if resource.Mode != "managed" || resource.Module != "" || len(resource.Instances) != 1 || resource.Instances[0].IndexKey != nil {
+			continue
+		}
+		id, _ := resource.Instances[0].Attributes["id"].(string)
+		if id == "" {
+			continue
+		}
+		if labels[resource.Type] == nil {
+			labels[resource.Type] = make(map[string]string)
+		}
+		labels[resource.Type][id] = resource.Name
+	}
+	return labels, nil
+}
+
+// buildMovedBlocks returns a moved block for every exported resource whose label differs from the previous one.
+// A resource is not moved if its previous address is now used by another resource, or if its new address was used by
+// another resource in the previous export. Terraform would otherwise reject the moved block or chain it with the move
+// of the other resource, e.g. when two resources swap labels.
+func buildMovedBlocks(previousLabels map[string]map[string]string, exported []exportedAddress) (moves []movedBlock, skipped []movedBlock) {
+	currentAddresses := make(map[string]string, len(exported))
+	for _, resource := range exported {
+		currentAddresses[resource.ResourceType+"."+resource.Label] = resource.ID
+	}
+	previousAddresses := make(map[string]string)
+	for resourceType, labels := range previousLabels {
+		for id, label := range labels {
+			previousAddresses[resourceType+"."+label] = id
+		}
+	}
+
+	for _, resource := range exported {
+		previousLabel := previousLabels[resource.ResourceType][resource.ID]
+		if previousLabel == "" || previousLabel == resource.Label {
+			continue
+		}
+		move := movedBlock{ResourceType: resource.ResourceType, From: previousLabel, To: resource.Label}
+		if owner, used := currentAddresses[resource.ResourceType+"."+previousLabel]; used && owner != resource.ID {
+			skipped = append(skipped, move)
+			continue
+		}
+		if owner, used := previousAddresses[resource.ResourceType+"."+resource.Label]; used && owner != resource.ID {
+			skipped = append(skipped, move)
+			continue
+		}
+		moves = append(moves, move)
+	}
+
+	sortMoves := func(moves []movedBlock) {
+		sort.Slice(moves, func(i, j int) bool {
+			if moves[i].ResourceType != moves[j].ResourceType {
+				return moves[i].ResourceType < moves[j].ResourceType
+			}
+			return moves[i].From < moves[j].From
+		})
+	}
+	sortMoves(moves)
+	sortMoves(skipped)
+	return moves, skipped
+}
+
+// movedBlocksToHCL renders moved blocks in HCL
+func movedBlocksToHCL(moves []movedBlock) []byte {
+	f := hclwrite.NewEmptyFile()
+	rootBody := f.Body()
+	for i, move := range moves {
+		if i > 0 {
+			rootBody.AppendNewline()
+		}
+		body := rootBody.AppendNewBlock("moved", nil).Body()
+		body.SetAttributeTraversal("from", hcl.Traversal{hcl.TraverseRoot{Name: move.ResourceType}, hcl.TraverseAttr{Name: move.From}})
+		body.SetAttributeTraversal("to", hcl.Traversal{hcl.TraverseRoot{Name: move.ResourceType}, hcl.TraverseAttr{Name: move.To}})
+	}
+	return f.Bytes()
+}
+
+// movedBlocksToJSON renders moved blocks in the JSON configuration syntax
+func movedBlocksToJSON(moves []movedBlock) util.JsonMap {
+	blocks := make([]interface{}, 0, len(moves))
+	for _, move := range moves {
+		blocks = append(blocks, map[string]interface{}{
+			"from": move.ResourceType + "." + move.From,
+			"to":   move.ResourceType + "." + move.To,
+		})
+	}
+	return util.JsonMap{"moved": blocks}
+}
+
+// loadPreviousLabels reads the labels of the previous export set in previous_export_path, if any. It runs before
+// anything is written to the export directory, which may be the directory of the previous export.
+func (g *GenesysCloudResourceExporter) loadPreviousLabels() diag.Diagnostics {
+	path, _ := g.d.Get("previous_export_path").(string)
+	if path == "" {
+		return nil
+	}
+	previousLabels, err := readPreviousLabels(path)
+	if err != nil {
+		return diag.Errorf("Failed to read the labels of the previous export from %s: %v", path, err)
+	}
+	g.previousLabels = previousLabels
+	return nil
+}
+
+// writeMovedBlocks writes a moved block for every resource whose label changed since the previous export
+func (g *GenesysCloudResourceExporter) writeMovedBlocks() diag.Diagnostics {
+	if g.previousLabels == nil {
+		return nil
+	}
+
+	var exported []exportedAddress
+	for _, resource := range g.getResources() {
+		if resource.State == nil || g.isDataSource(resource.Type, resource.BlockLabel, resource.OriginalLabel) {
+			continue
+		}
+		exported = append(exported, exportedAddress{ResourceType: resource.Type, Label: resource.BlockLabel, ID: resource.State.ID})
+	}
+
+	moves, skipped := buildMovedBlocks(g.previousLabels, exported)
+	for _, move := range skipped {
+		tflog.Warn(g.ctx, fmt.Sprintf("Not moving %s.%s to %s.%s as one of the addresses belongs to another resource in the previous or current export", move.ResourceType, move.From, move.ResourceType, move.To))
+	}
+	if len(moves) == 0 {
+		tflog.Info(g.ctx, "No block labels changed since the previous export")
+		return nil
+	}
+	tflog.Info(g.ctx, fmt.Sprintf("Writing %d moved blocks for block labels that changed since the previous export", len(moves)))
+
+	var diags diag.Diagnostics
+	if g.matchesExportFormat(formatHCL, formatJSONHCL) {
+		diags = append(diags, writeHCLToFile([][]byte{movedBlocksToHCL(moves)}, filepath.Join(g.exportDirPath, movedHCLFile))...)
+	}
+	if g.matchesExportFormat(formatJSON, formatJSONHCL) {
+		diags = append(diags, writeConfig(movedBlocksToJSON(moves), filepath.Join(g.exportDirPath, movedJSONFile))...)
+	}
+	return diags
+}
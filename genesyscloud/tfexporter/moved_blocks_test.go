@@ -0,0 +1,94 @@
+package tfexporter
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testPreviousState = `{
+  "version": 4,
+  "resources": [
+    {"mode": "managed", "type": "genesyscloud_routing_queue", "name": "Support", "instances": [{"attributes": {"id": "queue-1"}}]},
+    {"mode": "managed", "type": "genesyscloud_routing_queue", "name": "Sales", "instances": [{"attributes": {"id": "queue-2"}}]},
+    {"mode": "data", "type": "genesyscloud_auth_division", "name": "home", "instances": [{"attributes": {"id": "division-1"}}]},
+    {"mode": "managed", "type": "genesyscloud_user", "name": "users", "instances": [{"index_key": 0, "attributes": {"id": "user-1"}}]}
+  ]
+}`
+
+// TestUnitReadPreviousLabels asserts that labels are read from the state file and the label lock file of an export
+// directory, and that data sources and resources created with count are ignored
+func TestUnitReadPreviousLabels(t *testing.T) {
+	exportDir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(exportDir, defaultTfStateFile), []byte(testPreviousState), 0644))
+	require.NoError(t, os.WriteFile(filepath.Join(exportDir, labelLockFileName), []byte(`{
+  "version": 1,
+  "labels": {"genesyscloud_routing_queue": {"queue-1": "Old_Support", "queue-3": "Billing"}}
+}`), 0644))
+
+	labels, err := readPreviousLabels(exportDir)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]map[string]string{
+		"genesyscloud_routing_queue": {"queue-1": "Support", "queue-2": "Sales", "queue-3": "Billing"},
+	}, labels)
+
+	labels, err = readPreviousLabels(filepath.Join(exportDir, defaultTfStateFile))
+	require.NoError(t, err)
+	assert.Equal(t, map[string]map[string]string{
+		"genesyscloud_routing_queue": {"queue-1": "Support", "queue-2": "Sales"},
+	}, labels)
+
+	_, err = readPreviousLabels(filepath.Join(exportDir, "missing"))
+	assert.Error(t, err)
+}
+
+// TestUnitBuildMovedBlocks asserts that only relabeled resources are moved, and that a move is skipped when one of
+// its addresses belongs to another resource
+func TestUnitBuildMovedBlocks(t *testing.T) {
+	previousLabels := map[string]map[string]string{
+		"genesyscloud_routing_queue": {"queue-1": "Support", "queue-2": "Sales", "queue-3": "Billing", "queue-4": "Tier_1", "queue-5": "Tier_2"},
+	}
+	moves, skipped := buildMovedBlocks(previousLabels, []exportedAddress{
+		{ResourceType: "genesyscloud_routing_queue", Label: "Customer_Support", ID: "queue-1"},
+		{ResourceType: "genesyscloud_routing_queue", Label: "Sales", ID: "queue-2"},
+		{ResourceType: "genesyscloud_routing_queue", Label: "Invoicing", ID: "queue-3"},
+		{ResourceType: "genesyscloud_routing_queue", Label: "Tier_2", ID: "queue-4"},
+		{ResourceType: "genesyscloud_routing_queue", Label: "Tier_1", ID: "queue-5"},
+		{ResourceType: "genesyscloud_routing_queue", Label: "New", ID: "queue-6"},
+	})
+
+	assert.Equal(t, []movedBlock{
+		{ResourceType: "genesyscloud_routing_queue", From: "Billing", To: "Invoicing"},
+		{ResourceType: "genesyscloud_routing_queue", From: "Support", To: "Customer_Support"},
+	}, moves)
+	assert.Equal(t, []movedBlock{
+		{ResourceType: "genesyscloud_routing_queue", From: "Tier_1", To: "Tier_2"},
+		{ResourceType: "genesyscloud_routing_queue", From: "Tier_2", To: "Tier_1"},
+	}, skipped)
+}
+
+// TestUnitMovedBlocksToHCL asserts the HCL and JSON syntax of moved blocks
+func TestUnitMovedBlocksToHCL(t *testing.T) {
+	moves := []movedBlock{
+		{ResourceType: "genesyscloud_routing_queue", From: "Support", To: "Customer_Support"},
+		{ResourceType: "genesyscloud_user", From: "_1_Jane", To: "Jane"},
+	}
+
+	assert.Equal(t, `moved {
+  from = genesyscloud_routing_queue.Support
+  to   = genesyscloud_routing_queue.Customer_Support
+}
+
+moved {
+  from = genesyscloud_user._1_Jane
+  to   = genesyscloud_user.Jane
+}
+`, string(movedBlocksToHCL(moves)))
+
+	jsonMoves := movedBlocksToJSON(moves)["moved"].([]interface{})
+	require.Len(t, jsonMoves, 2)
+	assert.Equal(t, "genesyscloud_user._1_Jane", jsonMoves[1].(map[string]interface{})["from"])
+}
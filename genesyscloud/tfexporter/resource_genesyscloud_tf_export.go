@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"strings"
 
 	"github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/validators"
 
@@ -112,6 +113,33 @@ func ResourceTfExport() *schema.Resource {
 				Default:     false,
 				ForceNew:    true,
 			},
+			"git_commit": {
+				Description:  fmt.Sprintf("Commit the exported files to the git repository of the export directory, which is initialized if the directory is in none. With '%s', the export is committed at once. With '%s', each resource type is committed on its own, followed by the provider, variables and other files, which needs split_files_by_resource or stream_output. The message of each commit lists the resources created, changed and removed since the last commit.", gitCommitPerRun, gitCommitPerResourceType),
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{gitCommitPerRun, gitCommitPerResourceType}, false),
+			},
+			"git_author_name": {
+				Description: "Name of the author of the commits made with `git_commit`. Defaults to the user of the git config.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+			},
+			"git_author_email": {
+				Description:  "Email of the author of the commits made with `git_commit`, used with `git_author_name`.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				RequiredWith: []string{"git_author_name"},
+			},
+			"include_export_metrics": {
+				Description: fmt.Sprintf("Write '%s' with the number of instances, outcome and read time of each exported resource type, and the number of threads used to read them. The file is kept when the export is destroyed, and the next export to the same directory reads the types that took the longest first.", exportMetricsFileName),
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				ForceNew:    true,
+			},
 			"export_as_hcl": {
 				Description:   "Export the config as HCL. Deprecated. Please use the export_format attribute instead",
 				Type:          schema.TypeBool,
@@ -121,7 +149,7 @@ func ResourceTfExport() *schema.Resource {
 				ConflictsWith: []string{"export_format"},
 			},
 			"export_format": {
-				Description: "Export the config as hcl or json or json_hcl.",
+				Description: fmt.Sprintf("Export the config as hcl or json or json_hcl. Add the %s suffix to any of them to also write the inventory database '%s', a SQLite file with a table of the flattened attributes and labels of each exported resource type and an '%s' table of the references between the exported resources.", formatSQLiteSuffix, inventoryFileName, inventoryEdgesTable),
 				Type:        schema.TypeString,
 				Optional:    true,
 				Default:     "json",
@@ -131,8 +159,22 @@ func ResourceTfExport() *schema.Resource {
 					"json",
 					"json_hcl",
 					"hcl_json",
+					"hcl_sqlite",
+					"json_sqlite",
+					"json_hcl_sqlite",
+					"hcl_json_sqlite",
 				}, true), // true enables case-insensitive matching
 			},
+			"dependency_graph_formats": {
+				Description: fmt.Sprintf("Also write the dependency graph of the exported resources in each of these formats: %s. Every exported resource and data source is a node, and every reference or depends_on entry between them is an edge. References that are part of a cycle are highlighted, including flow cycles whose depends_on entries ignore_cyclic_deps left out. The graph is written to '%s' with the extension of the format.", strings.Join(dependencyGraphFormats, ", "), dependencyGraphFileName),
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice(dependencyGraphFormats, true),
+				},
+				ForceNew: true,
+			},
 			"split_files_by_resource": {
 				Description: "Split export files by resource type. This will also split the terraform provider and variable declarations into their own files.",
 				Type:        schema.TypeBool,
@@ -140,6 +182,13 @@ func ResourceTfExport() *schema.Resource {
 				Default:     false,
 				ForceNew:    true,
 			},
+			"stream_output": {
+				Description: "Read, convert and write one resource type at a time, keeping only the IDs and labels of the exported resources in memory, to cap the memory used by very large exports. Implies split_files_by_resource. Cannot be used with include_state_file, enable_dependency_resolution, dependency_graph_formats, label_templates, use_label_lock_file or the _sqlite export formats.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				ForceNew:    true,
+			},
 			"log_permission_errors": {
 				Description: "Log permission/product issues rather than fail.",
 				Type:        schema.TypeBool,
@@ -175,6 +224,13 @@ func ResourceTfExport() *schema.Resource {
 				Default:     false,
 				ForceNew:    true,
 			},
+			"export_datatable_rows_as_csv": {
+				Description: "Export the rows of each exported `genesyscloud_architect_datatable` to a CSV file referenced by its `rows_filepath`, instead of one `genesyscloud_architect_datatable_row` resource per row. The `genesyscloud_architect_datatable_row` resources are then not exported, as they would manage the same rows.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				ForceNew:    true,
+			},
 			"export_computed": {
 				Description: "Export attributes that are marked as being Computed and Optional. Does not attempt to export attributes that are explicitly marked as read-only by the provider. Defaults to true to match existing functionality. This attribute's default value will likely switch to false in a future release.",
 				Default:     true,
@@ -190,7 +246,7 @@ func ResourceTfExport() *schema.Resource {
 				ForceNew:    true,
 			},
 			"max_concurrent_threads": {
-				Description: "Maximum number of concurrent threads to use during export process. This is distinct from the provider's token pool size configuration",
+				Description: "Maximum number of concurrent threads to use during export process. The instances of all the resource types are read by one pool of this many threads, capped at the provider's token pool size so that no thread waits for a token.",
 				Default:     10,
 				Type:        schema.TypeInt,
 				Optional:    true,
@@ -203,6 +259,51 @@ func ResourceTfExport() *schema.Resource {
 				Default:     true,
 				ForceNew:    true,
 			},
+			"label_templates": {
+				Description:  "Go templates used to build the block labels of exported resources, keyed by resource type, e.g. `{ genesyscloud_routing_queue = \"{{.name}}_{{.division_name}}\" }`. Templates can use the top level attributes of the resource and its `id`. For each reference attribute ending in `_id`, the name of the referenced object is available under the same key ending in `_name`. Resources recorded in the label lock file keep their label. Templates also apply to resources added by `enable_dependency_resolution`. See export guide for additional information.",
+				Type:         schema.TypeMap,
+				Optional:     true,
+				Elem:         &schema.Schema{Type: schema.TypeString},
+				ValidateFunc: validateLabelTemplates,
+				ForceNew:     true,
+			},
+			"use_label_lock_file": {
+				Description: fmt.Sprintf("Reuse the block labels recorded in the '%s' file of the export directory for the resources it lists, and record the labels of this export in it, including those of resources added by `enable_dependency_resolution`. The file is kept when the export is destroyed so that re-exports into the same directory keep their labels.", labelLockFileName),
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				ForceNew:    true,
+			},
+			"previous_export_path": {
+				Description: fmt.Sprintf("Path to the '%s' file of a previous export, or to a previous export directory holding a '%s' or '%s' file. Resources that are exported again under a different block label get a moved block from their previous address, written to '%s' or '%s', so that Terraform moves them instead of destroying and recreating them.", defaultTfStateFile, defaultTfStateFile, labelLockFileName, movedHCLFile, movedJSONFile),
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+			},
+			"secret_store": {
+				Description: fmt.Sprintf("Read sensitive and unresolvable attributes from a secret store instead of variables with placeholder values in '%s'. With '%s', each attribute is read from a Vault KV version 2 secret per resource. With '%s', each attribute is a sensitive variable to be set through its TF_VAR_ environment variable, without a default when the exported state holds a value for it. Sensitive attributes that are not set in the exported state are left out. The secrets to populate are listed in '%s'.", defaultTfVarsFile, secretStoreVault, secretStoreEnv, secretsManifestFile),
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				ValidateFunc: validation.StringInSlice([]string{
+					secretStoreVault,
+					secretStoreEnv,
+				}, false),
+			},
+			"secret_store_vault_mount": {
+				Description: "Mount of the Vault KV version 2 secrets engine holding the secrets when `secret_store` is 'vault'.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "secret",
+				ForceNew:    true,
+			},
+			"secret_store_vault_path_prefix": {
+				Description: "Path prefix of the Vault secrets when `secret_store` is 'vault'. The secrets of each resource are read from '<prefix>/<resource type>/<block label>'.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "genesyscloud",
+				ForceNew:    true,
+			},
 			"export_omit_unresolved_refs": {
 				Description: "Omit optional reference attributes that could not be resolved to Terraform references during export. When disabled, unresolved references are left as raw GUIDs. Defaults to false to match existing functionality. This attribute's default value will likely switch to true in a future release.",
 				Type:        schema.TypeBool,
@@ -278,7 +379,7 @@ func readTfExport(_ context.Context, d *schema.ResourceData, _ interface{}) diag
 }
 
 // Delete everything (files and subdirectories) inside the export directory
-// not including the directory itself
+// not including the directory itself and the files that outlive the export
 func deleteTfExport(_ context.Context, d *schema.ResourceData, _ interface{}) diag.Diagnostics {
 	exportPath := d.Id()
 	dir, err := os.ReadDir(exportPath)
@@ -286,6 +387,9 @@ func deleteTfExport(_ context.Context, d *schema.ResourceData, _ interface{}) di
 		return diag.FromErr(err)
 	}
 	for _, d := range dir {
+		if isPreservedExportFile(d.Name()) {
+			continue
+		}
 		os.RemoveAll(filepath.Join(exportPath, d.Name()))
 	}
 
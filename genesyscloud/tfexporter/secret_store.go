@@ -0,0 +1,340 @@
+package tfexporter
+
+import (
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	resourceExporter "github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/resource_exporter"
+	"github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/util"
+	"github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/util/files"
+
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	zclconfCty "github.com/zclconf/go-cty/cty"
+)
+
+/*
+The secret_store.go file renders sensitive and unresolvable attributes as lookups against a secret store instead of
+variables with placeholder values in a tfvars file. With the "vault" store, each attribute is read from a Vault KV
+version 2 secret per resource. With the "env" store, each attribute is a sensitive variable that Terraform reads from
+its TF_VAR_ environment variable, without a default when it replaces a value of the exported state. Sensitive attributes
+that are not set in the exported state are left out. In both cases a manifest lists the secrets that must be populated
+before the export is applied.
+*/
+
+const (
+	secretStoreVault = "vault"
+	secretStoreEnv   = "env"
+
+	secretsHCLFile      = "secrets.tf"
+	secretsJSONFile     = "secrets.tf.json"
+	secretsManifestFile = "secrets_manifest.json"
+
+	vaultKVDataSourceType = "vault_kv_secret_v2"
+	vaultProviderSource   = "hashicorp/vault"
+)
+
+// secretStore is the secret backend that sensitive and unresolvable attributes are read from
+type secretStore struct {
+	Type            string
+	VaultMount      string
+	VaultPathPrefix string
+}
+
+// secretsManifest lists the secrets that must be populated before an export is applied
+type secretsManifest struct {
+	SecretStore string                 `json:"secret_store"`
+	Secrets     []secretsManifestEntry `json:"secrets"`
+}
+
+type secretsManifestEntry struct {
+	ResourceType        string   `json:"resource_type"`
+	ResourceLabel       string   `json:"resource_label"`
+	Attribute           string   `json:"attribute"`
+	Type                string   `json:"type"`
+	Properties          []string `json:"properties,omitempty"`
+	EnvironmentVariable string   `json:"environment_variable,omitempty"`
+	VaultMount          string   `json:"vault_mount,omitempty"`
+	VaultPath           string   `json:"vault_path,omitempty"`
+	VaultKey            string   `json:"vault_key,omitempty"`
+}
+
+// reference returns the expression that reads an attribute, or one property of an object attribute, from the secret
+// store. A nil secret store reads it from a variable.
+func (s *secretStore) reference(attr unresolvableAttributeInfo, property string) string {
+	var expression string
+	if s != nil && s.Type == secretStoreVault {
+		expression = fmt.Sprintf("jsondecode(data.%s.%s.data_json).%s", vaultKVDataSourceType, secretDataSourceLabel(attr), attr.Name)
+	} else {
+		expression = "var." + createUnresolvedAttrKey(attr)
+	}
+	if property != "" {
+		expression += "." + property
+	}
+	return "${" + expression + "}"
+}
+
+// vaultPath returns the path of the Vault secret holding the secret attributes of a resource
+func (s *secretStore) vaultPath(resourceType string, resourceLabel string) string {
+	prefix := strings.Trim(s.VaultPathPrefix, "/")
+	if prefix == "" {
+		return resourceType + "/" + resourceLabel
+	}
+	return prefix + "/" + resourceType + "/" + resourceLabel
+}
+
+// secretDataSourceLabel returns the label of the Vault data source that reads the secrets of a resource
+func secretDataSourceLabel(attr unresolvableAttributeInfo) string {
+	return attr.ResourceType + "_" + attr.ResourceLabel
+}
+
+// secretAttributeValue returns the config value of an attribute read from the secret store. Object attributes are read
+// property by property.
+func (s *secretStore) secretAttributeValue(attr unresolvableAttributeInfo) interface{} {
+	if properties, ok := attr.Schema.Elem.(*schema.Resource); ok {
+		propertiesMap := make(map[string]interface{})
+		for k := range properties.Schema {
+			propertiesMap[k] = s.reference(attr, k)
+		}
+		return propertiesMap
+	}
+	return s.reference(attr, "")
+}
+
+// replaceSensitiveAttributes replaces the sensitive top level attributes of a resource config with secret store lookups.
+// Only attributes that are set in the exported state are replaced, so that an unset optional secret such as a user
+// password does not become a variable that must be populated. Attributes that are unresolvable for the resource exporter
+// are left to the sanitizer.
+func (s *secretStore) replaceSensitiveAttributes(resource resourceExporter.ResourceInfo, resourceSchema map[string]*schema.Schema, unresolvable map[string]*schema.Schema, configMap util.JsonMap) []unresolvableAttributeInfo {
+	var attrs []unresolvableAttributeInfo
+	for name, attrSchema := range resourceSchema {
+		if !attrSchema.Sensitive || !(attrSchema.Required || attrSchema.Optional) {
+			continue
+		}
+		if _, ok := unresolvable[name]; ok {
+			continue
+		}
+		if !isAttributeSetInState(resource.State, name) {
+			continue
+		}
+		attr := unresolvableAttributeInfo{
+			ResourceType:  resource.Type,
+			ResourceLabel: resource.BlockLabel,
+			Name:          name,
+			Schema:        attrSchema,
+			HasValue:      true,
+		}
+		configMap[name] = s.secretAttributeValue(attr)
+		attrs = append(attrs, attr)
+	}
+	return attrs
+}
+
+// isAttributeSetInState returns true if a top level attribute holds a value in the flatmap of an instance state
+func isAttributeSetInState(state *terraform.InstanceState, name string) bool {
+	if state == nil {
+		return false
+	}
+	if value := state.Attributes[name]; value != "" {
+		return true
+	}
+	for _, countKey := range []string{name + ".#", name + ".%"} {
+		if count := state.Attributes[countKey]; count != "" && count != "0" {
+			return true
+		}
+	}
+	return false
+}
+
+// uniqueSecretAttrs sorts secret attributes by address and drops duplicates
+func uniqueSecretAttrs(attrs []unresolvableAttributeInfo) []unresolvableAttributeInfo {
+	unique := make(map[string]unresolvableAttributeInfo, len(attrs))
+	keys := make([]string, 0, len(attrs))
+	for _, attr := range attrs {
+		key := createUnresolvedAttrKey(attr)
+		if _, ok := unique[key]; ok {
+			continue
+		}
+		unique[key] = attr
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	sorted := make([]unresolvableAttributeInfo, 0, len(keys))
+	for _, key := range keys {
+		sorted = append(sorted, unique[key])
+	}
+	return sorted
+}
+
+// variableAttrs returns the attributes that the config exporters write variables for. Variables read from the
+// environment are always sensitive. Those that replace a value of the exported state have no default, so Terraform
+// fails when one is not set, while the others keep the default of their schema. No variables are needed when secrets
+// are read from Vault.
+func (s *secretStore) variableAttrs(attrs []unresolvableAttributeInfo) []unresolvableAttributeInfo {
+	if s == nil {
+		return attrs
+	}
+	if s.Type == secretStoreVault {
+		return nil
+	}
+	variables := make([]unresolvableAttributeInfo, 0, len(attrs))
+	for _, attr := range attrs {
+		sensitiveSchema := *attr.Schema
+		sensitiveSchema.Sensitive = true
+		if attr.HasValue {
+			sensitiveSchema.Default = nil
+		}
+		attr.Schema = &sensitiveSchema
+		variables = append(variables, attr)
+	}
+	return variables
+}
+
+// buildSecretsManifest lists where each secret attribute must be populated
+func (s *secretStore) buildSecretsManifest(attrs []unresolvableAttributeInfo) secretsManifest {
+	manifest := secretsManifest{SecretStore: s.Type, Secrets: make([]secretsManifestEntry, 0, len(attrs))}
+	for _, attr := range uniqueSecretAttrs(attrs) {
+		entry := secretsManifestEntry{
+			ResourceType:  attr.ResourceType,
+			ResourceLabel: attr.ResourceLabel,
+			Attribute:     attr.Name,
+			Type:          determineVarType(attr.Schema),
+		}
+		if properties, ok := attr.Schema.Elem.(*schema.Resource); ok {
+			entry.Type = "object"
+			for k := range properties.Schema {
+				entry.Properties = append(entry.Properties, k)
+			}
+			sort.Strings(entry.Properties)
+		}
+		if s.Type == secretStoreVault {
+			entry.VaultMount = s.VaultMount
+			entry.VaultPath = s.vaultPath(attr.ResourceType, attr.ResourceLabel)
+			entry.VaultKey = attr.Name
+		} else {
+			entry.EnvironmentVariable = "TF_VAR_" + createUnresolvedAttrKey(attr)
+		}
+		manifest.Secrets = append(manifest.Secrets, entry)
+	}
+	return manifest
+}
+
+// vaultDataSources returns the Vault data source config of each resource with secret attributes by label
+func (s *secretStore) vaultDataSources(attrs []unresolvableAttributeInfo) map[string]util.JsonMap {
+	dataSources := make(map[string]util.JsonMap)
+	for _, attr := range attrs {
+		dataSources[secretDataSourceLabel(attr)] = util.JsonMap{
+			"mount": s.VaultMount,
+			"name":  s.vaultPath(attr.ResourceType, attr.ResourceLabel),
+		}
+	}
+	return dataSources
+}
+
+// secretsToHCL renders the Vault provider requirement and data sources in HCL
+func (s *secretStore) secretsToHCL(attrs []unresolvableAttributeInfo) []byte {
+	f := hclwrite.NewEmptyFile()
+	rootBody := f.Body()
+	requiredProviders := rootBody.AppendNewBlock("terraform", nil).Body().AppendNewBlock("required_providers", nil)
+	requiredProviders.Body().SetAttributeValue("vault", zclconfCty.ObjectVal(map[string]zclconfCty.Value{
+		"source": zclconfCty.StringVal(vaultProviderSource),
+	}))
+
+	dataSources := s.vaultDataSources(attrs)
+	labels := make([]string, 0, len(dataSources))
+	for label := range dataSources {
+		labels = append(labels, label)
+	}
+	sort.Strings(labels)
+	for _, label := range labels {
+		rootBody.AppendNewline()
+		body := rootBody.AppendNewBlock("data", []string{vaultKVDataSourceType, label}).Body()
+		body.SetAttributeValue("mount", zclconfCty.StringVal(dataSources[label]["mount"].(string)))
+		body.SetAttributeValue("name", zclconfCty.StringVal(dataSources[label]["name"].(string)))
+	}
+	return f.Bytes()
+}
+
+// secretsToJSON renders the Vault provider requirement and data sources in the JSON configuration syntax
+func (s *secretStore) secretsToJSON(attrs []unresolvableAttributeInfo) util.JsonMap {
+	dataSources := make(map[string]interface{})
+	for label, dataSource := range s.vaultDataSources(attrs) {
+		dataSources[label] = map[string]interface{}(dataSource)
+	}
+	return util.JsonMap{
+		"terraform": map[string]interface{}{
+			"required_providers": map[string]interface{}{
+				"vault": map[string]interface{}{"source": vaultProviderSource},
+			},
+		},
+		"data": map[string]interface{}{
+			vaultKVDataSourceType: dataSources,
+		},
+	}
+}
+
+// setupSecretStore reads the secret store settings of the export, if any
+func (g *GenesysCloudResourceExporter) setupSecretStore() {
+	storeType, _ := g.d.Get("secret_store").(string)
+	if storeType == "" {
+		return
+	}
+	g.secretStore = &secretStore{Type: storeType}
+	g.secretStore.VaultMount, _ = g.d.Get("secret_store_vault_mount").(string)
+	g.secretStore.VaultPathPrefix, _ = g.d.Get("secret_store_vault_path_prefix").(string)
+}
+
+// addSensitiveSecretAttrs replaces the sensitive attributes of a resource config with secret store lookups when a
+// secret store is set. Without one, sensitive attributes are exported as they are read.
+func (g *GenesysCloudResourceExporter) addSensitiveSecretAttrs(resource resourceExporter.ResourceInfo, configMap util.JsonMap) {
+	if g.secretStore == nil || g.provider == nil {
+		return
+	}
+	resSchema := g.provider.ResourcesMap[resource.Type]
+	if resSchema == nil {
+		return
+	}
+	// Thread-safe read of the resource exporter
+	var unresolvable map[string]*schema.Schema
+	g.exportersMutex.RLock()
+	if exporter := (*g.exporters)[resource.Type]; exporter != nil {
+		unresolvable = exporter.UnResolvableAttributes
+	}
+	g.exportersMutex.RUnlock()
+	if attrs := g.secretStore.replaceSensitiveAttributes(resource, resSchema.Schema, unresolvable, configMap); len(attrs) > 0 {
+		g.addUnresolvedAttrs(attrs)
+	}
+}
+
+// writeSecretStoreFiles writes the secrets manifest and, for Vault, the data sources that read the secrets
+func (g *GenesysCloudResourceExporter) writeSecretStoreFiles() diag.Diagnostics {
+	if g.secretStore == nil {
+		return nil
+	}
+	attrs := uniqueSecretAttrs(g.getUnresolvedAttrs())
+	tflog.Info(g.ctx, fmt.Sprintf("Writing %d secrets to populate in %s to %s", len(attrs), g.secretStore.Type, secretsManifestFile))
+
+	manifest, err := json.MarshalIndent(g.secretStore.buildSecretsManifest(attrs), "", "  ")
+	if err != nil {
+		return diag.Errorf("Failed to encode the secrets manifest: %v", err)
+	}
+	diags := files.WriteToFile(manifest, filepath.Join(g.exportDirPath, secretsManifestFile))
+	if diags.HasError() || g.secretStore.Type != secretStoreVault || len(attrs) == 0 {
+		return diags
+	}
+
+	if g.matchesExportFormat(formatHCL, formatJSONHCL) {
+		diags = append(diags, writeHCLToFile([][]byte{g.secretStore.secretsToHCL(attrs)}, filepath.Join(g.exportDirPath, secretsHCLFile))...)
+	}
+	if g.matchesExportFormat(formatJSON, formatJSONHCL) {
+		diags = append(diags, writeConfig(g.secretStore.secretsToJSON(attrs), filepath.Join(g.exportDirPath, secretsJSONFile))...)
+	}
+	return diags
+}
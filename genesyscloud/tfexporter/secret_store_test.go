@@ -0,0 +1,155 @@
+package tfexporter
+
+import (
+	"testing"
+
+	resourceExporter "github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/resource_exporter"
+	"github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/util"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	testSecretFieldsSchema = &schema.Schema{Type: schema.TypeMap, Optional: true, Elem: &schema.Schema{Type: schema.TypeString}}
+	testSecretTokenSchema  = &schema.Schema{Type: schema.TypeList, Optional: true, Elem: &schema.Resource{Schema: map[string]*schema.Schema{
+		"key":    {Type: schema.TypeString, Optional: true},
+		"secret": {Type: schema.TypeString, Optional: true},
+	}}}
+)
+
+func testSecretAttrs() []unresolvableAttributeInfo {
+	return []unresolvableAttributeInfo{
+		{ResourceType: "genesyscloud_integration_credential", ResourceLabel: "Zendesk", Name: "fields", Schema: testSecretFieldsSchema},
+		{ResourceType: "genesyscloud_integration_credential", ResourceLabel: "Zendesk", Name: "fields", Schema: testSecretFieldsSchema},
+		{ResourceType: "genesyscloud_idp_generic", ResourceLabel: "Okta", Name: "token", Schema: testSecretTokenSchema},
+	}
+}
+
+// TestUnitSecretStoreReference asserts the expressions used to read secret attributes from each secret store
+func TestUnitSecretStoreReference(t *testing.T) {
+	attr := testSecretAttrs()[0]
+	var noStore *secretStore
+	envStore := &secretStore{Type: secretStoreEnv}
+	vaultStore := &secretStore{Type: secretStoreVault, VaultMount: "secret", VaultPathPrefix: "/genesyscloud/prod/"}
+
+	assert.Equal(t, "${var.genesyscloud_integration_credential_Zendesk_fields}", noStore.secretAttributeValue(attr))
+	assert.Equal(t, "${var.genesyscloud_integration_credential_Zendesk_fields}", envStore.secretAttributeValue(attr))
+	assert.Equal(t, "${jsondecode(data.vault_kv_secret_v2.genesyscloud_integration_credential_Zendesk.data_json).fields}", vaultStore.secretAttributeValue(attr))
+	assert.Equal(t, map[string]interface{}{
+		"key":    "${jsondecode(data.vault_kv_secret_v2.genesyscloud_idp_generic_Okta.data_json).token.key}",
+		"secret": "${jsondecode(data.vault_kv_secret_v2.genesyscloud_idp_generic_Okta.data_json).token.secret}",
+	}, vaultStore.secretAttributeValue(testSecretAttrs()[2]))
+	assert.Equal(t, "genesyscloud/prod/genesyscloud_idp_generic/Okta", vaultStore.vaultPath("genesyscloud_idp_generic", "Okta"))
+
+	assert.Len(t, noStore.variableAttrs(testSecretAttrs()), 3)
+	assert.Empty(t, vaultStore.variableAttrs(testSecretAttrs()))
+	variables := envStore.variableAttrs(testSecretAttrs())
+	require.Len(t, variables, 3)
+	assert.True(t, variables[0].Schema.Sensitive)
+	assert.False(t, testSecretFieldsSchema.Sensitive, "the schema of the resource must not be changed")
+}
+
+// TestUnitReplaceSensitiveAttributes asserts that the sensitive attributes set in the exported state are read from the
+// secret store, that unset ones are left out, and that unresolvable attributes are left to the sanitizer
+func TestUnitReplaceSensitiveAttributes(t *testing.T) {
+	store := &secretStore{Type: secretStoreEnv}
+	resource := resourceExporter.ResourceInfo{
+		State: &terraform.InstanceState{ID: "idp-1", Attributes: map[string]string{
+			"name":         "Okta",
+			"certificate":  "-----BEGIN CERTIFICATE-----",
+			"password":     "",
+			"client_token": "abc",
+			"fields.%":     "1",
+		}},
+		Type:       "genesyscloud_idp_generic",
+		BlockLabel: "Okta",
+	}
+	resourceSchema := map[string]*schema.Schema{
+		"name":         {Type: schema.TypeString, Required: true},
+		"certificate":  {Type: schema.TypeString, Required: true, Sensitive: true},
+		"password":     {Type: schema.TypeString, Optional: true, Sensitive: true},
+		"client_token": {Type: schema.TypeString, Optional: true, Sensitive: true},
+		"read_only":    {Type: schema.TypeString, Computed: true, Sensitive: true},
+		"fields":       {Type: schema.TypeMap, Optional: true, Sensitive: true},
+	}
+	configMap := util.JsonMap{"name": "Okta", "client_token": "abc", "fields": "${var.genesyscloud_idp_generic_Okta_fields}"}
+
+	attrs := store.replaceSensitiveAttributes(resource, resourceSchema, map[string]*schema.Schema{"fields": resourceSchema["fields"]}, configMap)
+
+	names := make([]string, 0, len(attrs))
+	for _, attr := range uniqueSecretAttrs(attrs) {
+		names = append(names, attr.Name)
+	}
+	assert.Equal(t, []string{"certificate", "client_token"}, names)
+	assert.Equal(t, util.JsonMap{
+		"name":         "Okta",
+		"certificate":  "${var.genesyscloud_idp_generic_Okta_certificate}",
+		"client_token": "${var.genesyscloud_idp_generic_Okta_client_token}",
+		"fields":       "${var.genesyscloud_idp_generic_Okta_fields}",
+	}, configMap)
+}
+
+// TestUnitSecretsManifestAndVaultConfig asserts the manifest entries of each secret store and the Vault data sources
+func TestUnitSecretsManifestAndVaultConfig(t *testing.T) {
+	envManifest := (&secretStore{Type: secretStoreEnv}).buildSecretsManifest(testSecretAttrs())
+	assert.Equal(t, secretStoreEnv, envManifest.SecretStore)
+	assert.Equal(t, []secretsManifestEntry{
+		{ResourceType: "genesyscloud_idp_generic", ResourceLabel: "Okta", Attribute: "token", Type: "object", Properties: []string{"key", "secret"}, EnvironmentVariable: "TF_VAR_genesyscloud_idp_generic_Okta_token"},
+		{ResourceType: "genesyscloud_integration_credential", ResourceLabel: "Zendesk", Attribute: "fields", Type: "map(string)", EnvironmentVariable: "TF_VAR_genesyscloud_integration_credential_Zendesk_fields"},
+	}, envManifest.Secrets)
+
+	vaultStore := &secretStore{Type: secretStoreVault, VaultMount: "kv", VaultPathPrefix: "genesyscloud"}
+	vaultManifest := vaultStore.buildSecretsManifest(testSecretAttrs())
+	require.Len(t, vaultManifest.Secrets, 2)
+	assert.Equal(t, "kv", vaultManifest.Secrets[1].VaultMount)
+	assert.Equal(t, "genesyscloud/genesyscloud_integration_credential/Zendesk", vaultManifest.Secrets[1].VaultPath)
+	assert.Equal(t, "fields", vaultManifest.Secrets[1].VaultKey)
+
+	assert.Equal(t, `terraform {
+  required_providers {
+    vault = {
+      source = "hashicorp/vault"
+    }
+  }
+}
+
+data "vault_kv_secret_v2" "genesyscloud_idp_generic_Okta" {
+  mount = "kv"
+  name  = "genesyscloud/genesyscloud_idp_generic/Okta"
+}
+
+data "vault_kv_secret_v2" "genesyscloud_integration_credential_Zendesk" {
+  mount = "kv"
+  name  = "genesyscloud/genesyscloud_integration_credential/Zendesk"
+}
+`, string(vaultStore.secretsToHCL(uniqueSecretAttrs(testSecretAttrs()))))
+
+	dataSources := vaultStore.secretsToJSON(testSecretAttrs())["data"].(map[string]interface{})[vaultKVDataSourceType].(map[string]interface{})
+	assert.Len(t, dataSources, 2)
+}
+
+// TestUnitEnvSecretVariablesDefaults asserts that the env secret store writes the variables that replace a value of the
+// exported state without the schema default, so Terraform fails when a TF_VAR_ environment variable is not set, and
+// that the other variables keep their default
+func TestUnitEnvSecretVariablesDefaults(t *testing.T) {
+	defaultedSchema := &schema.Schema{Type: schema.TypeString, Optional: true, Sensitive: true, Default: "placeholder"}
+	attrs := []unresolvableAttributeInfo{
+		{ResourceType: "genesyscloud_oauth_client", ResourceLabel: "Integration", Name: "client_secret", Schema: defaultedSchema, HasValue: true},
+		{ResourceType: "genesyscloud_oauth_client", ResourceLabel: "Unset", Name: "client_secret", Schema: defaultedSchema},
+	}
+
+	variables := (&secretStore{Type: secretStoreEnv}).variableAttrs(attrs)
+	require.Len(t, variables, 2)
+	assert.Nil(t, variables[0].Schema.Default)
+	assert.Equal(t, "placeholder", variables[1].Schema.Default)
+	assert.Equal(t, "placeholder", defaultedSchema.Default, "the schema of the resource must not be changed")
+
+	variablesBlock := string(createHCLVariablesBlock(variables[:1]))
+	assert.Contains(t, variablesBlock, `variable "genesyscloud_oauth_client_Integration_client_secret" {`)
+	assert.Contains(t, variablesBlock, "sensitive = true")
+	assert.NotContains(t, variablesBlock, "default")
+	assert.Contains(t, string(createHCLVariablesBlock(variables[1:])), `"placeholder"`, "variables of unset attributes keep the default")
+}
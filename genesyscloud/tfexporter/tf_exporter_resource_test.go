@@ -16,6 +16,7 @@ import (
 	userPrompt "github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/architect_user_prompt"
 	authDivision "github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/auth_division"
 	authRole "github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/auth_role"
+	authorizationPolicy "github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/authorization_policy"
 	businessRulesDecisionTable "github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/business_rules_decision_table"
 	businessRulesSchema "github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/business_rules_schema"
 	integrationInstagram "github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/conversations_messaging_integrations_instagram"
@@ -25,11 +26,16 @@ import (
 	supportedContent "github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/conversations_messaging_supportedcontent"
 	defaultSupportedContent "github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/conversations_messaging_supportedcontent_default"
 	employeeperformanceExternalmetricsDefinition "github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/employeeperformance_externalmetrics_definitions"
+	externalContactsContactSchema "github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/external_contacts_contact_schema"
 	externalOrganization "github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/external_contacts_organization"
+	externalOrganizationSchema "github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/external_contacts_organization_schema"
+	externalRelationship "github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/external_contacts_relationship"
 	externalUser "github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/external_user"
 	flowLogLevel "github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/flow_loglevel"
 	flowMilestone "github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/flow_milestone"
 	flowOutcome "github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/flow_outcome"
+	gamificationMetric "github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/gamification_metric"
+	gamificationProfile "github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/gamification_profile"
 	"github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/group"
 	groupRoles "github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/group_roles"
 	"github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/guide"
@@ -81,6 +87,7 @@ import (
 	qualityFormsEvaluation "github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/quality_forms_evaluation"
 	qualityFormsSurvey "github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/quality_forms_survey"
 	recMediaRetPolicy "github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/recording_media_retention_policy"
+	recordingSettings "github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/recording_settings"
 	resourceExporter "github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/resource_exporter"
 	respmanagementLibrary "github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/responsemanagement_library"
 	responsemanagementResponse "github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/responsemanagement_response"
@@ -88,6 +95,7 @@ import (
 	routingEmailDomain "github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/routing_email_domain"
 	routingEmailRoute "github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/routing_email_route"
 	routinglanguage "github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/routing_language"
+	routingPredictor "github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/routing_predictor"
 	routingQueue "github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/routing_queue"
 	routingQueueConditionalGroupRouting "github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/routing_queue_conditional_group_routing"
 	routingQueueOutboundEmailAddress "github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/routing_queue_outbound_email_address"
@@ -155,7 +163,10 @@ func (r *registerTestInstance) registerTestResources() {
 	providerResources[userPrompt.ResourceType] = userPrompt.ResourceArchitectUserPrompt()
 	providerResources[authRole.ResourceType] = authRole.ResourceAuthRole()
 	providerResources[authDivision.ResourceType] = authDivision.ResourceAuthDivision()
+	providerResources[authorizationPolicy.ResourceType] = authorizationPolicy.ResourceAuthorizationPolicy()
 	providerResources[employeeperformanceExternalmetricsDefinition.ResourceType] = employeeperformanceExternalmetricsDefinition.ResourceEmployeeperformanceExternalmetricsDefinition()
+	providerResources[gamificationProfile.ResourceType] = gamificationProfile.ResourceGamificationProfile()
+	providerResources[gamificationMetric.ResourceType] = gamificationMetric.ResourceGamificationMetric()
 	providerResources[flowLogLevel.ResourceType] = flowLogLevel.ResourceFlowLoglevel()
 	providerResources[group.ResourceType] = group.ResourceGroup()
 	providerResources[groupRoles.ResourceType] = groupRoles.ResourceGroupRoles()
@@ -191,10 +202,12 @@ func (r *registerTestInstance) registerTestResources() {
 	providerResources[routingEmailRoute.ResourceType] = routingEmailRoute.ResourceRoutingEmailRoute()
 	providerResources[routinglanguage.ResourceType] = routinglanguage.ResourceRoutingLanguage()
 	providerResources[routingQueue.ResourceType] = routingQueue.ResourceRoutingQueue()
+	providerResources[routingPredictor.ResourceType] = routingPredictor.ResourceRoutingPredictor()
 	providerResources[routingQueueConditionalGroupRouting.ResourceType] = routingQueueConditionalGroupRouting.ResourceRoutingQueueConditionalGroupRouting()
 	providerResources[routingQueueOutboundEmailAddress.ResourceType] = routingQueueOutboundEmailAddress.ResourceRoutingQueueOutboundEmailAddress()
 	providerResources[routingSkill.ResourceType] = routingSkill.ResourceRoutingSkill()
 	providerResources[routingSettings.ResourceType] = routingSettings.ResourceRoutingSettings()
+	providerResources[recordingSettings.ResourceType] = recordingSettings.ResourceRecordingSettings()
 	providerResources[routingUtilization.ResourceType] = routingUtilization.ResourceRoutingUtilization()
 	providerResources[routingUtilizationLabel.ResourceType] = routingUtilizationLabel.ResourceRoutingUtilizationLabel()
 	providerResources[routingWrapupcode.ResourceType] = routingWrapupcode.ResourceRoutingWrapupCode()
@@ -239,6 +252,9 @@ func (r *registerTestInstance) registerTestResources() {
 	providerResources[worktypeStatus.ResourceType] = worktypeStatus.ResourceTaskManagementWorktypeStatus()
 	providerResources[cMessagingOpen.ResourceType] = cMessagingOpen.ResourceConversationsMessagingIntegrationsOpen()
 	providerResources[externalOrganization.ResourceType] = externalOrganization.ResourceExternalContactsOrganization()
+	providerResources[externalContactsContactSchema.ResourceType] = externalContactsContactSchema.ResourceExternalContactsContactSchema()
+	providerResources[externalOrganizationSchema.ResourceType] = externalOrganizationSchema.ResourceExternalContactsOrganizationSchema()
+	providerResources[externalRelationship.ResourceType] = externalRelationship.ResourceExternalContactsRelationship()
 	providerResources[knowledgeCategory.ResourceType] = knowledgeCategory.ResourceKnowledgeCategory()
 	providerResources[journeyOutcome.ResourceType] = journeyOutcome.ResourceJourneyOutcome()
 	providerResources[externalUser.ResourceType] = externalUser.ResourceExternalUserIdentity()
@@ -275,7 +291,10 @@ func (r *registerTestInstance) registerTestExporters() {
 	RegisterExporter(userPrompt.ResourceType, userPrompt.ArchitectUserPromptExporter())
 	RegisterExporter(authDivision.ResourceType, authDivision.AuthDivisionExporter())
 	RegisterExporter(authRole.ResourceType, authRole.AuthRoleExporter())
+	RegisterExporter(authorizationPolicy.ResourceType, authorizationPolicy.AuthorizationPolicyExporter())
 	RegisterExporter(employeeperformanceExternalmetricsDefinition.ResourceType, employeeperformanceExternalmetricsDefinition.EmployeeperformanceExternalmetricsDefinitionExporter())
+	RegisterExporter(gamificationProfile.ResourceType, gamificationProfile.GamificationProfileExporter())
+	RegisterExporter(gamificationMetric.ResourceType, gamificationMetric.GamificationMetricExporter())
 	RegisterExporter(flow.ResourceType, flow.ArchitectFlowExporter())
 	RegisterExporter(flowLogLevel.ResourceType, flowLogLevel.FlowLogLevelExporter())
 	RegisterExporter(flowMilestone.ResourceType, flowMilestone.FlowMilestoneExporter())
@@ -327,9 +346,11 @@ func (r *registerTestInstance) registerTestExporters() {
 	RegisterExporter(routingEmailRoute.ResourceType, routingEmailRoute.RoutingEmailRouteExporter())
 	RegisterExporter(routinglanguage.ResourceType, routinglanguage.RoutingLanguageExporter())
 	RegisterExporter(routingQueue.ResourceType, routingQueue.RoutingQueueExporter())
+	RegisterExporter(routingPredictor.ResourceType, routingPredictor.RoutingPredictorExporter())
 	RegisterExporter(routingQueueConditionalGroupRouting.ResourceType, routingQueueConditionalGroupRouting.RoutingQueueConditionalGroupRoutingExporter())
 	RegisterExporter(routingQueueOutboundEmailAddress.ResourceType, routingQueueOutboundEmailAddress.OutboundRoutingQueueOutboundEmailAddressExporter())
 	RegisterExporter(routingSettings.ResourceType, routingSettings.RoutingSettingsExporter())
+	RegisterExporter(recordingSettings.ResourceType, recordingSettings.RecordingSettingsExporter())
 	RegisterExporter(routingSkillGroup.ResourceType, routingSkillGroup.ResourceSkillGroupExporter())
 	RegisterExporter(routingSkill.ResourceType, routingSkill.RoutingSkillExporter())
 	RegisterExporter(routingSmsAddress.ResourceType, routingSmsAddress.RoutingSmsAddressExporter())
@@ -361,6 +382,9 @@ func (r *registerTestInstance) registerTestExporters() {
 	RegisterExporter(cMessagingOpen.ResourceType, cMessagingOpen.ConversationsMessagingIntegrationsOpenExporter())
 	RegisterExporter(scripts.ResourceType, scripts.ExporterScript())
 	RegisterExporter(externalOrganization.ResourceType, externalOrganization.ExternalContactsOrganizationExporter())
+	RegisterExporter(externalContactsContactSchema.ResourceType, externalContactsContactSchema.ExternalContactsContactSchemaExporter())
+	RegisterExporter(externalOrganizationSchema.ResourceType, externalOrganizationSchema.ExternalContactsOrganizationSchemaExporter())
+	RegisterExporter(externalRelationship.ResourceType, externalRelationship.ExternalContactsRelationshipExporter())
 	RegisterExporter(externalUser.ResourceType, externalUser.ExternalUserIdentityExporter())
 	RegisterExporter(qualityFormsSurvey.ResourceType, qualityFormsSurvey.QualityFormsSurveyExporter())
 	RegisterExporter(guide.ResourceType, guide.GuideExporter())
@@ -138,59 +138,70 @@ func updateUserSkills(d *schema.ResourceData, proxy *userProxy) diag.Diagnostics
 		if skillsConfig := d.Get("routing_skills"); skillsConfig != nil {
 			log.Printf("Updating skills for user %s", d.Get("email"))
 			newSkillProfs := make(map[string]float64)
-			skillList := skillsConfig.(*schema.Set).List()
-			newSkillIds := make([]string, len(skillList))
-			for i, skill := range skillList {
+			for _, skill := range skillsConfig.(*schema.Set).List() {
 				skillMap := skill.(map[string]interface{})
-				newSkillIds[i] = skillMap["skill_id"].(string)
-				newSkillProfs[newSkillIds[i]] = skillMap["proficiency"].(float64)
+				newSkillProfs[skillMap["skill_id"].(string)] = skillMap["proficiency"].(float64)
 			}
+			return reconcileUserRoutingSkills(d.Id(), newSkillProfs, proxy)
+		}
+	}
+	return nil
+}
 
-			oldSdkSkills, err := getUserRoutingSkills(d.Id(), proxy)
-			if err != nil {
-				return err
-			}
+// UpdateUserRoutingSkills sets the routing skills of a user to skillProfs (skill ID to proficiency), removing any other skills.
+// Only skills that are added, removed or have a changed proficiency are sent to the API.
+func UpdateUserRoutingSkills(userID string, skillProfs map[string]float64, sdkConfig *platformclientv2.Configuration) diag.Diagnostics {
+	return reconcileUserRoutingSkills(userID, skillProfs, GetUserProxy(sdkConfig))
+}
 
-			oldSkillIds := make([]string, len(oldSdkSkills))
-			oldSkillProfs := make(map[string]float64)
-			for i, skill := range oldSdkSkills {
-				oldSkillIds[i] = *skill.Id
-				oldSkillProfs[oldSkillIds[i]] = *skill.Proficiency
-			}
+func reconcileUserRoutingSkills(userID string, newSkillProfs map[string]float64, proxy *userProxy) diag.Diagnostics {
+	newSkillIds := make([]string, 0, len(newSkillProfs))
+	for skillId := range newSkillProfs {
+		newSkillIds = append(newSkillIds, skillId)
+	}
 
-			if len(oldSkillIds) > 0 {
-				skillsToRemove := lists.SliceDifference(oldSkillIds, newSkillIds)
-				for _, skillId := range skillsToRemove {
-					diagErr := util.RetryWhen(util.IsVersionMismatch, func() (*platformclientv2.APIResponse, diag.Diagnostics) {
-						resp, err := proxy.userApi.DeleteUserRoutingskill(d.Id(), skillId)
-						if err != nil {
-							return resp, util.BuildAPIDiagnosticError(ResourceType, fmt.Sprintf("Failed to remove skill from user %s error: %s", d.Id(), err), resp)
-						}
-						return nil, nil
-					})
-					if diagErr != nil {
-						return diagErr
-					}
-				}
-			}
+	oldSdkSkills, err := getUserRoutingSkills(userID, proxy)
+	if err != nil {
+		return err
+	}
 
-			if len(newSkillIds) > 0 {
-				// skills to add
-				skillsToAddOrUpdate := lists.SliceDifference(newSkillIds, oldSkillIds)
-				// Check for existing proficiencies to update which can be done with the same API
-				for langID, newNum := range newSkillProfs {
-					if oldNum, found := oldSkillProfs[langID]; found {
-						if newNum != oldNum {
-							skillsToAddOrUpdate = append(skillsToAddOrUpdate, langID)
-						}
-					}
+	oldSkillIds := make([]string, len(oldSdkSkills))
+	oldSkillProfs := make(map[string]float64)
+	for i, skill := range oldSdkSkills {
+		oldSkillIds[i] = *skill.Id
+		oldSkillProfs[oldSkillIds[i]] = *skill.Proficiency
+	}
+
+	if len(oldSkillIds) > 0 {
+		skillsToRemove := lists.SliceDifference(oldSkillIds, newSkillIds)
+		for _, skillId := range skillsToRemove {
+			diagErr := util.RetryWhen(util.IsVersionMismatch, func() (*platformclientv2.APIResponse, diag.Diagnostics) {
+				resp, err := proxy.userApi.DeleteUserRoutingskill(userID, skillId)
+				if err != nil {
+					return resp, util.BuildAPIDiagnosticError(ResourceType, fmt.Sprintf("Failed to remove skill from user %s error: %s", userID, err), resp)
 				}
+				return nil, nil
+			})
+			if diagErr != nil {
+				return diagErr
+			}
+		}
+	}
 
-				if diagErr := updateUserRoutingSkills(d.Id(), skillsToAddOrUpdate, newSkillProfs, proxy); diagErr != nil {
-					return diagErr
+	if len(newSkillIds) > 0 {
+		// skills to add
+		skillsToAddOrUpdate := lists.SliceDifference(newSkillIds, oldSkillIds)
+		// Check for existing proficiencies to update which can be done with the same API
+		for skillId, newNum := range newSkillProfs {
+			if oldNum, found := oldSkillProfs[skillId]; found {
+				if newNum != oldNum {
+					skillsToAddOrUpdate = append(skillsToAddOrUpdate, skillId)
 				}
 			}
+		}
 
+		if diagErr := updateUserRoutingSkills(userID, skillsToAddOrUpdate, newSkillProfs, proxy); diagErr != nil {
+			return diagErr
 		}
 	}
 	return nil
@@ -201,59 +212,73 @@ func updateUserLanguages(d *schema.ResourceData, proxy *userProxy) diag.Diagnost
 		if languages := d.Get("routing_languages"); languages != nil {
 			log.Printf("Updating languages for user %s", d.Get("email"))
 			newLangProfs := make(map[string]int)
-			langList := languages.(*schema.Set).List()
-			newLangIds := make([]string, len(langList))
-			for i, lang := range langList {
+			for _, lang := range languages.(*schema.Set).List() {
 				langMap := lang.(map[string]interface{})
-				newLangIds[i] = langMap["language_id"].(string)
-				newLangProfs[newLangIds[i]] = langMap["proficiency"].(int)
+				newLangProfs[langMap["language_id"].(string)] = langMap["proficiency"].(int)
 			}
-
-			oldSdkLangs, err := getUserRoutingLanguages(d.Id(), proxy)
-			if err != nil {
-				return err
+			if diagErr := reconcileUserRoutingLanguages(d.Id(), newLangProfs, proxy); diagErr != nil {
+				return diagErr
 			}
+			log.Printf("Languages updated for user %s", d.Get("email"))
+		}
+	}
+	return nil
+}
 
-			oldLangIds := make([]string, len(oldSdkLangs))
-			oldLangProfs := make(map[string]int)
-			for i, lang := range oldSdkLangs {
-				oldLangIds[i] = *lang.Id
-				oldLangProfs[oldLangIds[i]] = int(*lang.Proficiency)
-			}
+// UpdateUserRoutingLanguages sets the routing languages of a user to langProfs (language ID to proficiency), removing any other languages.
+// Only languages that are added, removed or have a changed proficiency are sent to the API.
+func UpdateUserRoutingLanguages(userID string, langProfs map[string]int, sdkConfig *platformclientv2.Configuration) diag.Diagnostics {
+	return reconcileUserRoutingLanguages(userID, langProfs, GetUserProxy(sdkConfig))
+}
 
-			if len(oldLangIds) > 0 {
-				langsToRemove := lists.SliceDifference(oldLangIds, newLangIds)
-				for _, langID := range langsToRemove {
-					diagErr := util.RetryWhen(util.IsVersionMismatch, func() (*platformclientv2.APIResponse, diag.Diagnostics) {
-						resp, err := proxy.userApi.DeleteUserRoutinglanguage(d.Id(), langID)
-						if err != nil {
-							return resp, util.BuildAPIDiagnosticError(ResourceType, fmt.Sprintf("Failed to remove language from user %s error: %s", d.Id(), err), resp)
-						}
-						return nil, nil
-					})
-					if diagErr != nil {
-						return diagErr
-					}
+func reconcileUserRoutingLanguages(userID string, newLangProfs map[string]int, proxy *userProxy) diag.Diagnostics {
+	newLangIds := make([]string, 0, len(newLangProfs))
+	for langID := range newLangProfs {
+		newLangIds = append(newLangIds, langID)
+	}
+
+	oldSdkLangs, err := getUserRoutingLanguages(userID, proxy)
+	if err != nil {
+		return err
+	}
+
+	oldLangIds := make([]string, len(oldSdkLangs))
+	oldLangProfs := make(map[string]int)
+	for i, lang := range oldSdkLangs {
+		oldLangIds[i] = *lang.Id
+		oldLangProfs[oldLangIds[i]] = int(*lang.Proficiency)
+	}
+
+	if len(oldLangIds) > 0 {
+		langsToRemove := lists.SliceDifference(oldLangIds, newLangIds)
+		for _, langID := range langsToRemove {
+			diagErr := util.RetryWhen(util.IsVersionMismatch, func() (*platformclientv2.APIResponse, diag.Diagnostics) {
+				resp, err := proxy.userApi.DeleteUserRoutinglanguage(userID, langID)
+				if err != nil {
+					return resp, util.BuildAPIDiagnosticError(ResourceType, fmt.Sprintf("Failed to remove language from user %s error: %s", userID, err), resp)
 				}
+				return nil, nil
+			})
+			if diagErr != nil {
+				return diagErr
 			}
+		}
+	}
 
-			if len(newLangIds) > 0 {
-				// Languages to add
-				langsToAddOrUpdate := lists.SliceDifference(newLangIds, oldLangIds)
+	if len(newLangIds) > 0 {
+		// Languages to add
+		langsToAddOrUpdate := lists.SliceDifference(newLangIds, oldLangIds)
 
-				// Check for existing proficiencies to update which can be done with the same API
-				for langID, newNum := range newLangProfs {
-					if oldNum, found := oldLangProfs[langID]; found {
-						if newNum != oldNum {
-							langsToAddOrUpdate = append(langsToAddOrUpdate, langID)
-						}
-					}
-				}
-				if diagErr := updateUserRoutingLanguages(d.Id(), langsToAddOrUpdate, newLangProfs, proxy); diagErr != nil {
-					return diagErr
+		// Check for existing proficiencies to update which can be done with the same API
+		for langID, newNum := range newLangProfs {
+			if oldNum, found := oldLangProfs[langID]; found {
+				if newNum != oldNum {
+					langsToAddOrUpdate = append(langsToAddOrUpdate, langID)
 				}
 			}
-			log.Printf("Languages updated for user %s", d.Get("email"))
+		}
+		if diagErr := updateUserRoutingLanguages(userID, langsToAddOrUpdate, newLangProfs, proxy); diagErr != nil {
+			return diagErr
 		}
 	}
 	return nil
@@ -0,0 +1,255 @@
+package users_bulk
+
+import (
+	"context"
+
+	"github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/provider"
+	"github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/user"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/mypurecloud/platform-client-sdk-go/v192/platformclientv2"
+)
+
+/*
+The genesyscloud_users_bulk_proxy.go file contains the proxy structures and methods that interact
+with the Genesys Cloud SDK. We use composition here for each function on the proxy so individual functions can be stubbed
+out during testing.
+
+Skills and languages are reconciled through the genesyscloud_user package so both resources apply them the same way.
+*/
+
+// internalProxy holds a proxy instance that can be used throughout the package
+var internalProxy *usersBulkProxy
+
+// Type definitions for each func on our proxy so we can easily mock them out later
+type searchUserIdByEmailFunc func(ctx context.Context, p *usersBulkProxy, email string, states []string) (string, *platformclientv2.APIResponse, error)
+type getUsersByIdsFunc func(ctx context.Context, p *usersBulkProxy, userIds []string) ([]platformclientv2.User, *platformclientv2.APIResponse, error)
+type getUserByIdFunc func(ctx context.Context, p *usersBulkProxy, userId string, state string) (*platformclientv2.User, *platformclientv2.APIResponse, error)
+type createUserFunc func(ctx context.Context, p *usersBulkProxy, createUser *platformclientv2.Createuser) (*platformclientv2.User, *platformclientv2.APIResponse, error)
+type patchUserFunc func(ctx context.Context, p *usersBulkProxy, userId string, updateUser *platformclientv2.Updateuser) (*platformclientv2.User, *platformclientv2.APIResponse, error)
+type deleteUserFunc func(ctx context.Context, p *usersBulkProxy, userId string) (*platformclientv2.APIResponse, error)
+type updateUsersDivisionFunc func(ctx context.Context, p *usersBulkProxy, divisionId string, userIds []string) (*platformclientv2.APIResponse, error)
+type updateUserRolesFunc func(ctx context.Context, p *usersBulkProxy, userId string, roleIds []string) (*platformclientv2.APIResponse, error)
+type updateQueueMembersFunc func(ctx context.Context, p *usersBulkProxy, queueId string, userIds []string, remove bool) (*platformclientv2.APIResponse, error)
+type updateUserRoutingSkillsFunc func(ctx context.Context, p *usersBulkProxy, userId string, skillProfs map[string]float64) diag.Diagnostics
+type updateUserRoutingLanguagesFunc func(ctx context.Context, p *usersBulkProxy, userId string, langProfs map[string]int) diag.Diagnostics
+
+// usersBulkProxy contains all the methods that call the genesys cloud APIs.
+type usersBulkProxy struct {
+	clientConfig                   *platformclientv2.Configuration
+	usersApi                       *platformclientv2.UsersApi
+	routingApi                     *platformclientv2.RoutingApi
+	authorizationApi               *platformclientv2.AuthorizationApi
+	searchUserIdByEmailAttr        searchUserIdByEmailFunc
+	getUsersByIdsAttr              getUsersByIdsFunc
+	getUserByIdAttr                getUserByIdFunc
+	createUserAttr                 createUserFunc
+	patchUserAttr                  patchUserFunc
+	deleteUserAttr                 deleteUserFunc
+	updateUsersDivisionAttr        updateUsersDivisionFunc
+	updateUserRolesAttr            updateUserRolesFunc
+	updateQueueMembersAttr         updateQueueMembersFunc
+	updateUserRoutingSkillsAttr    updateUserRoutingSkillsFunc
+	updateUserRoutingLanguagesAttr updateUserRoutingLanguagesFunc
+}
+
+// newUsersBulkProxy initializes the users bulk proxy with all the data needed to communicate with Genesys Cloud
+func newUsersBulkProxy(clientConfig *platformclientv2.Configuration) *usersBulkProxy {
+	return &usersBulkProxy{
+		clientConfig:                   clientConfig,
+		usersApi:                       platformclientv2.NewUsersApiWithConfig(clientConfig),
+		routingApi:                     platformclientv2.NewRoutingApiWithConfig(clientConfig),
+		authorizationApi:               platformclientv2.NewAuthorizationApiWithConfig(clientConfig),
+		searchUserIdByEmailAttr:        searchUserIdByEmailFn,
+		getUsersByIdsAttr:              getUsersByIdsFn,
+		getUserByIdAttr:                getUserByIdFn,
+		createUserAttr:                 createUserFn,
+		patchUserAttr:                  patchUserFn,
+		deleteUserAttr:                 deleteUserFn,
+		updateUsersDivisionAttr:        updateUsersDivisionFn,
+		updateUserRolesAttr:            updateUserRolesFn,
+		updateQueueMembersAttr:         updateQueueMembersFn,
+		updateUserRoutingSkillsAttr:    updateUserRoutingSkillsFn,
+		updateUserRoutingLanguagesAttr: updateUserRoutingLanguagesFn,
+	}
+}
+
+// getUsersBulkProxy acts as a singleton to for the internalProxy. It also ensures
+// that we can still proxy our tests by directly setting internalProxy package variable
+func getUsersBulkProxy(clientConfig *platformclientv2.Configuration) *usersBulkProxy {
+	if internalProxy == nil {
+		internalProxy = newUsersBulkProxy(clientConfig)
+	}
+	return internalProxy
+}
+
+// searchUserIdByEmail returns the ID of the user with the given email in one of the given states, or an empty string if there is none
+func (p *usersBulkProxy) searchUserIdByEmail(ctx context.Context, email string, states []string) (string, *platformclientv2.APIResponse, error) {
+	return p.searchUserIdByEmailAttr(ctx, p, email, states)
+}
+
+// getUsersByIds returns the users with the given IDs in any state
+func (p *usersBulkProxy) getUsersByIds(ctx context.Context, userIds []string) ([]platformclientv2.User, *platformclientv2.APIResponse, error) {
+	return p.getUsersByIdsAttr(ctx, p, userIds)
+}
+
+// getUserById returns a single user
+func (p *usersBulkProxy) getUserById(ctx context.Context, userId string, state string) (*platformclientv2.User, *platformclientv2.APIResponse, error) {
+	return p.getUserByIdAttr(ctx, p, userId, state)
+}
+
+// createUser creates a user
+func (p *usersBulkProxy) createUser(ctx context.Context, createUser *platformclientv2.Createuser) (*platformclientv2.User, *platformclientv2.APIResponse, error) {
+	return p.createUserAttr(ctx, p, createUser)
+}
+
+// patchUser updates a user
+func (p *usersBulkProxy) patchUser(ctx context.Context, userId string, updateUser *platformclientv2.Updateuser) (*platformclientv2.User, *platformclientv2.APIResponse, error) {
+	return p.patchUserAttr(ctx, p, userId, updateUser)
+}
+
+// deleteUser deletes a user
+func (p *usersBulkProxy) deleteUser(ctx context.Context, userId string) (*platformclientv2.APIResponse, error) {
+	return p.deleteUserAttr(ctx, p, userId)
+}
+
+// updateUsersDivision moves a batch of users to a division
+func (p *usersBulkProxy) updateUsersDivision(ctx context.Context, divisionId string, userIds []string) (*platformclientv2.APIResponse, error) {
+	return p.updateUsersDivisionAttr(ctx, p, divisionId, userIds)
+}
+
+// updateUserRoles replaces the roles granted to a user in the home division
+func (p *usersBulkProxy) updateUserRoles(ctx context.Context, userId string, roleIds []string) (*platformclientv2.APIResponse, error) {
+	return p.updateUserRolesAttr(ctx, p, userId, roleIds)
+}
+
+// updateQueueMembers adds or removes a batch of users to or from a queue
+func (p *usersBulkProxy) updateQueueMembers(ctx context.Context, queueId string, userIds []string, remove bool) (*platformclientv2.APIResponse, error) {
+	return p.updateQueueMembersAttr(ctx, p, queueId, userIds, remove)
+}
+
+// updateUserRoutingSkills sets the routing skills of a user
+func (p *usersBulkProxy) updateUserRoutingSkills(ctx context.Context, userId string, skillProfs map[string]float64) diag.Diagnostics {
+	return p.updateUserRoutingSkillsAttr(ctx, p, userId, skillProfs)
+}
+
+// updateUserRoutingLanguages sets the routing languages of a user
+func (p *usersBulkProxy) updateUserRoutingLanguages(ctx context.Context, userId string, langProfs map[string]int) diag.Diagnostics {
+	return p.updateUserRoutingLanguagesAttr(ctx, p, userId, langProfs)
+}
+
+// searchUserIdByEmailFn is an implementation function for finding a user by email
+func searchUserIdByEmailFn(ctx context.Context, p *usersBulkProxy, email string, states []string) (string, *platformclientv2.APIResponse, error) {
+	// Set resource context for SDK debug logging
+	ctx = provider.EnsureResourceContext(ctx, ResourceType)
+
+	exactType := "EXACT"
+	results, resp, err := p.usersApi.PostUsersSearch(platformclientv2.Usersearchrequest{
+		Query: &[]platformclientv2.Usersearchcriteria{
+			{
+				Fields:  &[]string{"email"},
+				Value:   &email,
+				VarType: &exactType,
+			},
+			{
+				Fields:  &[]string{"state"},
+				Values:  &states,
+				VarType: &exactType,
+			},
+		},
+	})
+	if err != nil {
+		return "", resp, err
+	}
+	if results.Results == nil || len(*results.Results) == 0 || (*results.Results)[0].Id == nil {
+		return "", resp, nil
+	}
+	return *(*results.Results)[0].Id, resp, nil
+}
+
+// getUsersByIdsFn is an implementation function for retrieving a batch of users by ID
+func getUsersByIdsFn(ctx context.Context, p *usersBulkProxy, userIds []string) ([]platformclientv2.User, *platformclientv2.APIResponse, error) {
+	// Set resource context for SDK debug logging
+	ctx = provider.EnsureResourceContext(ctx, ResourceType)
+
+	users, resp, err := p.usersApi.GetUsers(len(userIds), 1, userIds, nil, "", nil, "", nil, "any")
+	if err != nil {
+		return nil, resp, err
+	}
+	if users.Entities == nil {
+		return nil, resp, nil
+	}
+	return *users.Entities, resp, nil
+}
+
+// getUserByIdFn is an implementation function for retrieving a single user
+func getUserByIdFn(ctx context.Context, p *usersBulkProxy, userId string, state string) (*platformclientv2.User, *platformclientv2.APIResponse, error) {
+	// Set resource context for SDK debug logging
+	ctx = provider.EnsureResourceContext(ctx, ResourceType)
+
+	return p.usersApi.GetUser(userId, nil, "", nil, state)
+}
+
+// createUserFn is an implementation function for creating a user
+func createUserFn(ctx context.Context, p *usersBulkProxy, createUser *platformclientv2.Createuser) (*platformclientv2.User, *platformclientv2.APIResponse, error) {
+	// Set resource context for SDK debug logging
+	ctx = provider.EnsureResourceContext(ctx, ResourceType)
+
+	return p.usersApi.PostUsers(*createUser)
+}
+
+// patchUserFn is an implementation function for updating a user
+func patchUserFn(ctx context.Context, p *usersBulkProxy, userId string, updateUser *platformclientv2.Updateuser) (*platformclientv2.User, *platformclientv2.APIResponse, error) {
+	// Set resource context for SDK debug logging
+	ctx = provider.EnsureResourceContext(ctx, ResourceType)
+
+	return p.usersApi.PatchUser(userId, *updateUser)
+}
+
+// deleteUserFn is an implementation function for deleting a user
+func deleteUserFn(ctx context.Context, p *usersBulkProxy, userId string) (*platformclientv2.APIResponse, error) {
+	// Set resource context for SDK debug logging
+	ctx = provider.EnsureResourceContext(ctx, ResourceType)
+
+	_, resp, err := p.usersApi.DeleteUser(userId)
+	return resp, err
+}
+
+// updateUsersDivisionFn is an implementation function for moving users to a division
+func updateUsersDivisionFn(ctx context.Context, p *usersBulkProxy, divisionId string, userIds []string) (*platformclientv2.APIResponse, error) {
+	// Set resource context for SDK debug logging
+	ctx = provider.EnsureResourceContext(ctx, ResourceType)
+
+	return p.authorizationApi.PostAuthorizationDivisionObject(divisionId, "USER", userIds)
+}
+
+// updateUserRolesFn is an implementation function for replacing the roles of a user
+func updateUserRolesFn(ctx context.Context, p *usersBulkProxy, userId string, roleIds []string) (*platformclientv2.APIResponse, error) {
+	// Set resource context for SDK debug logging
+	ctx = provider.EnsureResourceContext(ctx, ResourceType)
+
+	_, resp, err := p.usersApi.PutUserRoles(userId, roleIds)
+	return resp, err
+}
+
+// updateQueueMembersFn is an implementation function for adding or removing queue members
+func updateQueueMembersFn(ctx context.Context, p *usersBulkProxy, queueId string, userIds []string, remove bool) (*platformclientv2.APIResponse, error) {
+	// Set resource context for SDK debug logging
+	ctx = provider.EnsureResourceContext(ctx, ResourceType)
+
+	members := make([]platformclientv2.Writableentity, len(userIds))
+	for i := range userIds {
+		members[i] = platformclientv2.Writableentity{Id: &userIds[i]}
+	}
+	return p.routingApi.PostRoutingQueueMembers(queueId, members, remove)
+}
+
+// updateUserRoutingSkillsFn is an implementation function for setting the routing skills of a user
+func updateUserRoutingSkillsFn(ctx context.Context, p *usersBulkProxy, userId string, skillProfs map[string]float64) diag.Diagnostics {
+	return user.UpdateUserRoutingSkills(userId, skillProfs, p.clientConfig)
+}
+
+// updateUserRoutingLanguagesFn is an implementation function for setting the routing languages of a user
+func updateUserRoutingLanguagesFn(ctx context.Context, p *usersBulkProxy, userId string, langProfs map[string]int) diag.Diagnostics {
+	return user.UpdateUserRoutingLanguages(userId, langProfs, p.clientConfig)
+}
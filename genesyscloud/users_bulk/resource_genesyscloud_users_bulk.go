@@ -0,0 +1,485 @@
+package users_bulk
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+
+	"github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/provider"
+	"github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/util"
+	"github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/util/chunks"
+	"github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/util/files"
+	"github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/util/lists"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mypurecloud/platform-client-sdk-go/v192/platformclientv2"
+)
+
+/*
+The resource_genesyscloud_users_bulk.go file reconciles the users listed in a CSV or JSON file in batches.
+
+Only rows whose content changed since the last apply are sent to Genesys Cloud. A row that cannot be applied is reported
+as a warning and retried on the next apply instead of failing the whole apply.
+*/
+
+// queueMembersMaxBatchSize is the maximum number of members the queue members API accepts per request
+const queueMembersMaxBatchSize = 100
+
+func createUsersBulk(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	log.Printf("Creating users from %s", d.Get("filepath").(string))
+	return applyUsersBulk(ctx, d, meta)
+}
+
+func updateUsersBulk(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	log.Printf("Updating users from %s", d.Get("filepath").(string))
+	return applyUsersBulk(ctx, d, meta)
+}
+
+func applyUsersBulk(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sdkConfig := meta.(*provider.ProviderMeta).ClientConfig
+	proxy := getUsersBulkProxy(sdkConfig)
+	filePath := d.Get("filepath").(string)
+
+	users, skippedEmails, diags := readBulkUsersFile(ctx, filePath)
+	if diags.HasError() {
+		return diags
+	}
+	if d.Id() == "" {
+		d.SetId(uuid.NewString())
+	}
+
+	states, reconcileDiags := reconcileBulkUsers(ctx, proxy, users, skippedEmails, buildBulkUserStates(d), d.Get("batch_size").(int))
+	diags = append(diags, reconcileDiags...)
+
+	// A row that was not applied leaves the stored hash empty, so the next plan shows a change and retries it
+	fileHash := ""
+	if len(diags) == 0 {
+		hash, err := files.HashFileContent(ctx, filePath, S3Enabled)
+		if err != nil {
+			return append(diags, util.BuildDiagnosticError(ResourceType, fmt.Sprintf("Failed to hash users file %s", filePath), err)...)
+		}
+		fileHash = hash
+	}
+
+	_ = d.Set("users", flattenBulkUserStates(states))
+	_ = d.Set("file_content_hash", fileHash)
+
+	log.Printf("Applied %d users from %s with %d warnings", len(users), filePath, len(diags))
+	return append(diags, readUsersBulk(ctx, d, meta)...)
+}
+
+// readUsersBulk drops users that no longer exist from the state, so they are created again on the next apply. The name,
+// email, division and state of the remaining users are compared with the values recorded after their rows were applied.
+// When they differ the row hash is cleared, so the next plan shows a change and the row is applied again.
+func readUsersBulk(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sdkConfig := meta.(*provider.ProviderMeta).ClientConfig
+	proxy := getUsersBulkProxy(sdkConfig)
+
+	states := buildBulkUserStates(d)
+	userIds := make([]string, 0, len(states))
+	emailsById := make(map[string]string, len(states))
+	for email, state := range states {
+		userIds = append(userIds, state.UserId)
+		emailsById[state.UserId] = email
+	}
+	sort.Strings(userIds)
+
+	existing := make(map[string]bool)
+	stateChanged, drifted := false, false
+	for _, chunk := range chunks.ChunkBy(userIds, queueMembersMaxBatchSize) {
+		if len(chunk) == 0 {
+			continue
+		}
+		users, resp, err := proxy.getUsersByIds(ctx, chunk)
+		if err != nil {
+			return util.BuildAPIDiagnosticError(ResourceType, fmt.Sprintf("Failed to read users of %s error: %s", d.Id(), err), resp)
+		}
+		for _, user := range users {
+			if user.Id == nil || (user.State != nil && *user.State == "deleted") {
+				continue
+			}
+			existing[*user.Id] = true
+
+			email, ok := emailsById[*user.Id]
+			if !ok {
+				continue
+			}
+			userHash, err := hashLiveBulkUser(user)
+			if err != nil {
+				return util.BuildDiagnosticError(ResourceType, fmt.Sprintf("Failed to hash user %s", *user.Id), err)
+			}
+			state := states[email]
+			switch state.UserHash {
+			case userHash:
+				continue
+			case "":
+				// The row was applied since the last read, so record the values to compare against from now on
+				state.UserHash = userHash
+			default:
+				log.Printf("User %s (%s) managed by %s was changed outside of Terraform", email, state.UserId, d.Id())
+				state.RowHash = ""
+				state.UserHash = ""
+				drifted = true
+			}
+			states[email] = state
+			stateChanged = true
+		}
+	}
+
+	for email, state := range states {
+		if !existing[state.UserId] {
+			log.Printf("User %s (%s) managed by %s no longer exists", email, state.UserId, d.Id())
+			delete(states, email)
+			stateChanged = true
+			drifted = true
+		}
+	}
+	if stateChanged {
+		_ = d.Set("users", flattenBulkUserStates(states))
+	}
+	if drifted {
+		_ = d.Set("file_content_hash", "")
+	}
+
+	log.Printf("Read %d users of %s", len(states), d.Id())
+	return nil
+}
+
+// deleteUsersBulk deletes every managed user. Each deleted user is removed from the state right away, so a delete that
+// fails part way only leaves the users that could not be deleted.
+func deleteUsersBulk(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sdkConfig := meta.(*provider.ProviderMeta).ClientConfig
+	proxy := getUsersBulkProxy(sdkConfig)
+
+	var diags diag.Diagnostics
+	states := buildBulkUserStates(d)
+	for email, state := range states {
+		if err := deleteBulkUser(ctx, proxy, state.UserId); err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  fmt.Sprintf("Failed to delete user %s (%s)", email, state.UserId),
+				Detail:   err.Error(),
+			})
+			continue
+		}
+		delete(states, email)
+		_ = d.Set("users", flattenBulkUserStates(states))
+	}
+	if diags.HasError() {
+		return diags
+	}
+
+	log.Printf("Deleted users of %s", d.Id())
+	return nil
+}
+
+// reconcileBulkUsers applies the rows that changed since the last apply in batches of batchSize and deletes the users that
+// were removed from the file. It returns the new state of every managed user and a warning for every row that failed.
+func reconcileBulkUsers(ctx context.Context, proxy *usersBulkProxy, users []bulkUser, skippedEmails []string, previous map[string]bulkUserState, batchSize int) (map[string]bulkUserState, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	states := make(map[string]bulkUserState)
+
+	var changed []bulkUser
+	rowHashes := make(map[string]string)
+	for _, row := range users {
+		hash, err := hashBulkUser(row)
+		if err != nil {
+			diags = append(diags, bulkUserRowWarning(row, err))
+			if state, ok := previous[row.Email]; ok {
+				states[row.Email] = bulkUserState{UserId: state.UserId, QueueIds: state.QueueIds}
+			}
+			continue
+		}
+		rowHashes[row.Email] = hash
+		if state, ok := previous[row.Email]; ok && state.RowHash == hash {
+			states[row.Email] = state
+			continue
+		}
+		changed = append(changed, row)
+	}
+
+	// Keep users whose rows could not be read, but apply them again once they can
+	for _, email := range skippedEmails {
+		if state, ok := previous[email]; ok {
+			states[email] = bulkUserState{UserId: state.UserId, QueueIds: state.QueueIds}
+		}
+	}
+
+	log.Printf("Applying %d of %d users in batches of %d", len(changed), len(users), batchSize)
+
+	var pendingManagers []bulkUser
+	for _, batch := range chunks.ChunkBy(changed, batchSize) {
+		if len(batch) == 0 {
+			continue
+		}
+		batchDiags, deferred := applyBulkUserBatch(ctx, proxy, batch, previous, states, rowHashes)
+		diags = append(diags, batchDiags...)
+		pendingManagers = append(pendingManagers, deferred...)
+	}
+
+	// Managers that were created later in the file are only known once all batches have been applied
+	for _, row := range pendingManagers {
+		state, ok := states[row.Email]
+		if !ok {
+			continue
+		}
+		if err := updateBulkUserManager(ctx, proxy, state.UserId, row.Manager, states); err != nil {
+			diags = append(diags, bulkUserRowWarning(row, err))
+			state.RowHash = ""
+			states[row.Email] = state
+		}
+	}
+
+	// Delete users that were removed from the file
+	for email, state := range previous {
+		if _, ok := states[email]; ok || rowHashes[email] != "" {
+			continue
+		}
+		log.Printf("Deleting user %s (%s) which was removed from the users file", email, state.UserId)
+		if err := deleteBulkUser(ctx, proxy, state.UserId); err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  fmt.Sprintf("Failed to delete user %s which was removed from the users file", email),
+				Detail:   err.Error(),
+			})
+			states[email] = bulkUserState{UserId: state.UserId, QueueIds: state.QueueIds}
+		}
+	}
+
+	return states, diags
+}
+
+// applyBulkUserBatch applies one batch of rows. Division moves and queue memberships are sent for the whole batch at once.
+// It returns the rows whose manager could not be resolved yet.
+func applyBulkUserBatch(ctx context.Context, proxy *usersBulkProxy, batch []bulkUser, previous, states map[string]bulkUserState, rowHashes map[string]string) (diags diag.Diagnostics, pendingManagers []bulkUser) {
+	failed := make(map[string]bool)
+	applied := make([]bulkUser, 0, len(batch))
+	divisionMoves := make(map[string][]bulkUser)
+
+	for _, row := range batch {
+		userId, created, err := upsertBulkUser(ctx, proxy, row, previous[row.Email].UserId, states)
+		if userId != "" {
+			states[row.Email] = bulkUserState{UserId: userId, QueueIds: previous[row.Email].QueueIds}
+		}
+		if err != nil {
+			diags = append(diags, bulkUserRowWarning(row, err))
+			continue
+		}
+		if row.DivisionId != "" && !created {
+			divisionMoves[row.DivisionId] = append(divisionMoves[row.DivisionId], row)
+		}
+		if row.Manager != "" {
+			if _, ok := states[row.Manager]; !ok {
+				pendingManagers = append(pendingManagers, row)
+			}
+		}
+		applied = append(applied, row)
+	}
+
+	for divisionId, rows := range divisionMoves {
+		userIds := make([]string, len(rows))
+		for i, row := range rows {
+			userIds[i] = states[row.Email].UserId
+		}
+		_, err := proxy.updateUsersDivision(ctx, divisionId, userIds)
+		if err != nil {
+			for _, row := range rows {
+				diags = append(diags, bulkUserRowWarning(row, fmt.Errorf("failed to move user to division %s: %v", divisionId, err)))
+				failed[row.Email] = true
+			}
+		}
+	}
+
+	diags = append(diags, updateBulkQueueMembers(ctx, proxy, applied, previous, states, failed)...)
+
+	for _, row := range applied {
+		if failed[row.Email] {
+			continue
+		}
+		state := states[row.Email]
+		state.RowHash = rowHashes[row.Email]
+		state.QueueIds = row.QueueIds
+		states[row.Email] = state
+	}
+	return diags, pendingManagers
+}
+
+// upsertBulkUser creates, restores or adopts the user of a row and applies all of its fields except division moves
+// and queue memberships, which are applied per batch. It returns the user ID and whether the user was created.
+func upsertBulkUser(ctx context.Context, proxy *usersBulkProxy, row bulkUser, userId string, states map[string]bulkUserState) (string, bool, error) {
+	created := false
+	if userId == "" {
+		id, _, err := proxy.searchUserIdByEmail(ctx, row.Email, []string{"active", "inactive"})
+		if err != nil {
+			return "", false, fmt.Errorf("failed to search for user: %v", err)
+		}
+		userId = id
+	}
+	if userId == "" {
+		id, _, err := proxy.searchUserIdByEmail(ctx, row.Email, []string{"deleted"})
+		if err != nil {
+			return "", false, fmt.Errorf("failed to search for deleted user: %v", err)
+		}
+		if id != "" {
+			log.Printf("Restoring deleted user %s", row.Email)
+			if err := patchBulkUser(ctx, proxy, id, "deleted", platformclientv2.Updateuser{State: platformclientv2.String("active")}); err != nil {
+				return "", false, fmt.Errorf("failed to restore deleted user: %v", err)
+			}
+			userId = id
+		}
+	}
+	if userId == "" {
+		createUser := platformclientv2.Createuser{
+			Email:      platformclientv2.String(row.Email),
+			Name:       platformclientv2.String(row.Name),
+			Title:      platformclientv2.String(row.Title),
+			Department: platformclientv2.String(row.Department),
+		}
+		if row.DivisionId != "" {
+			createUser.DivisionId = platformclientv2.String(row.DivisionId)
+		}
+		user, _, err := proxy.createUser(ctx, &createUser)
+		if err != nil {
+			return "", false, fmt.Errorf("failed to create user: %v", err)
+		}
+		userId = *user.Id
+		created = true
+	}
+
+	update := platformclientv2.Updateuser{
+		Email:      platformclientv2.String(row.Email),
+		Name:       platformclientv2.String(row.Name),
+		Title:      platformclientv2.String(row.Title),
+		Department: platformclientv2.String(row.Department),
+		State:      platformclientv2.String("active"),
+	}
+	if managerId, ok := resolveBulkUserManagerId(row.Manager, states); ok {
+		update.Manager = platformclientv2.String(managerId)
+	}
+	if err := patchBulkUser(ctx, proxy, userId, "", update); err != nil {
+		return userId, created, fmt.Errorf("failed to update user: %v", err)
+	}
+
+	if diagErr := proxy.updateUserRoutingSkills(ctx, userId, buildSkillProficiencies(row.RoutingSkills)); diagErr != nil {
+		return userId, created, fmt.Errorf("failed to update routing skills: %v", diagErr)
+	}
+	if diagErr := proxy.updateUserRoutingLanguages(ctx, userId, buildLanguageProficiencies(row.RoutingLanguages)); diagErr != nil {
+		return userId, created, fmt.Errorf("failed to update routing languages: %v", diagErr)
+	}
+	if len(row.RoleIds) > 0 {
+		if _, err := proxy.updateUserRoles(ctx, userId, row.RoleIds); err != nil {
+			return userId, created, fmt.Errorf("failed to update roles: %v", err)
+		}
+	}
+	return userId, created, nil
+}
+
+// resolveBulkUserManagerId returns the ID of the manager of a row when it is already known. An empty manager clears it.
+func resolveBulkUserManagerId(manager string, states map[string]bulkUserState) (string, bool) {
+	if manager == "" {
+		return "", true
+	}
+	if state, ok := states[manager]; ok {
+		return state.UserId, true
+	}
+	return "", false
+}
+
+// updateBulkUserManager sets the manager of a user, looking the manager up by email when it is not in the users file
+func updateBulkUserManager(ctx context.Context, proxy *usersBulkProxy, userId, manager string, states map[string]bulkUserState) error {
+	managerId, ok := resolveBulkUserManagerId(manager, states)
+	if !ok {
+		id, _, err := proxy.searchUserIdByEmail(ctx, manager, []string{"active", "inactive"})
+		if err != nil {
+			return fmt.Errorf("failed to search for manager %s: %v", manager, err)
+		}
+		if id == "" {
+			return fmt.Errorf("manager %s does not exist", manager)
+		}
+		managerId = id
+	}
+	return patchBulkUser(ctx, proxy, userId, "", platformclientv2.Updateuser{Manager: platformclientv2.String(managerId)})
+}
+
+// patchBulkUser patches a user with the current version of the user, retrying on version mismatches
+func patchBulkUser(ctx context.Context, proxy *usersBulkProxy, userId, state string, update platformclientv2.Updateuser) error {
+	diagErr := util.RetryWhen(util.IsVersionMismatch, func() (*platformclientv2.APIResponse, diag.Diagnostics) {
+		currentUser, resp, err := proxy.getUserById(ctx, userId, state)
+		if err != nil {
+			return resp, util.BuildAPIDiagnosticError(ResourceType, fmt.Sprintf("Failed to read user %s error: %s", userId, err), resp)
+		}
+		update.Version = currentUser.Version
+		_, resp, err = proxy.patchUser(ctx, userId, &update)
+		if err != nil {
+			return resp, util.BuildAPIDiagnosticError(ResourceType, fmt.Sprintf("Failed to update user %s error: %s", userId, err), resp)
+		}
+		return nil, nil
+	})
+	if diagErr != nil {
+		return fmt.Errorf("%v", diagErr)
+	}
+	return nil
+}
+
+// updateBulkQueueMembers adds and removes the queue memberships of the applied rows, grouped per queue
+func updateBulkQueueMembers(ctx context.Context, proxy *usersBulkProxy, applied []bulkUser, previous, states map[string]bulkUserState, failed map[string]bool) diag.Diagnostics {
+	var diags diag.Diagnostics
+	additions := make(map[string][]bulkUser)
+	removals := make(map[string][]bulkUser)
+	for _, row := range applied {
+		if failed[row.Email] {
+			continue
+		}
+		oldQueueIds := previous[row.Email].QueueIds
+		for _, queueId := range lists.SliceDifference(row.QueueIds, oldQueueIds) {
+			additions[queueId] = append(additions[queueId], row)
+		}
+		for _, queueId := range lists.SliceDifference(oldQueueIds, row.QueueIds) {
+			removals[queueId] = append(removals[queueId], row)
+		}
+	}
+
+	apply := func(memberships map[string][]bulkUser, remove bool) {
+		queueIds := make([]string, 0, len(memberships))
+		for queueId := range memberships {
+			queueIds = append(queueIds, queueId)
+		}
+		sort.Strings(queueIds)
+
+		for _, queueId := range queueIds {
+			for _, rows := range chunks.ChunkBy(memberships[queueId], queueMembersMaxBatchSize) {
+				userIds := make([]string, len(rows))
+				for i, row := range rows {
+					userIds[i] = states[row.Email].UserId
+				}
+				_, err := proxy.updateQueueMembers(ctx, queueId, userIds, remove)
+				if err == nil {
+					continue
+				}
+				action := "add user to"
+				if remove {
+					action = "remove user from"
+				}
+				for _, row := range rows {
+					diags = append(diags, bulkUserRowWarning(row, fmt.Errorf("failed to %s queue %s: %v", action, queueId, err)))
+					failed[row.Email] = true
+				}
+			}
+		}
+	}
+	apply(additions, false)
+	apply(removals, true)
+	return diags
+}
+
+// deleteBulkUser deletes a user, treating a user that no longer exists as deleted
+func deleteBulkUser(ctx context.Context, proxy *usersBulkProxy, userId string) error {
+	resp, err := proxy.deleteUser(ctx, userId)
+	if err != nil && !util.IsStatus404(resp) {
+		return fmt.Errorf("%v", err)
+	}
+	return nil
+}
@@ -0,0 +1,107 @@
+package users_bulk
+
+import (
+	"time"
+
+	"github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/provider"
+	registrar "github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/resource_register"
+	"github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/validators"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const (
+	ResourceType = "genesyscloud_users_bulk"
+	S3Enabled    = true
+)
+
+// SetRegistrar registers all the resources in the package
+func SetRegistrar(l registrar.Registrar) {
+	l.RegisterResource(ResourceType, ResourceUsersBulk())
+}
+
+var bulkUserStateResource = &schema.Resource{
+	Schema: map[string]*schema.Schema{
+		"email": {
+			Description: "Email of the user.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"user_id": {
+			Description: "ID of the user.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"row_hash": {
+			Description: "Hash of the row of the user when it was last applied. Empty when the row failed to apply.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"user_hash": {
+			Description: "Hash of the name, email, division and state of the user when it was first read after its row was applied. Used to detect changes made outside of Terraform.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"queue_ids": {
+			Description: "IDs of the queues the user was added to.",
+			Type:        schema.TypeSet,
+			Computed:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+		},
+	},
+}
+
+// ResourceUsersBulk registers the genesyscloud_users_bulk resource with terraform
+func ResourceUsersBulk() *schema.Resource {
+	return &schema.Resource{
+		Description: `Genesys Cloud Users Bulk manages a large number of users from a single CSV or JSON file, instead of one genesyscloud_user resource per user.
+
+A JSON file contains an array of objects with the keys ` + "`email`, `name`, `title`, `department`, `division_id`, `manager`, `routing_skills` (objects with `skill_id` and `proficiency`), `routing_languages` (objects with `language_id` and `proficiency`), `queue_ids` and `role_ids`" + `. Any other file is read as a CSV file with a header row using the same column names. In a CSV file list items are separated with ` + "`;`" + ` and proficiencies follow the ID after a ` + "`:`" + `, e.g. ` + "`skill-id-1:4.5;skill-id-2:3`" + `. The manager is identified by email.
+
+Only rows that changed since the last apply are sent to Genesys Cloud. Existing users with a matching email are adopted, and users removed from the file are deleted. When ` + "`role_ids`" + ` is set it replaces all roles of the user in the home division. Rows that fail are reported as warnings and retried on the next apply. Changes made outside of Terraform to the name, email, division or state of a user are detected and the row of the user is applied again on the next apply.`,
+
+		CreateContext: provider.CreateWithPooledClient(createUsersBulk),
+		ReadContext:   provider.ReadWithPooledClient(readUsersBulk),
+		UpdateContext: provider.UpdateWithPooledClient(updateUsersBulk),
+		DeleteContext: provider.DeleteWithPooledClient(deleteUsersBulk),
+		CustomizeDiff: customdiff.All(
+			customdiff.ComputedIf("file_content_hash", validators.ValidateFileContentHashChanged("filepath", "file_content_hash", S3Enabled)),
+			customdiff.ComputedIf("users", validators.ValidateFileContentHashChanged("filepath", "file_content_hash", S3Enabled)),
+		),
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(4 * time.Hour),
+			Update: schema.DefaultTimeout(4 * time.Hour),
+			Delete: schema.DefaultTimeout(4 * time.Hour),
+		},
+		SchemaVersion: 1,
+		Schema: map[string]*schema.Schema{
+			"filepath": {
+				Description:  "Path to the CSV or JSON file listing the users. Files ending in `.json` are read as JSON, all other files as CSV.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validators.ValidatePath,
+			},
+			"file_content_hash": {
+				Description: "Hash value of the users file content. Used to detect changes. Empty when a row failed to apply.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+			},
+			"batch_size": {
+				Description:  "Number of users applied per batch. Division moves and queue memberships are sent once per batch.",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      50,
+				ValidateFunc: validation.IntBetween(1, 100),
+			},
+			"users": {
+				Description: "The users managed by this resource.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        bulkUserStateResource,
+			},
+		},
+	}
+}
@@ -0,0 +1,223 @@
+package users_bulk
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/provider"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mypurecloud/platform-client-sdk-go/v192/platformclientv2"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnitReadBulkUsersFileCsv(t *testing.T) {
+	usersFile := filepath.Join(t.TempDir(), "users.csv")
+	content := `email,name,division_id,manager,routing_skills,routing_languages,queue_ids,role_ids
+agent1@example.com,Agent One,div-1,lead@example.com,skill-b:3;skill-a:4.5,lang-1:5,queue-2;queue-1,role-1
+agent2@example.com,Agent Two,,,skill-a:high,,,
+agent1@example.com,Agent One Again,,,,,,
+,No Email,,,,,,
+`
+	assert.NoError(t, os.WriteFile(usersFile, []byte(content), 0644))
+
+	users, skippedEmails, diags := readBulkUsersFile(context.Background(), usersFile)
+	assert.False(t, diags.HasError(), diags)
+	assert.Len(t, diags, 3)
+	assert.Equal(t, []string{"agent2@example.com"}, skippedEmails)
+
+	assert.Len(t, users, 1)
+	assert.Equal(t, bulkUser{
+		Email:            "agent1@example.com",
+		Name:             "Agent One",
+		DivisionId:       "div-1",
+		Manager:          "lead@example.com",
+		RoutingSkills:    []bulkUserSkill{{SkillId: "skill-a", Proficiency: 4.5}, {SkillId: "skill-b", Proficiency: 3}},
+		RoutingLanguages: []bulkUserLanguage{{LanguageId: "lang-1", Proficiency: 5}},
+		QueueIds:         []string{"queue-1", "queue-2"},
+		RoleIds:          []string{"role-1"},
+		row:              2,
+	}, users[0])
+}
+
+func TestUnitReadBulkUsersFileJson(t *testing.T) {
+	usersFile := filepath.Join(t.TempDir(), "users.json")
+	content := `[
+  {"email": "agent1@example.com", "name": "Agent One", "routing_languages": [{"language_id": "lang-1", "proficiency": 3}]},
+  {"email": "agent2@example.com", "name": "Agent Two", "routing_skills": [{"skill_id": "skill-a", "proficiency": 7}]}
+]`
+	assert.NoError(t, os.WriteFile(usersFile, []byte(content), 0644))
+
+	users, skippedEmails, diags := readBulkUsersFile(context.Background(), usersFile)
+	assert.False(t, diags.HasError(), diags)
+	assert.Len(t, diags, 1)
+	assert.Equal(t, []string{"agent2@example.com"}, skippedEmails)
+	assert.Len(t, users, 1)
+	assert.Equal(t, "agent1@example.com", users[0].Email)
+
+	// Unknown keys are rejected so that typos do not silently drop data
+	assert.NoError(t, os.WriteFile(usersFile, []byte(`[{"email": "agent1@example.com", "nmae": "Agent One"}]`), 0644))
+	_, _, diags = readBulkUsersFile(context.Background(), usersFile)
+	assert.True(t, diags.HasError())
+}
+
+func TestUnitReconcileBulkUsers(t *testing.T) {
+	unchanged := bulkUser{Email: "unchanged@example.com", Name: "Unchanged", QueueIds: []string{"queue-1"}}
+	lead := bulkUser{Email: "lead@example.com", Name: "Lead", QueueIds: []string{"queue-1"}}
+	agent := bulkUser{Email: "agent@example.com", Name: "Agent", Manager: "lead@example.com", QueueIds: []string{"queue-2"}}
+	failing := bulkUser{Email: "failing@example.com", Name: "Failing"}
+
+	unchangedHash, err := hashBulkUser(unchanged)
+	assert.NoError(t, err)
+
+	unchangedId := uuid.NewString()
+	agentId := uuid.NewString()
+	removedId := uuid.NewString()
+	previous := map[string]bulkUserState{
+		unchanged.Email:       {UserId: unchangedId, RowHash: unchangedHash, QueueIds: []string{"queue-1"}},
+		agent.Email:           {UserId: agentId, RowHash: "outdated", QueueIds: []string{"queue-1"}},
+		"removed@example.com": {UserId: removedId, RowHash: "removed"},
+	}
+
+	leadId := uuid.NewString()
+	var (
+		patchedManagers = make(map[string]string)
+		queueAdds       = make(map[string][]string)
+		queueRemovals   = make(map[string][]string)
+		deletedIds      []string
+	)
+	proxy := &usersBulkProxy{
+		searchUserIdByEmailAttr: func(ctx context.Context, p *usersBulkProxy, email string, states []string) (string, *platformclientv2.APIResponse, error) {
+			return "", nil, nil
+		},
+		createUserAttr: func(ctx context.Context, p *usersBulkProxy, createUser *platformclientv2.Createuser) (*platformclientv2.User, *platformclientv2.APIResponse, error) {
+			if *createUser.Email == failing.Email {
+				return nil, nil, fmt.Errorf("user limit reached")
+			}
+			assert.Equal(t, lead.Email, *createUser.Email)
+			return &platformclientv2.User{Id: &leadId}, nil, nil
+		},
+		getUserByIdAttr: func(ctx context.Context, p *usersBulkProxy, userId string, state string) (*platformclientv2.User, *platformclientv2.APIResponse, error) {
+			return &platformclientv2.User{Id: &userId, Version: platformclientv2.Int(1)}, nil, nil
+		},
+		patchUserAttr: func(ctx context.Context, p *usersBulkProxy, userId string, updateUser *platformclientv2.Updateuser) (*platformclientv2.User, *platformclientv2.APIResponse, error) {
+			assert.NotEqual(t, unchangedId, userId)
+			if updateUser.Manager != nil {
+				patchedManagers[userId] = *updateUser.Manager
+			}
+			return &platformclientv2.User{Id: &userId}, nil, nil
+		},
+		updateUserRoutingSkillsAttr: func(ctx context.Context, p *usersBulkProxy, userId string, skillProfs map[string]float64) diag.Diagnostics {
+			return nil
+		},
+		updateUserRoutingLanguagesAttr: func(ctx context.Context, p *usersBulkProxy, userId string, langProfs map[string]int) diag.Diagnostics {
+			return nil
+		},
+		updateQueueMembersAttr: func(ctx context.Context, p *usersBulkProxy, queueId string, userIds []string, remove bool) (*platformclientv2.APIResponse, error) {
+			if remove {
+				queueRemovals[queueId] = append(queueRemovals[queueId], userIds...)
+			} else {
+				queueAdds[queueId] = append(queueAdds[queueId], userIds...)
+			}
+			return nil, nil
+		},
+		deleteUserAttr: func(ctx context.Context, p *usersBulkProxy, userId string) (*platformclientv2.APIResponse, error) {
+			deletedIds = append(deletedIds, userId)
+			return nil, nil
+		},
+	}
+
+	states, diags := reconcileBulkUsers(context.Background(), proxy, []bulkUser{unchanged, lead, agent, failing}, nil, previous, 2)
+
+	// The failing row is reported as a warning instead of failing the whole apply
+	assert.False(t, diags.HasError(), diags)
+	assert.Len(t, diags, 1)
+	assert.NotContains(t, states, failing.Email)
+
+	assert.Equal(t, previous[unchanged.Email], states[unchanged.Email])
+	assert.Equal(t, leadId, states[lead.Email].UserId)
+	assert.Equal(t, agentId, states[agent.Email].UserId)
+	assert.NotEmpty(t, states[agent.Email].RowHash)
+	assert.Equal(t, []string{"queue-2"}, states[agent.Email].QueueIds)
+	assert.Equal(t, leadId, patchedManagers[agentId])
+
+	assert.Equal(t, map[string][]string{"queue-1": {leadId}, "queue-2": {agentId}}, queueAdds)
+	assert.Equal(t, map[string][]string{"queue-1": {agentId}}, queueRemovals)
+
+	assert.Equal(t, []string{removedId}, deletedIds)
+	assert.NotContains(t, states, "removed@example.com")
+}
+
+func TestUnitReadUsersBulkDetectsDrift(t *testing.T) {
+	unchangedUser := platformclientv2.User{Id: platformclientv2.String(uuid.NewString()), Name: platformclientv2.String("Unchanged"), Email: platformclientv2.String("unchanged@example.com"), State: platformclientv2.String("active")}
+	renamedUser := platformclientv2.User{Id: platformclientv2.String(uuid.NewString()), Name: platformclientv2.String("Renamed"), Email: platformclientv2.String("renamed@example.com"), State: platformclientv2.String("active")}
+	appliedUser := platformclientv2.User{Id: platformclientv2.String(uuid.NewString()), Name: platformclientv2.String("Applied"), Email: platformclientv2.String("applied@example.com"), State: platformclientv2.String("active")}
+
+	unchangedHash, err := hashLiveBulkUser(unchangedUser)
+	assert.NoError(t, err)
+	appliedHash, err := hashLiveBulkUser(appliedUser)
+	assert.NoError(t, err)
+
+	internalProxy = &usersBulkProxy{
+		getUsersByIdsAttr: func(ctx context.Context, p *usersBulkProxy, userIds []string) ([]platformclientv2.User, *platformclientv2.APIResponse, error) {
+			return []platformclientv2.User{unchangedUser, renamedUser, appliedUser}, nil, nil
+		},
+	}
+	defer func() { internalProxy = nil }()
+
+	d := schema.TestResourceDataRaw(t, ResourceUsersBulk().Schema, map[string]interface{}{"filepath": "users.csv"})
+	d.SetId(uuid.NewString())
+	_ = d.Set("file_content_hash", "file-hash")
+	_ = d.Set("users", flattenBulkUserStates(map[string]bulkUserState{
+		"unchanged@example.com": {UserId: *unchangedUser.Id, RowHash: "unchanged-row", UserHash: unchangedHash},
+		"renamed@example.com":   {UserId: *renamedUser.Id, RowHash: "renamed-row", UserHash: unchangedHash},
+		"applied@example.com":   {UserId: *appliedUser.Id, RowHash: "applied-row"},
+	}))
+
+	diags := readUsersBulk(context.Background(), d, &provider.ProviderMeta{ClientConfig: &platformclientv2.Configuration{}})
+	assert.False(t, diags.HasError(), diags)
+
+	states := buildBulkUserStates(d)
+	assert.Equal(t, "unchanged-row", states["unchanged@example.com"].RowHash)
+	// The user renamed outside of Terraform is applied again on the next apply
+	assert.Empty(t, states["renamed@example.com"].RowHash)
+	assert.Empty(t, states["renamed@example.com"].UserHash)
+	assert.Empty(t, d.Get("file_content_hash").(string))
+	// The values of a user whose row was just applied are recorded
+	assert.Equal(t, "applied-row", states["applied@example.com"].RowHash)
+	assert.Equal(t, appliedHash, states["applied@example.com"].UserHash)
+}
+
+func TestUnitDeleteUsersBulkKeepsFailedUsers(t *testing.T) {
+	deletedId := uuid.NewString()
+	failingId := uuid.NewString()
+
+	internalProxy = &usersBulkProxy{
+		deleteUserAttr: func(ctx context.Context, p *usersBulkProxy, userId string) (*platformclientv2.APIResponse, error) {
+			if userId == failingId {
+				return nil, fmt.Errorf("user is locked")
+			}
+			return nil, nil
+		},
+	}
+	defer func() { internalProxy = nil }()
+
+	d := schema.TestResourceDataRaw(t, ResourceUsersBulk().Schema, map[string]interface{}{"filepath": "users.csv"})
+	d.SetId(uuid.NewString())
+	_ = d.Set("users", flattenBulkUserStates(map[string]bulkUserState{
+		"deleted@example.com": {UserId: deletedId},
+		"failing@example.com": {UserId: failingId},
+	}))
+
+	diags := deleteUsersBulk(context.Background(), d, &provider.ProviderMeta{ClientConfig: &platformclientv2.Configuration{}})
+	assert.True(t, diags.HasError())
+
+	states := buildBulkUserStates(d)
+	assert.NotContains(t, states, "deleted@example.com")
+	assert.Equal(t, failingId, states["failing@example.com"].UserId)
+}
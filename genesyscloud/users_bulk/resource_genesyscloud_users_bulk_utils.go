@@ -0,0 +1,370 @@
+package users_bulk
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/mail"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/util"
+	"github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/util/files"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mypurecloud/platform-client-sdk-go/v192/platformclientv2"
+)
+
+const (
+	// csvListSeparator separates the items of a list column in a CSV file, e.g. "queue-id-1;queue-id-2"
+	csvListSeparator = ";"
+	// csvProficiencySeparator separates an ID from its proficiency in a CSV file, e.g. "skill-id:4.5"
+	csvProficiencySeparator = ":"
+)
+
+var csvColumns = []string{"email", "name", "title", "department", "division_id", "manager", "routing_skills", "routing_languages", "queue_ids", "role_ids"}
+
+// bulkUser is a single row of a users file
+type bulkUser struct {
+	Email            string             `json:"email"`
+	Name             string             `json:"name"`
+	Title            string             `json:"title,omitempty"`
+	Department       string             `json:"department,omitempty"`
+	DivisionId       string             `json:"division_id,omitempty"`
+	Manager          string             `json:"manager,omitempty"`
+	RoutingSkills    []bulkUserSkill    `json:"routing_skills,omitempty"`
+	RoutingLanguages []bulkUserLanguage `json:"routing_languages,omitempty"`
+	QueueIds         []string           `json:"queue_ids,omitempty"`
+	RoleIds          []string           `json:"role_ids,omitempty"`
+
+	// row is the position of the user in the file, used to point at the row in warnings
+	row int
+	// parseErr is set when a cell of the row could not be parsed
+	parseErr error
+}
+
+type bulkUserSkill struct {
+	SkillId     string  `json:"skill_id"`
+	Proficiency float64 `json:"proficiency"`
+}
+
+type bulkUserLanguage struct {
+	LanguageId  string `json:"language_id"`
+	Proficiency int    `json:"proficiency"`
+}
+
+// bulkUserState is what the resource remembers about a user it manages
+type bulkUserState struct {
+	UserId   string
+	RowHash  string
+	UserHash string
+	QueueIds []string
+}
+
+// readBulkUsersFile reads the users from a CSV or JSON file. Rows that cannot be used are returned as warnings together
+// with their emails, so that the users they refer to are left alone rather than deleted.
+func readBulkUsersFile(ctx context.Context, path string) (users []bulkUser, skippedEmails []string, diags diag.Diagnostics) {
+	reader, file, err := files.DownloadOrOpenFile(ctx, path, S3Enabled)
+	if err != nil {
+		return nil, nil, util.BuildDiagnosticError(ResourceType, fmt.Sprintf("Failed to open users file %s", path), err)
+	}
+	if file != nil {
+		defer file.Close()
+	}
+
+	content, err := io.ReadAll(reader)
+	if err != nil {
+		return nil, nil, util.BuildDiagnosticError(ResourceType, fmt.Sprintf("Failed to read users file %s", path), err)
+	}
+
+	var rows []bulkUser
+	if strings.EqualFold(filepath.Ext(path), ".json") {
+		rows, err = parseBulkUsersJson(content)
+	} else {
+		rows, err = parseBulkUsersCsv(content)
+	}
+	if err != nil {
+		return nil, nil, util.BuildDiagnosticError(ResourceType, fmt.Sprintf("Failed to parse users file %s", path), err)
+	}
+
+	seen := make(map[string]bool)
+	for _, row := range rows {
+		if err := validateBulkUser(row); err != nil {
+			diags = append(diags, bulkUserRowWarning(row, err))
+			if row.Email != "" {
+				skippedEmails = append(skippedEmails, row.Email)
+			}
+			continue
+		}
+		if seen[row.Email] {
+			diags = append(diags, bulkUserRowWarning(row, fmt.Errorf("email %s is used by an earlier row", row.Email)))
+			continue
+		}
+		seen[row.Email] = true
+		users = append(users, normalizeBulkUser(row))
+	}
+	return users, skippedEmails, diags
+}
+
+func parseBulkUsersJson(content []byte) ([]bulkUser, error) {
+	decoder := json.NewDecoder(bytes.NewReader(content))
+	decoder.DisallowUnknownFields()
+
+	var rows []bulkUser
+	if err := decoder.Decode(&rows); err != nil {
+		return nil, err
+	}
+	for i := range rows {
+		rows[i].row = i + 1
+	}
+	return rows, nil
+}
+
+// parseBulkUsersCsv parses a CSV file with a header row. Cells that cannot be parsed are recorded on their row.
+func parseBulkUsersCsv(content []byte) ([]bulkUser, error) {
+	reader := csv.NewReader(bytes.NewReader(content))
+	reader.TrimLeadingSpace = true
+	reader.FieldsPerRecord = -1
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read header row: %v", err)
+	}
+	columns := make(map[string]int)
+	for i, column := range header {
+		column = strings.ToLower(strings.TrimSpace(column))
+		if !isKnownCsvColumn(column) {
+			return nil, fmt.Errorf("unknown column %q, supported columns are %s", column, strings.Join(csvColumns, ", "))
+		}
+		columns[column] = i
+	}
+
+	var rows []bulkUser
+	for line := 2; ; line++ {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		cell := func(column string) string {
+			if i, ok := columns[column]; ok && i < len(record) {
+				return strings.TrimSpace(record[i])
+			}
+			return ""
+		}
+
+		row := bulkUser{
+			Email:      cell("email"),
+			Name:       cell("name"),
+			Title:      cell("title"),
+			Department: cell("department"),
+			DivisionId: cell("division_id"),
+			Manager:    cell("manager"),
+			QueueIds:   splitCsvList(cell("queue_ids")),
+			RoleIds:    splitCsvList(cell("role_ids")),
+			row:        line,
+		}
+
+		row.RoutingSkills, row.parseErr = parseCsvSkills(cell("routing_skills"))
+		if row.parseErr == nil {
+			row.RoutingLanguages, row.parseErr = parseCsvLanguages(cell("routing_languages"))
+		}
+
+		rows = append(rows, row)
+	}
+	return rows, nil
+}
+
+func isKnownCsvColumn(column string) bool {
+	for _, known := range csvColumns {
+		if column == known {
+			return true
+		}
+	}
+	return false
+}
+
+func splitCsvList(value string) []string {
+	var items []string
+	for _, item := range strings.Split(value, csvListSeparator) {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+func parseCsvSkills(value string) ([]bulkUserSkill, error) {
+	var skills []bulkUserSkill
+	for _, item := range splitCsvList(value) {
+		id, proficiency, found := strings.Cut(item, csvProficiencySeparator)
+		if !found {
+			return nil, fmt.Errorf("routing skill %q must have the format <skill_id>%s<proficiency>", item, csvProficiencySeparator)
+		}
+		prof, err := strconv.ParseFloat(strings.TrimSpace(proficiency), 64)
+		if err != nil {
+			return nil, fmt.Errorf("routing skill %q has an invalid proficiency: %v", item, err)
+		}
+		skills = append(skills, bulkUserSkill{SkillId: strings.TrimSpace(id), Proficiency: prof})
+	}
+	return skills, nil
+}
+
+func parseCsvLanguages(value string) ([]bulkUserLanguage, error) {
+	var languages []bulkUserLanguage
+	for _, item := range splitCsvList(value) {
+		id, proficiency, found := strings.Cut(item, csvProficiencySeparator)
+		if !found {
+			return nil, fmt.Errorf("routing language %q must have the format <language_id>%s<proficiency>", item, csvProficiencySeparator)
+		}
+		prof, err := strconv.Atoi(strings.TrimSpace(proficiency))
+		if err != nil {
+			return nil, fmt.Errorf("routing language %q has an invalid proficiency: %v", item, err)
+		}
+		languages = append(languages, bulkUserLanguage{LanguageId: strings.TrimSpace(id), Proficiency: prof})
+	}
+	return languages, nil
+}
+
+func validateBulkUser(row bulkUser) error {
+	if row.parseErr != nil {
+		return row.parseErr
+	}
+	if row.Email == "" {
+		return fmt.Errorf("email is required")
+	}
+	if _, err := mail.ParseAddress(row.Email); err != nil {
+		return fmt.Errorf("invalid email %s: %v", row.Email, err)
+	}
+	if row.Name == "" {
+		return fmt.Errorf("name is required")
+	}
+	if row.Manager != "" && strings.EqualFold(row.Manager, row.Email) {
+		return fmt.Errorf("user cannot be their own manager")
+	}
+	for _, skill := range row.RoutingSkills {
+		if skill.SkillId == "" || skill.Proficiency < 0 || skill.Proficiency > 5 {
+			return fmt.Errorf("routing skill %q must have an ID and a proficiency between 0 and 5", skill.SkillId)
+		}
+	}
+	for _, language := range row.RoutingLanguages {
+		if language.LanguageId == "" || language.Proficiency < 0 || language.Proficiency > 5 {
+			return fmt.Errorf("routing language %q must have an ID and a proficiency between 0 and 5", language.LanguageId)
+		}
+	}
+	return nil
+}
+
+// normalizeBulkUser sorts the lists of a row so that the row hash does not depend on the order of items in the file
+func normalizeBulkUser(row bulkUser) bulkUser {
+	sort.Slice(row.RoutingSkills, func(i, j int) bool { return row.RoutingSkills[i].SkillId < row.RoutingSkills[j].SkillId })
+	sort.Slice(row.RoutingLanguages, func(i, j int) bool { return row.RoutingLanguages[i].LanguageId < row.RoutingLanguages[j].LanguageId })
+	sort.Strings(row.QueueIds)
+	sort.Strings(row.RoleIds)
+	return row
+}
+
+func hashBulkUser(row bulkUser) (string, error) {
+	return util.QuickHashFields(row)
+}
+
+// hashLiveBulkUser hashes the fields of a user in Genesys Cloud that are compared to detect changes made outside of Terraform
+func hashLiveBulkUser(user platformclientv2.User) (string, error) {
+	var divisionId string
+	if user.Division != nil && user.Division.Id != nil {
+		divisionId = *user.Division.Id
+	}
+	return util.QuickHashFields(struct {
+		Name       string
+		Email      string
+		DivisionId string
+		State      string
+	}{
+		Name:       util.StringOrNil(user.Name),
+		Email:      util.StringOrNil(user.Email),
+		DivisionId: divisionId,
+		State:      util.StringOrNil(user.State),
+	})
+}
+
+func bulkUserRowWarning(row bulkUser, err error) diag.Diagnostic {
+	summary := fmt.Sprintf("Skipped row %d of the users file", row.row)
+	if row.Email != "" {
+		summary = fmt.Sprintf("Failed to apply row %d (%s) of the users file", row.row, row.Email)
+	}
+	return diag.Diagnostic{
+		Severity: diag.Warning,
+		Summary:  summary,
+		Detail:   err.Error(),
+	}
+}
+
+func buildSkillProficiencies(skills []bulkUserSkill) map[string]float64 {
+	skillProfs := make(map[string]float64, len(skills))
+	for _, skill := range skills {
+		skillProfs[skill.SkillId] = skill.Proficiency
+	}
+	return skillProfs
+}
+
+func buildLanguageProficiencies(languages []bulkUserLanguage) map[string]int {
+	langProfs := make(map[string]int, len(languages))
+	for _, language := range languages {
+		langProfs[language.LanguageId] = language.Proficiency
+	}
+	return langProfs
+}
+
+func buildBulkUserStates(d *schema.ResourceData) map[string]bulkUserState {
+	states := make(map[string]bulkUserState)
+	for _, item := range d.Get("users").([]interface{}) {
+		userMap := item.(map[string]interface{})
+		var queueIds []string
+		if queues, ok := userMap["queue_ids"].(*schema.Set); ok {
+			for _, queueId := range queues.List() {
+				queueIds = append(queueIds, queueId.(string))
+			}
+		}
+		states[userMap["email"].(string)] = bulkUserState{
+			UserId:   userMap["user_id"].(string),
+			RowHash:  userMap["row_hash"].(string),
+			UserHash: userMap["user_hash"].(string),
+			QueueIds: queueIds,
+		}
+	}
+	return states
+}
+
+func flattenBulkUserStates(states map[string]bulkUserState) []interface{} {
+	emails := make([]string, 0, len(states))
+	for email := range states {
+		emails = append(emails, email)
+	}
+	sort.Strings(emails)
+
+	users := make([]interface{}, 0, len(states))
+	for _, email := range emails {
+		state := states[email]
+		queueIds := make([]interface{}, len(state.QueueIds))
+		for i, queueId := range state.QueueIds {
+			queueIds[i] = queueId
+		}
+		users = append(users, map[string]interface{}{
+			"email":     email,
+			"user_id":   state.UserId,
+			"row_hash":  state.RowHash,
+			"user_hash": state.UserHash,
+			"queue_ids": schema.NewSet(schema.HashString, queueIds),
+		})
+	}
+	return users
+}
@@ -31,6 +31,7 @@ type S3Uploader struct {
 	bodyBuf       *bytes.Buffer
 	Writer        *multipart.Writer
 	substitutions map[string]interface{}
+	template      *TemplateSettings
 	headers       map[string]string
 	httpMethod    string
 	presignedUrl  string
@@ -61,17 +62,31 @@ func NewS3Uploader(reader io.Reader, formData map[string]io.Reader, substitution
 	return s3Uploader
 }
 
-func (s *S3Uploader) substituteValues() {
-	// Attribute specific to the flows resource
-	if len(s.substitutions) > 0 {
-		fileContents := s.bodyBuf.String()
-		for k, v := range s.substitutions {
-			fileContents = strings.Replace(fileContents, fmt.Sprintf("{{%s}}", k), v.(string), -1)
+// SetTemplateSettings enables text/template rendering of the uploaded content after substitutions are applied
+func (s *S3Uploader) SetTemplateSettings(settings *TemplateSettings) {
+	s.template = settings
+}
+
+func (s *S3Uploader) substituteValues() error {
+	// Attribute specific to the flows and scripts resources
+	if len(s.substitutions) > 0 || s.template != nil {
+		fileContents, err := RenderFileContent(s.bodyBuf.String(), s.substitutions, s.template)
+		if err != nil {
+			return err
 		}
 
 		s.bodyBuf.Reset()
 		s.bodyBuf.WriteString(fileContents)
 	}
+	return nil
+}
+
+// SubstituteValues replaces every {{key}} placeholder in content with the matching substitution value
+func SubstituteValues(content string, substitutions map[string]interface{}) string {
+	for k, v := range substitutions {
+		content = strings.Replace(content, fmt.Sprintf("{{%s}}", k), v.(string), -1)
+	}
+	return content
 }
 
 func (s *S3Uploader) Upload() ([]byte, error) {
@@ -95,7 +110,9 @@ func UploadFn(s *S3Uploader) ([]byte, error) {
 		}
 	}
 
-	s.substituteValues()
+	if err := s.substituteValues(); err != nil {
+		return nil, err
+	}
 
 	req, _ := http.NewRequest(s.httpMethod, s.presignedUrl, s.bodyBuf)
 	for key, value := range s.headers {
@@ -0,0 +1,119 @@
+package files
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"text/template"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// TemplateSettings configures the optional text/template rendering of an uploaded file
+type TemplateSettings struct {
+	Variables  map[string]interface{}
+	LeftDelim  string
+	RightDelim string
+}
+
+// TemplateResource is the schema of the opt-in template block shared by resources that upload files with substitutions
+var TemplateResource = &schema.Resource{
+	Schema: map[string]*schema.Schema{
+		"variables": {
+			Description:  "JSON encoded object of typed values available to the template, e.g. `jsonencode({ brand = \"acme\", queues = [\"Sales\", \"Support\"] })`. Values are accessed with the dot notation, e.g. `{{ .brand }}`, and support conditionals (`{{ if }}`) and loops (`{{ range }}`).",
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringIsJSON,
+		},
+		"left_delimiter": {
+			Description: "Left delimiter of template actions. Set this when the file already uses `{{` for its own syntax.",
+			Type:        schema.TypeString,
+			Optional:    true,
+			Default:     "{{",
+		},
+		"right_delimiter": {
+			Description: "Right delimiter of template actions.",
+			Type:        schema.TypeString,
+			Optional:    true,
+			Default:     "}}",
+		},
+	},
+}
+
+type attributeGetter interface {
+	Get(key string) interface{}
+}
+
+// BuildTemplateSettings reads the template block from resource data or a resource diff. It returns nil when templating is not enabled.
+func BuildTemplateSettings(d attributeGetter, attr string) (*TemplateSettings, error) {
+	templateList, _ := d.Get(attr).([]interface{})
+	if len(templateList) == 0 || templateList[0] == nil {
+		return nil, nil
+	}
+	templateMap := templateList[0].(map[string]interface{})
+
+	settings := &TemplateSettings{
+		LeftDelim:  templateMap["left_delimiter"].(string),
+		RightDelim: templateMap["right_delimiter"].(string),
+	}
+	if err := json.Unmarshal([]byte(templateMap["variables"].(string)), &settings.Variables); err != nil {
+		return nil, fmt.Errorf("failed to parse template variables: %v", err)
+	}
+	return settings, nil
+}
+
+// RenderTemplate executes content as a text/template with the configured variables. Referencing a variable that is not set is an error.
+func RenderTemplate(content string, settings *TemplateSettings) (string, error) {
+	tmpl, err := template.New("file").Delims(settings.LeftDelim, settings.RightDelim).Option("missingkey=error").Parse(content)
+	if err != nil {
+		return "", fmt.Errorf("failed to parse template: %v", err)
+	}
+
+	var rendered bytes.Buffer
+	if err := tmpl.Execute(&rendered, settings.Variables); err != nil {
+		return "", fmt.Errorf("failed to render template: %v", err)
+	}
+	return rendered.String(), nil
+}
+
+// RenderFileContent applies literal substitutions to content and then renders it as a template when templating is enabled
+func RenderFileContent(content string, substitutions map[string]interface{}, settings *TemplateSettings) (string, error) {
+	content = SubstituteValues(content, substitutions)
+	if settings == nil {
+		return content, nil
+	}
+	return RenderTemplate(content, settings)
+}
+
+// HashRenderedFileContent hashes the rendered file content when templating is enabled, and the raw file content otherwise
+func HashRenderedFileContent(ctx context.Context, path string, supportS3 bool, substitutions map[string]interface{}, settings *TemplateSettings) (string, error) {
+	if settings == nil {
+		return HashFileContent(ctx, path, supportS3)
+	}
+
+	reader, file, err := DownloadOrOpenFile(ctx, path, supportS3)
+	if err != nil {
+		return "", fmt.Errorf("unable to open file: %v", err.Error())
+	}
+	if file != nil {
+		defer file.Close()
+	}
+
+	content, err := io.ReadAll(reader)
+	if err != nil {
+		return "", fmt.Errorf("unable to read file content: %v", err.Error())
+	}
+
+	rendered, err := RenderFileContent(string(content), substitutions, settings)
+	if err != nil {
+		return "", err
+	}
+
+	hash := sha256.Sum256([]byte(rendered))
+	return hex.EncodeToString(hash[:]), nil
+}
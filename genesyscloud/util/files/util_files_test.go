@@ -126,7 +126,8 @@ func TestUnitSubstitutions(t *testing.T) {
 	var original bytes.Buffer
 	fmt.Fprint(&original, origYamlFile)
 	s3Uploader.bodyBuf = &original
-	s3Uploader.substituteValues()
+	err := s3Uploader.substituteValues()
+	assert.NoError(t, err)
 
 	assert.Equal(t, string(expcYamlFile), original.String())
 
@@ -432,3 +433,43 @@ func TestUnitGetCSVRecordCount_NonexistentFile(t *testing.T) {
 		t.Error("Expected error for nonexistent file, got none")
 	}
 }
+
+func TestUnitRenderFileContent(t *testing.T) {
+	content := `inboundCall:
+  name: {{name}}
+  menus:
+{{- range .queues }}
+    - menu:
+        name: {{ . }}
+{{- end }}
+{{- if .afterHours }}
+  closed: true
+{{- end }}`
+	expected := `inboundCall:
+  name: Acme IVR
+  menus:
+    - menu:
+        name: Sales
+    - menu:
+        name: Support
+  closed: true`
+
+	substitutions := map[string]interface{}{"name": "Acme IVR"}
+	settings := &TemplateSettings{
+		Variables:  map[string]interface{}{"queues": []interface{}{"Sales", "Support"}, "afterHours": true},
+		LeftDelim:  "{{",
+		RightDelim: "}}",
+	}
+
+	rendered, err := RenderFileContent(content, substitutions, settings)
+	assert.NoError(t, err)
+	assert.Equal(t, expected, rendered)
+
+	_, err = RenderFileContent(`name: {{ .missing }}`, nil, settings)
+	assert.Error(t, err)
+
+	settings.LeftDelim, settings.RightDelim = "[[", "]]"
+	rendered, err = RenderFileContent(`{"text": "{{Scripter.Agent Name}}", "brand": "[[ index .queues 0 ]]"}`, nil, settings)
+	assert.NoError(t, err)
+	assert.Equal(t, `{"text": "{{Scripter.Agent Name}}", "brand": "Sales"}`, rendered)
+}
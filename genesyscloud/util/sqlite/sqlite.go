@@ -0,0 +1,468 @@
+/*
+Package sqlite writes SQLite database files without cgo. A Database is built in memory and written at once, which is all
+the exporter needs to write a queryable snapshot of an org. It implements the subset of the file format needed for that:
+rowid tables in B-trees of any depth, overflow pages for large values, and NULL, integer, real, text and blob values.
+Indexes, updates and reads are not supported.
+
+The files have these limits:
+  - Each table is a single rowid B-tree. Rows get the rowids 1 to n in insert order, and there are no primary keys,
+    constraints or WITHOUT ROWID tables.
+  - There are no indexes, so queries that filter on a column scan the whole table. Readers can add indexes once the
+    file is written.
+  - The page size is fixed at 4096 bytes. Page 1 also holds the 100 byte file header, so the schema table root has
+    3996 bytes, and a file has at most 2^32-1 pages. The whole file is built in memory before it is written.
+  - Readers reject a value larger than their SQLITE_MAX_LENGTH, 1 GB by default.
+
+See https://www.sqlite.org/fileformat2.html
+*/
+package sqlite
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+	"os"
+	"strings"
+)
+
+const (
+	pageSize        = 4096
+	fileHeaderSize  = 100
+	leafHeaderSize  = 8
+	innerHeaderSize = 12
+
+	leafTablePage     = 0x0d
+	interiorTablePage = 0x05
+
+	// The largest payload stored in a leaf cell, and the smallest part of a larger payload kept in it
+	maxLocalPayload = pageSize - 35
+	minLocalPayload = (pageSize-12)*32/255 - 23
+
+	// The SQLite version that the file claims to be written by
+	sqliteVersionNumber = 3045000
+)
+
+// Column is a column of a table. An empty type declares a column without type affinity.
+type Column struct {
+	Name string
+	Type string
+}
+
+// Table is a rowid table of a Database
+type Table struct {
+	Name    string
+	Columns []Column
+	rows    [][]interface{}
+}
+
+// Database is a SQLite database built in memory
+type Database struct {
+	tables []*Table
+	names  map[string]bool
+}
+
+// New returns an empty database
+func New() *Database {
+	return &Database{names: make(map[string]bool)}
+}
+
+// CreateTable adds a table to the database. Table names are case insensitive, like in SQLite.
+func (db *Database) CreateTable(name string, columns ...Column) (*Table, error) {
+	if name == "" || strings.HasPrefix(strings.ToLower(name), "sqlite_") {
+		return nil, fmt.Errorf("invalid table name %q", name)
+	}
+	if len(columns) == 0 {
+		return nil, fmt.Errorf("table %s has no columns", name)
+	}
+	if db.names[strings.ToLower(name)] {
+		return nil, fmt.Errorf("table %s already exists", name)
+	}
+	db.names[strings.ToLower(name)] = true
+
+	table := &Table{Name: name, Columns: columns}
+	db.tables = append(db.tables, table)
+	return table, nil
+}
+
+// Insert adds a row to the table. Values must be nil, bool, int, int64, float64, string or []byte.
+func (t *Table) Insert(values ...interface{}) error {
+	if len(values) != len(t.Columns) {
+		return fmt.Errorf("table %s has %d columns but %d values were inserted", t.Name, len(t.Columns), len(values))
+	}
+	for i, value := range values {
+		switch value.(type) {
+		case nil, bool, int, int64, float64, string, []byte:
+		default:
+			return fmt.Errorf("unsupported value of type %T for column %s of table %s", value, t.Columns[i].Name, t.Name)
+		}
+	}
+	t.rows = append(t.rows, values)
+	return nil
+}
+
+// Rows returns the rows inserted in the table
+func (t *Table) Rows() [][]interface{} {
+	return t.rows
+}
+
+// Tables returns the tables of the database in creation order
+func (db *Database) Tables() []*Table {
+	return db.tables
+}
+
+// createTableSQL returns the statement that creates the table, as stored in the schema table
+func (t *Table) createTableSQL() string {
+	columns := make([]string, 0, len(t.Columns))
+	for _, column := range t.Columns {
+		definition := quoteIdentifier(column.Name)
+		if column.Type != "" {
+			definition += " " + column.Type
+		}
+		columns = append(columns, definition)
+	}
+	return fmt.Sprintf("CREATE TABLE %s (%s)", quoteIdentifier(t.Name), strings.Join(columns, ", "))
+}
+
+func quoteIdentifier(name string) string {
+	return `"` + strings.ReplaceAll(name, `"`, `""`) + `"`
+}
+
+// WriteFile writes the database to a file
+func (db *Database) WriteFile(path string) error {
+	content, err := db.Bytes()
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, content, 0644)
+}
+
+// Bytes returns the content of the database file
+func (db *Database) Bytes() ([]byte, error) {
+	w := &fileWriter{}
+	// Page 1 holds the file header and the root of the schema table
+	schemaRoot := w.allocate()
+
+	schemaRows := make([][]interface{}, 0, len(db.tables))
+	for _, table := range db.tables {
+		root := w.allocate()
+		if err := w.writeTable(root, table.rows); err != nil {
+			return nil, fmt.Errorf("failed to write table %s: %w", table.Name, err)
+		}
+		schemaRows = append(schemaRows, []interface{}{"table", table.Name, table.Name, int64(root), table.createTableSQL()})
+	}
+	if err := w.writeTable(schemaRoot, schemaRows); err != nil {
+		return nil, fmt.Errorf("failed to write the schema table: %w", err)
+	}
+
+	w.writeFileHeader()
+	content := make([]byte, 0, len(w.pages)*pageSize)
+	for _, page := range w.pages {
+		content = append(content, page...)
+	}
+	return content, nil
+}
+
+// fileWriter lays out the pages of a database file. pages[i] is page i+1.
+type fileWriter struct {
+	pages [][]byte
+}
+
+func (w *fileWriter) allocate() int {
+	w.pages = append(w.pages, make([]byte, pageSize))
+	return len(w.pages)
+}
+
+// headerOffset returns the offset of the B-tree page header, which follows the file header on page 1
+func headerOffset(page int) int {
+	if page == 1 {
+		return fileHeaderSize
+	}
+	return 0
+}
+
+func (w *fileWriter) writeFileHeader() {
+	header := w.pages[0][:fileHeaderSize]
+	copy(header, "SQLite format 3\x00")
+	binary.BigEndian.PutUint16(header[16:], pageSize)
+	header[18] = 1 // Legacy write version
+	header[19] = 1 // Legacy read version
+	header[21] = 64
+	header[22] = 32
+	header[23] = 32
+	binary.BigEndian.PutUint32(header[24:], 1) // File change counter
+	binary.BigEndian.PutUint32(header[28:], uint32(len(w.pages)))
+	binary.BigEndian.PutUint32(header[40:], 1) // Schema cookie
+	binary.BigEndian.PutUint32(header[44:], 4) // Schema format
+	binary.BigEndian.PutUint32(header[56:], 1) // UTF-8
+	binary.BigEndian.PutUint32(header[92:], 1) // Version valid for the file change counter
+	binary.BigEndian.PutUint32(header[96:], sqliteVersionNumber)
+}
+
+// btreeNode is a page of a table B-tree before it is written. Leaf nodes hold cells, interior nodes hold the page
+// number and the largest rowid of each child.
+type btreeNode struct {
+	leaf      bool
+	cells     [][]byte
+	rowids    []int64
+	children  []int
+	maxRowids []int64
+}
+
+func (n *btreeNode) size() int {
+	if n.leaf {
+		size := leafHeaderSize
+		for _, cell := range n.cells {
+			size += 2 + len(cell)
+		}
+		return size
+	}
+	// The last child is the right-most pointer of the page header and has no cell
+	size := innerHeaderSize
+	for i := 0; i < len(n.children)-1; i++ {
+		size += 2 + 4 + len(putVarint(uint64(n.maxRowids[i])))
+	}
+	return size
+}
+
+func (n *btreeNode) maxRowid() int64 {
+	if n.leaf {
+		if len(n.rowids) == 0 {
+			return 0
+		}
+		return n.rowids[len(n.rowids)-1]
+	}
+	return n.maxRowids[len(n.maxRowids)-1]
+}
+
+// split divides a node in two halves, for a root that does not fit on page 1
+func (n *btreeNode) split() ([]*btreeNode, error) {
+	if n.leaf {
+		if len(n.cells) < 2 {
+			return nil, fmt.Errorf("a row of %d bytes does not fit on the first page", len(n.cells[0]))
+		}
+		half := len(n.cells) / 2
+		return []*btreeNode{
+			{leaf: true, cells: n.cells[:half], rowids: n.rowids[:half]},
+			{leaf: true, cells: n.cells[half:], rowids: n.rowids[half:]},
+		}, nil
+	}
+	half := len(n.children) / 2
+	return []*btreeNode{
+		{children: n.children[:half], maxRowids: n.maxRowids[:half]},
+		{children: n.children[half:], maxRowids: n.maxRowids[half:]},
+	}, nil
+}
+
+// writeTable writes the rows of a table in a B-tree whose root is the given page. Rows get rowids from 1.
+func (w *fileWriter) writeTable(root int, rows [][]interface{}) error {
+	level := []*btreeNode{{leaf: true}}
+	for i, row := range rows {
+		rowid := int64(i + 1)
+		cell := w.leafCell(rowid, encodeRecord(row))
+		node := level[len(level)-1]
+		if node.size()+2+len(cell) > pageSize && len(node.cells) > 0 {
+			node = &btreeNode{leaf: true}
+			level = append(level, node)
+		}
+		node.cells = append(node.cells, cell)
+		node.rowids = append(node.rowids, rowid)
+	}
+
+	for {
+		if len(level) == 1 {
+			if level[0].size() <= pageSize-headerOffset(root) {
+				w.writeNode(root, level[0])
+				return nil
+			}
+			halves, err := level[0].split()
+			if err != nil {
+				return err
+			}
+			level = halves
+		}
+
+		parents := []*btreeNode{{}}
+		for _, node := range level {
+			page := w.allocate()
+			w.writeNode(page, node)
+			parent := parents[len(parents)-1]
+			parent.children = append(parent.children, page)
+			parent.maxRowids = append(parent.maxRowids, node.maxRowid())
+			if parent.size() > pageSize {
+				// Move the child that does not fit to a new parent
+				last := len(parent.children) - 1
+				parents = append(parents, &btreeNode{children: parent.children[last:], maxRowids: parent.maxRowids[last:]})
+				parent.children, parent.maxRowids = parent.children[:last], parent.maxRowids[:last]
+			}
+		}
+		// Interior pages need at least one cell, so the last parent must have two children
+		if last := parents[len(parents)-1]; len(parents) > 1 && len(last.children) == 1 {
+			previous := parents[len(parents)-2]
+			end := len(previous.children) - 1
+			last.children = append([]int{previous.children[end]}, last.children...)
+			last.maxRowids = append([]int64{previous.maxRowids[end]}, last.maxRowids...)
+			previous.children, previous.maxRowids = previous.children[:end], previous.maxRowids[:end]
+		}
+		level = parents
+	}
+}
+
+// writeNode writes a B-tree node to a page, with its cells at the end of the page in rowid order
+func (w *fileWriter) writeNode(page int, node *btreeNode) {
+	buf := w.pages[page-1]
+	offset := headerOffset(page)
+
+	cells := node.cells
+	headerSize := leafHeaderSize
+	buf[offset] = leafTablePage
+	if !node.leaf {
+		headerSize = innerHeaderSize
+		buf[offset] = interiorTablePage
+		cells = make([][]byte, 0, len(node.children)-1)
+		for i := 0; i < len(node.children)-1; i++ {
+			cell := make([]byte, 4, 13)
+			binary.BigEndian.PutUint32(cell, uint32(node.children[i]))
+			cells = append(cells, append(cell, putVarint(uint64(node.maxRowids[i]))...))
+		}
+		binary.BigEndian.PutUint32(buf[offset+8:], uint32(node.children[len(node.children)-1]))
+	}
+
+	contentStart := pageSize
+	for i, cell := range cells {
+		contentStart -= len(cell)
+		copy(buf[contentStart:], cell)
+		binary.BigEndian.PutUint16(buf[offset+headerSize+2*i:], uint16(contentStart))
+	}
+	binary.BigEndian.PutUint16(buf[offset+3:], uint16(len(cells)))
+	binary.BigEndian.PutUint16(buf[offset+5:], uint16(contentStart))
+}
+
+// leafCell returns the cell of a row on a table leaf page. The part of a large payload that does not fit in the cell
+// is written to a chain of overflow pages.
+func (w *fileWriter) leafCell(rowid int64, payload []byte) []byte {
+	cell := append(putVarint(uint64(len(payload))), putVarint(uint64(rowid))...)
+	if len(payload) <= maxLocalPayload {
+		return append(cell, payload...)
+	}
+
+	local := minLocalPayload + (len(payload)-minLocalPayload)%(pageSize-4)
+	if local > maxLocalPayload {
+		local = minLocalPayload
+	}
+	cell = append(cell, payload[:local]...)
+
+	overflow := payload[local:]
+	first := 0
+	previous := 0
+	for len(overflow) > 0 {
+		page := w.allocate()
+		if previous == 0 {
+			first = page
+		} else {
+			binary.BigEndian.PutUint32(w.pages[previous-1], uint32(page))
+		}
+		n := copy(w.pages[page-1][4:], overflow)
+		overflow = overflow[n:]
+		previous = page
+	}
+	firstPage := make([]byte, 4)
+	binary.BigEndian.PutUint32(firstPage, uint32(first))
+	return append(cell, firstPage...)
+}
+
+// encodeRecord encodes the values of a row in the record format
+func encodeRecord(values []interface{}) []byte {
+	var serialTypes, body []byte
+	for _, value := range values {
+		serialType, data := encodeValue(value)
+		serialTypes = append(serialTypes, putVarint(serialType)...)
+		body = append(body, data...)
+	}
+
+	// The header size includes the varint that holds it
+	headerSize := len(serialTypes) + 1
+	for len(putVarint(uint64(headerSize)))+len(serialTypes) != headerSize {
+		headerSize = len(putVarint(uint64(headerSize))) + len(serialTypes)
+	}
+	record := append(putVarint(uint64(headerSize)), serialTypes...)
+	return append(record, body...)
+}
+
+// encodeValue returns the serial type and the content of a value
+func encodeValue(value interface{}) (uint64, []byte) {
+	switch v := value.(type) {
+	case nil:
+		return 0, nil
+	case bool:
+		if v {
+			return 9, nil
+		}
+		return 8, nil
+	case int:
+		return encodeInteger(int64(v))
+	case int64:
+		return encodeInteger(v)
+	case float64:
+		data := make([]byte, 8)
+		binary.BigEndian.PutUint64(data, math.Float64bits(v))
+		return 7, data
+	case string:
+		return uint64(len(v))*2 + 13, []byte(v)
+	case []byte:
+		return uint64(len(v))*2 + 12, v
+	}
+	return 0, nil
+}
+
+// encodeInteger stores an integer in the smallest of the big-endian two's complement sizes
+func encodeInteger(v int64) (uint64, []byte) {
+	switch {
+	case v == 0:
+		return 8, nil
+	case v == 1:
+		return 9, nil
+	}
+	sizes := []struct {
+		serialType uint64
+		bytes      int
+	}{{1, 1}, {2, 2}, {3, 3}, {4, 4}, {5, 6}, {6, 8}}
+	for _, size := range sizes {
+		bits := uint(size.bytes * 8)
+		if size.bytes == 8 || (v >= -(1<<(bits-1)) && v < 1<<(bits-1)) {
+			data := make([]byte, 8)
+			binary.BigEndian.PutUint64(data, uint64(v))
+			return size.serialType, data[8-size.bytes:]
+		}
+	}
+	return 0, nil
+}
+
+// putVarint encodes a value in the big-endian variable-length integer format of SQLite, where the ninth byte, if
+// any, holds eight bits
+func putVarint(v uint64) []byte {
+	if v&(uint64(0xff000000)<<32) != 0 {
+		buf := make([]byte, 9)
+		buf[8] = byte(v)
+		v >>= 8
+		for i := 7; i >= 0; i-- {
+			buf[i] = byte(v&0x7f) | 0x80
+			v >>= 7
+		}
+		return buf
+	}
+
+	var reversed []byte
+	for {
+		reversed = append(reversed, byte(v&0x7f)|0x80)
+		v >>= 7
+		if v == 0 {
+			break
+		}
+	}
+	reversed[0] &= 0x7f
+	buf := make([]byte, len(reversed))
+	for i := range reversed {
+		buf[i] = reversed[len(reversed)-1-i]
+	}
+	return buf
+}
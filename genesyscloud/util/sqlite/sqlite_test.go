@@ -0,0 +1,241 @@
+package sqlite
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// readVarint decodes a varint written by putVarint
+func readVarint(buf []byte) (uint64, int) {
+	var v uint64
+	for i := 0; i < 8; i++ {
+		v = v<<7 | uint64(buf[i]&0x7f)
+		if buf[i]&0x80 == 0 {
+			return v, i + 1
+		}
+	}
+	return v<<8 | uint64(buf[8]), 9
+}
+
+// readTable returns the records of the table B-tree rooted at a page, in rowid order
+func readTable(t *testing.T, content []byte, root int) [][]interface{} {
+	page := content[(root-1)*pageSize : root*pageSize]
+	offset := headerOffset(root)
+	cellCount := int(binary.BigEndian.Uint16(page[offset+3:]))
+
+	var rows [][]interface{}
+	switch page[offset] {
+	case interiorTablePage:
+		for i := 0; i < cellCount; i++ {
+			cell := page[binary.BigEndian.Uint16(page[offset+innerHeaderSize+2*i:]):]
+			rows = append(rows, readTable(t, content, int(binary.BigEndian.Uint32(cell)))...)
+		}
+		return append(rows, readTable(t, content, int(binary.BigEndian.Uint32(page[offset+8:])))...)
+	case leafTablePage:
+		for i := 0; i < cellCount; i++ {
+			cell := page[binary.BigEndian.Uint16(page[offset+leafHeaderSize+2*i:]):]
+			payloadSize, n := readVarint(cell)
+			_, m := readVarint(cell[n:])
+			cell = cell[n+m:]
+			payload := make([]byte, 0, payloadSize)
+			if payloadSize <= maxLocalPayload {
+				payload = append(payload, cell[:payloadSize]...)
+			} else {
+				local := minLocalPayload + (int(payloadSize)-minLocalPayload)%(pageSize-4)
+				if local > maxLocalPayload {
+					local = minLocalPayload
+				}
+				payload = append(payload, cell[:local]...)
+				for next := binary.BigEndian.Uint32(cell[local:]); next != 0; {
+					overflow := content[(int(next)-1)*pageSize : int(next)*pageSize]
+					size := int(payloadSize) - len(payload)
+					if size > pageSize-4 {
+						size = pageSize - 4
+					}
+					payload = append(payload, overflow[4:4+size]...)
+					next = binary.BigEndian.Uint32(overflow)
+				}
+			}
+			rows = append(rows, decodeRecord(t, payload))
+		}
+		return rows
+	}
+	t.Fatalf("page %d is not a table B-tree page", root)
+	return nil
+}
+
+func decodeRecord(t *testing.T, record []byte) []interface{} {
+	headerSize, n := readVarint(record)
+	header, body := record[n:headerSize], record[headerSize:]
+	var values []interface{}
+	for len(header) > 0 {
+		serialType, n := readVarint(header)
+		header = header[n:]
+		switch {
+		case serialType == 0:
+			values = append(values, nil)
+		case serialType >= 1 && serialType <= 6:
+			size := []int{0, 1, 2, 3, 4, 6, 8}[serialType]
+			v := int64(0)
+			if body[0]&0x80 != 0 {
+				v = -1
+			}
+			for _, b := range body[:size] {
+				v = v<<8 | int64(b)
+			}
+			values = append(values, v)
+			body = body[size:]
+		case serialType == 7:
+			values = append(values, math.Float64frombits(binary.BigEndian.Uint64(body)))
+			body = body[8:]
+		case serialType == 8 || serialType == 9:
+			values = append(values, int64(serialType-8))
+		case serialType >= 13 && serialType%2 == 1:
+			size := int(serialType-13) / 2
+			values = append(values, string(body[:size]))
+			body = body[size:]
+		case serialType >= 12:
+			size := int(serialType-12) / 2
+			values = append(values, body[:size])
+			body = body[size:]
+		default:
+			t.Fatalf("unexpected serial type %d", serialType)
+		}
+	}
+	return values
+}
+
+// TestUnitSQLiteDatabaseRoundTrip asserts that tables of every size, including rows with overflow pages and B-trees
+// with several levels, are written so that their rows read back in order
+func TestUnitSQLiteDatabaseRoundTrip(t *testing.T) {
+	db := New()
+	small, err := db.CreateTable("small", Column{Name: "name", Type: "TEXT"}, Column{Name: "value"})
+	require.NoError(t, err)
+	require.NoError(t, small.Insert("a", nil))
+	require.NoError(t, small.Insert("b", int64(-70000)))
+	require.NoError(t, small.Insert(`quoted "name"`, 1.5))
+	require.NoError(t, small.Insert("d", true))
+	require.NoError(t, small.Insert("e", []byte{0, 1, 2}))
+	require.NoError(t, small.Insert("f", math.MaxInt64))
+
+	large, err := db.CreateTable("large", Column{Name: "id", Type: "INTEGER"}, Column{Name: "content", Type: "TEXT"})
+	require.NoError(t, err)
+	const largeRows = 20000
+	for i := 0; i < largeRows; i++ {
+		content := "row"
+		if i%1000 == 0 {
+			content = strings.Repeat("x", 10000+i)
+		}
+		require.NoError(t, large.Insert(i, content))
+	}
+
+	empty, err := db.CreateTable("empty", Column{Name: "id"})
+	require.NoError(t, err)
+	_ = empty
+
+	_, err = db.CreateTable("SMALL", Column{Name: "id"})
+	assert.Error(t, err, "table names are case insensitive")
+	assert.Error(t, small.Insert("too", "many", "values"))
+	assert.Error(t, small.Insert("a", struct{}{}))
+
+	content, err := db.Bytes()
+	require.NoError(t, err)
+	require.Equal(t, 0, len(content)%pageSize)
+	assert.Equal(t, "SQLite format 3\x00", string(content[:16]))
+	assert.Equal(t, uint32(len(content)/pageSize), binary.BigEndian.Uint32(content[28:]))
+
+	schema := readTable(t, content, 1)
+	require.Len(t, schema, 3)
+	assert.Equal(t, []interface{}{"table", "small", "small", int64(2), `CREATE TABLE "small" ("name" TEXT, "value")`}, schema[0])
+	assert.Equal(t, `CREATE TABLE "large" ("id" INTEGER, "content" TEXT)`, schema[1][4])
+
+	assert.Equal(t, [][]interface{}{
+		{"a", nil},
+		{"b", int64(-70000)},
+		{`quoted "name"`, 1.5},
+		{"d", int64(1)},
+		{"e", []byte{0, 1, 2}},
+		{"f", int64(math.MaxInt64)},
+	}, readTable(t, content, int(schema[0][3].(int64))))
+
+	largeContent := readTable(t, content, int(schema[1][3].(int64)))
+	require.Len(t, largeContent, largeRows)
+	for i, row := range largeContent {
+		require.Equal(t, int64(i), row[0])
+		if i%1000 == 0 {
+			require.Equal(t, strings.Repeat("x", 10000+i), row[1])
+		}
+	}
+
+	assert.Empty(t, readTable(t, content, int(schema[2][3].(int64))))
+}
+
+// TestUnitSQLiteVarint asserts the varint encoding at the boundaries of its sizes
+func TestUnitSQLiteVarint(t *testing.T) {
+	for _, v := range []uint64{0, 0x7f, 0x80, 0x3fff, 0x4000, 1 << 56, 1<<56 - 1, math.MaxUint64} {
+		encoded := putVarint(v)
+		decoded, n := readVarint(encoded)
+		assert.Equal(t, v, decoded)
+		assert.Equal(t, len(encoded), n)
+	}
+	assert.Equal(t, []byte{0x81, 0x00}, putVarint(0x80))
+	assert.Len(t, putVarint(math.MaxUint64), 9)
+}
+
+// TestUnitSQLiteDatabaseOpensInSQLite asserts that the sqlite3 command line shell finds no errors in a written file and
+// reads its rows back. The shell is required in CI, and the test is only skipped locally when it is not installed.
+func TestUnitSQLiteDatabaseOpensInSQLite(t *testing.T) {
+	sqlite3, err := exec.LookPath("sqlite3")
+	if err != nil {
+		if os.Getenv("CI") != "" {
+			t.Fatalf("sqlite3 is required in CI to check that the written files open in SQLite: %v", err)
+		}
+		t.Skip("sqlite3 is not installed")
+	}
+
+	db := New()
+	small, err := db.CreateTable("small", Column{Name: "name", Type: "TEXT"}, Column{Name: "value"})
+	require.NoError(t, err)
+	require.NoError(t, small.Insert("a", nil))
+	require.NoError(t, small.Insert("b", int64(-70000)))
+	require.NoError(t, small.Insert(`quoted "name"`, 1.5))
+	require.NoError(t, small.Insert("d", []byte{0, 1, 2}))
+
+	large, err := db.CreateTable("large", Column{Name: "id", Type: "INTEGER"}, Column{Name: "content", Type: "TEXT"})
+	require.NoError(t, err)
+	const largeRows = 20000
+	expectedLength := 0
+	for i := 0; i < largeRows; i++ {
+		content := "row"
+		if i%1000 == 0 {
+			content = strings.Repeat("x", 10000+i)
+		}
+		expectedLength += len(content)
+		require.NoError(t, large.Insert(i, content))
+	}
+	_, err = db.CreateTable("empty", Column{Name: "id"})
+	require.NoError(t, err)
+
+	path := filepath.Join(t.TempDir(), "test.db")
+	require.NoError(t, db.WriteFile(path))
+
+	query := func(sql string) string {
+		output, err := exec.Command(sqlite3, "-readonly", path, sql).CombinedOutput()
+		require.NoError(t, err, string(output))
+		return strings.TrimSpace(string(output))
+	}
+	assert.Equal(t, "ok", query("PRAGMA integrity_check;"))
+	assert.Equal(t, "empty\nlarge\nsmall", query("SELECT name FROM sqlite_schema WHERE type = 'table' ORDER BY name;"))
+	assert.Equal(t, "a|\nb|-70000\nquoted \"name\"|1.5\nd|000102", query("SELECT name, CASE typeof(value) WHEN 'blob' THEN hex(value) ELSE value END FROM small ORDER BY rowid;"))
+	assert.Equal(t, fmt.Sprintf("%d|%d|%d", largeRows, largeRows-1, expectedLength), query("SELECT count(*), max(id), sum(length(content)) FROM large;"))
+	assert.Equal(t, "0", query("SELECT count(*) FROM empty;"))
+}
@@ -454,6 +454,40 @@ func ValidateFileContentHashChanged(filepathAttr, hashAttr string, supportS3 boo
 	}
 }
 
+// ValidateTemplatedFileContentHashChanged works like ValidateFileContentHashChanged, but hashes the rendered file content when the
+// resource has templating enabled so that changes to the template variables or substitutions are detected.
+func ValidateTemplatedFileContentHashChanged(filepathAttr, hashAttr, substitutionsAttr, templateAttr string, supportS3 bool) customdiff.ResourceConditionFunc {
+	return func(ctx context.Context, d *schema.ResourceDiff, meta any) bool {
+		if !d.NewValueKnown(templateAttr) || !d.NewValueKnown(substitutionsAttr) {
+			return true
+		}
+
+		settings, err := files.BuildTemplateSettings(d, templateAttr)
+		if err != nil {
+			log.Printf("Error reading template settings: %v", err)
+			return false
+		}
+		if settings == nil {
+			return ValidateFileContentHashChanged(filepathAttr, hashAttr, supportS3)(ctx, d, meta)
+		}
+
+		filepath, _ := d.Get(filepathAttr).(string)
+		if filepath == "" {
+			log.Printf("filepath is empty")
+			return false
+		}
+
+		substitutions, _ := d.Get(substitutionsAttr).(map[string]any)
+		newHash, err := files.HashRenderedFileContent(ctx, filepath, supportS3, substitutions, settings)
+		if err != nil {
+			log.Printf("Error calculating rendered file content hash: %v", err)
+			return false
+		}
+
+		return d.Get(hashAttr).(string) != newHash
+	}
+}
+
 // ValidateCSVColumns returns a CustomizeDiffFunction that validates if a CSV file
 // contains the required columns. It takes the names of the attributes that contain
 // the file path and the column names.
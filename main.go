@@ -1,7 +1,11 @@
 package main
 
 import (
+	"context"
 	"flag"
+	"os"
+
+	exportCli "github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/export_cli"
 	"github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/provider"
 	providerRegistrar "github.com/mypurecloud/terraform-provider-genesyscloud/genesyscloud/provider_registrar"
 
@@ -30,6 +34,11 @@ var (
 )
 
 func main() {
+	// "terraform-provider-genesyscloud export [flags]" runs an export without Terraform
+	if len(os.Args) > 1 && os.Args[1] == exportCli.CommandName {
+		os.Exit(exportCli.Run(context.Background(), os.Args[2:], version, os.Stdout, os.Stderr))
+	}
+
 	var debugMode bool
 
 	flag.BoolVar(&debugMode, "debug", false, "set to true to run the provider with support for debuggers like delve")